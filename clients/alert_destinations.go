@@ -0,0 +1,94 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"terraform-provider-ome/models"
+)
+
+// GetSNMPDestinations - returns all the SNMP trap destination slots of the appliance
+func (c *Client) GetSNMPDestinations() ([]models.SNMPDestination, error) {
+	destinations := []models.SNMPDestination{}
+	response, err := c.Get(AlertSNMPConfigAPI, nil, nil)
+	if err != nil {
+		return destinations, err
+	}
+	bodyData, getBodyError := c.GetBodyData(response.Body)
+	if getBodyError != nil {
+		return destinations, getBodyError
+	}
+	err = c.JSONUnMarshalValue(bodyData, &destinations)
+	return destinations, err
+}
+
+// ApplySNMPDestinations - updates the given SNMP trap destination slots of the appliance
+func (c *Client) ApplySNMPDestinations(destinations []models.SNMPDestination) error {
+	if len(destinations) == 0 {
+		return nil
+	}
+	data, errMarshal := c.JSONMarshal(destinations)
+	if errMarshal != nil {
+		return errMarshal
+	}
+	_, err := c.Post(AlertSNMPApplyAPI, nil, data)
+	return err
+}
+
+// SendTestTrap - sends a test trap to the given SNMP destination
+func (c *Client) SendTestTrap(destination models.SNMPDestination) error {
+	data, errMarshal := c.JSONMarshal(destination)
+	if errMarshal != nil {
+		return errMarshal
+	}
+	_, err := c.Post(AlertSNMPTestAPI, nil, data)
+	return err
+}
+
+// GetSyslogDestinations - returns all the syslog destination slots of the appliance
+func (c *Client) GetSyslogDestinations() ([]models.SyslogDestination, error) {
+	destinations := []models.SyslogDestination{}
+	response, err := c.Get(AlertSyslogConfigAPI, nil, nil)
+	if err != nil {
+		return destinations, err
+	}
+	bodyData, getBodyError := c.GetBodyData(response.Body)
+	if getBodyError != nil {
+		return destinations, getBodyError
+	}
+	err = c.JSONUnMarshalValue(bodyData, &destinations)
+	return destinations, err
+}
+
+// ApplySyslogDestinations - updates the given syslog destination slots of the appliance
+func (c *Client) ApplySyslogDestinations(destinations []models.SyslogDestination) error {
+	if len(destinations) == 0 {
+		return nil
+	}
+	data, errMarshal := c.JSONMarshal(destinations)
+	if errMarshal != nil {
+		return errMarshal
+	}
+	_, err := c.Post(AlertSyslogApplyAPI, nil, data)
+	return err
+}
+
+// SendTestSyslog - sends a test message to the given syslog destination
+func (c *Client) SendTestSyslog(destination models.SyslogDestination) error {
+	data, errMarshal := c.JSONMarshal(destination)
+	if errMarshal != nil {
+		return errMarshal
+	}
+	_, err := c.Post(AlertSyslogTestAPI, nil, data)
+	return err
+}
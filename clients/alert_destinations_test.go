@@ -0,0 +1,77 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"terraform-provider-ome/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_GetAlertDestinations(t *testing.T) {
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	opts := initOptions(ts)
+	c, _ := NewClient(opts)
+
+	snmp, err := c.GetSNMPDestinations()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(snmp))
+	assert.Equal(t, "192.0.2.10", snmp[0].DestinationAddress)
+	assert.Equal(t, "public", snmp[0].SnmpV1V2Credential.Community)
+
+	syslog, err := c.GetSyslogDestinations()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(syslog))
+	assert.Equal(t, int64(514), syslog[0].PortNumber)
+}
+
+func TestClient_ApplyAlertDestinations(t *testing.T) {
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	opts := initOptions(ts)
+	c, _ := NewClient(opts)
+
+	tests := []struct {
+		name    string
+		address string
+		wantErr bool
+	}{
+		{"Apply alert destinations successfully", "192.0.2.10", false},
+		{"Apply alert destinations failure", "invalid", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			snmp := models.SNMPDestination{ID: 1, Enabled: true, DestinationAddress: tt.address, PortNumber: 162, SnmpVersion: "SNMPV2",
+				SnmpV1V2Credential: &models.SNMPV1V2Credential{Community: "public"}}
+			syslog := models.SyslogDestination{ID: 1, Enabled: true, DestinationAddress: tt.address, PortNumber: 514}
+
+			errs := []error{
+				c.ApplySNMPDestinations([]models.SNMPDestination{snmp}),
+				c.SendTestTrap(snmp),
+				c.ApplySyslogDestinations([]models.SyslogDestination{syslog}),
+				c.SendTestSyslog(syslog),
+			}
+			for _, err := range errs {
+				assert.Equal(t, tt.wantErr, err != nil)
+			}
+		})
+	}
+
+	assert.Nil(t, c.ApplySNMPDestinations(nil))
+	assert.Nil(t, c.ApplySyslogDestinations(nil))
+}
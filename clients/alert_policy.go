@@ -0,0 +1,128 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"fmt"
+	"terraform-provider-ome/models"
+)
+
+// GetAlertPolicyByID - returns the alert policy with the given id
+func (c *Client) GetAlertPolicyByID(id int64) (models.AlertPolicy, error) {
+	policy := models.AlertPolicy{}
+	response, err := c.Get(fmt.Sprintf(AlertPolicyAPI, id), nil, nil)
+	if err != nil {
+		return policy, err
+	}
+	bodyData, getBodyError := c.GetBodyData(response.Body)
+	if getBodyError != nil {
+		return policy, getBodyError
+	}
+	err = c.JSONUnMarshal(bodyData, &policy)
+	return policy, err
+}
+
+// GetAlertPolicyByName - returns the alert policy with the given name
+func (c *Client) GetAlertPolicyByName(name string) (models.AlertPolicy, error) {
+	policy := models.AlertPolicy{}
+	response, err := c.Get(AlertPoliciesAPI, nil, map[string]string{"$filter": fmt.Sprintf("Name eq '%s'", name)})
+	if err != nil {
+		return policy, err
+	}
+	bodyData, getBodyError := c.GetBodyData(response.Body)
+	if getBodyError != nil {
+		return policy, getBodyError
+	}
+	err = c.JSONUnMarshalSingleValue(bodyData, &policy)
+	return policy, err
+}
+
+// CreateAlertPolicy - creates an alert policy and returns the created policy
+func (c *Client) CreateAlertPolicy(policy models.AlertPolicy) (models.AlertPolicy, error) {
+	data, errMarshal := c.JSONMarshal(policy)
+	if errMarshal != nil {
+		return models.AlertPolicy{}, errMarshal
+	}
+	response, err := c.Post(AlertPoliciesAPI, nil, data)
+	if err != nil {
+		return models.AlertPolicy{}, err
+	}
+	created := models.AlertPolicy{}
+	bodyData, getBodyError := c.GetBodyData(response.Body)
+	if getBodyError != nil {
+		return created, getBodyError
+	}
+	if err = c.JSONUnMarshal(bodyData, &created); err != nil || created.ID == 0 {
+		// some appliance versions do not return the created policy
+		return c.GetAlertPolicyByName(policy.Name)
+	}
+	return created, nil
+}
+
+// UpdateAlertPolicy - updates an alert policy and returns the updated policy
+func (c *Client) UpdateAlertPolicy(policy models.AlertPolicy) (models.AlertPolicy, error) {
+	data, errMarshal := c.JSONMarshal(policy)
+	if errMarshal != nil {
+		return models.AlertPolicy{}, errMarshal
+	}
+	_, err := c.Put(fmt.Sprintf(AlertPolicyAPI, policy.ID), nil, data)
+	if err != nil {
+		return models.AlertPolicy{}, err
+	}
+	return c.GetAlertPolicyByID(policy.ID)
+}
+
+// EnableAlertPolicies - enables or disables the given alert policies
+func (c *Client) EnableAlertPolicies(ids []int64, enable bool) error {
+	url := AlertPolicyDisableAPI
+	if enable {
+		url = AlertPolicyEnableAPI
+	}
+	return c.postAlertPolicyIDs(url, ids)
+}
+
+// DeleteAlertPolicies - removes the given alert policies
+func (c *Client) DeleteAlertPolicies(ids []int64) error {
+	return c.postAlertPolicyIDs(AlertPolicyRemoveAPI, ids)
+}
+
+func (c *Client) postAlertPolicyIDs(url string, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	data, errMarshal := c.JSONMarshal(models.AlertPolicyIDsPayload{AlertPolicyIds: ids})
+	if errMarshal != nil {
+		return errMarshal
+	}
+	_, err := c.Post(url, nil, data)
+	return err
+}
+
+// GetAlertActionTemplates - returns all the alert action templates
+func (c *Client) GetAlertActionTemplates() ([]models.AlertActionTemplate, error) {
+	templates := []models.AlertActionTemplate{}
+	err := c.GetValueWithPagination(RequestOptions{
+		URL: AlertActionTemplatesAPI,
+	}, &templates)
+	return templates, err
+}
+
+// GetAlertCategories - returns the alert categories of all the message catalogs
+func (c *Client) GetAlertCategories() ([]models.AlertCategoryCatalog, error) {
+	catalogs := []models.AlertCategoryCatalog{}
+	err := c.GetValueWithPagination(RequestOptions{
+		URL: AlertCategoriesAPI,
+	}, &catalogs)
+	return catalogs, err
+}
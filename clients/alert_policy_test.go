@@ -0,0 +1,94 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"terraform-provider-ome/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_GetAlertPolicy(t *testing.T) {
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	opts := initOptions(ts)
+	c, _ := NewClient(opts)
+
+	policy, err := c.GetAlertPolicyByID(1)
+	assert.Nil(t, err)
+	assert.Equal(t, "policy1", policy.Name)
+	assert.Equal(t, []int64{16}, policy.PolicyData.Severities)
+	assert.Equal(t, "Trap", policy.PolicyData.Actions[0].Name)
+
+	_, err = c.GetAlertPolicyByID(2)
+	assert.NotNil(t, err)
+
+	policy, err = c.GetAlertPolicyByName("policy1")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), policy.ID)
+}
+
+func TestClient_CreateUpdateDeleteAlertPolicy(t *testing.T) {
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	opts := initOptions(ts)
+	c, _ := NewClient(opts)
+
+	tests := []struct {
+		name    string
+		policy  string
+		wantErr bool
+	}{
+		{"Create alert policy successfully", "policy1", false},
+		{"Create alert policy without id in response", "noid", false},
+		{"Create alert policy failure", "invalid", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := c.CreateAlertPolicy(models.AlertPolicy{Name: tt.policy})
+			assert.Equal(t, tt.wantErr, err != nil)
+			if err == nil {
+				assert.Equal(t, int64(1), policy.ID)
+			}
+		})
+	}
+
+	policy, err := c.UpdateAlertPolicy(models.AlertPolicy{ID: 1, Name: "policy1"})
+	assert.Nil(t, err)
+	assert.Equal(t, "policy1", policy.Name)
+
+	assert.Nil(t, c.EnableAlertPolicies([]int64{1}, true))
+	assert.Nil(t, c.EnableAlertPolicies([]int64{1}, false))
+	assert.Nil(t, c.DeleteAlertPolicies([]int64{1}))
+	assert.Nil(t, c.DeleteAlertPolicies(nil))
+}
+
+func TestClient_GetAlertTemplatesAndCategories(t *testing.T) {
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	opts := initOptions(ts)
+	c, _ := NewClient(opts)
+
+	templates, err := c.GetAlertActionTemplates()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(templates))
+
+	catalogs, err := c.GetAlertCategories()
+	assert.Nil(t, err)
+	assert.Equal(t, "System Health", catalogs[0].CategoriesDetails[0].Name)
+}
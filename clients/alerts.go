@@ -0,0 +1,52 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"terraform-provider-ome/models"
+)
+
+// GetAlerts - returns all the alerts matching the given query params across all the pages
+func (c *Client) GetAlerts(queryParams map[string]string) ([]models.Alert, error) {
+	alerts := []models.Alert{}
+	err := c.GetPaginatedDataWithQueryParam(AlertsAPI, queryParams, &alerts)
+	return alerts, err
+}
+
+// AcknowledgeAlerts - acknowledges the given alerts
+func (c *Client) AcknowledgeAlerts(ids []int64) error {
+	return c.postAlertIDs(AlertAcknowledgeAPI, ids)
+}
+
+// UnacknowledgeAlerts - unacknowledges the given alerts
+func (c *Client) UnacknowledgeAlerts(ids []int64) error {
+	return c.postAlertIDs(AlertUnacknowledgeAPI, ids)
+}
+
+// ClearAlerts - removes the given alerts from the appliance
+func (c *Client) ClearAlerts(ids []int64) error {
+	return c.postAlertIDs(AlertRemoveAPI, ids)
+}
+
+func (c *Client) postAlertIDs(url string, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	data, errMarshal := c.JSONMarshal(models.AlertIDsPayload{AlertIds: ids})
+	if errMarshal != nil {
+		return errMarshal
+	}
+	_, err := c.Post(url, nil, data)
+	return err
+}
@@ -0,0 +1,54 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_GetAlerts(t *testing.T) {
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	opts := initOptions(ts)
+	c, _ := NewClient(opts)
+
+	alerts, err := c.GetAlerts(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(alerts))
+	assert.Equal(t, int64(1), alerts[0].ID)
+	assert.Equal(t, "SVCTAG2", alerts[1].AlertDeviceIdentifier)
+
+	alerts, err = c.GetAlerts(map[string]string{"$filter": "StatusType eq 2000"})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(alerts))
+
+	_, err = c.GetAlerts(map[string]string{"$filter": "invalid"})
+	assert.NotNil(t, err)
+}
+
+func TestClient_AlertActions(t *testing.T) {
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	opts := initOptions(ts)
+	c, _ := NewClient(opts)
+
+	assert.Nil(t, c.AcknowledgeAlerts([]int64{1, 2}))
+	assert.Nil(t, c.UnacknowledgeAlerts([]int64{1}))
+	assert.Nil(t, c.ClearAlerts([]int64{2}))
+	assert.Nil(t, c.ClearAlerts(nil))
+}
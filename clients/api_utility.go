@@ -77,6 +77,7 @@ type JobResp struct {
 	JobType        JobStatus `json:"JobType"`
 	JobStatus      JobStatus `json:"JobStatus"`
 	Params         []Params  `json:"Params"`
+	Targets        []Target  `json:"Targets"`
 	Visible        bool      `json:"Visible"`
 	Editable       bool      `json:"Editable"`
 	Builtin        bool      `json:"Builtin"`
@@ -91,11 +92,19 @@ type Params struct {
 	Value string `json:"Value"`
 }
 
+// Target for getting the targets of a job.
+type Target struct {
+	ID   int64  `json:"Id"`
+	Data string `json:"Data"`
+}
+
 // LastExecutionDetail is response returned by LastExecutionDetail job API
 type LastExecutionDetail struct {
 	Value              string    `json:"Value"`
 	ExecutionHistoryID int       `json:"ExecutionHistoryId"`
 	JobStatus          JobStatus `json:"JobStatus"`
+	Key                string    `json:"Key"`
+	IDBaseEntity       int64     `json:"IdBaseEntity"`
 }
 
 // ExecutionHistories is response returned by execution history job api.
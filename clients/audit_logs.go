@@ -0,0 +1,25 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"terraform-provider-ome/models"
+)
+
+// GetAuditLogs - returns all the audit logs matching the given query params across all the pages
+func (c *Client) GetAuditLogs(queryParams map[string]string) ([]models.AuditLog, error) {
+	logs := []models.AuditLog{}
+	err := c.GetPaginatedDataWithQueryParam(AuditLogsAPI, queryParams, &logs)
+	return logs, err
+}
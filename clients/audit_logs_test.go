@@ -0,0 +1,41 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_GetAuditLogs(t *testing.T) {
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	opts := initOptions(ts)
+	c, _ := NewClient(opts)
+
+	logs, err := c.GetAuditLogs(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(logs))
+	assert.Equal(t, "admin", logs[0].UserName)
+	assert.Equal(t, "192.0.2.2", logs[1].IPAddress)
+
+	logs, err = c.GetAuditLogs(map[string]string{"$filter": "UserName eq 'admin'"})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(logs))
+
+	_, err = c.GetAuditLogs(map[string]string{"$filter": "invalid"})
+	assert.NotNil(t, err)
+}
@@ -119,6 +119,31 @@ func (c *Client) GetBaselineDevAttrComplianceReportsByID(baselineID int64, devic
 	return string(respData), err
 }
 
+// GetBaselines returns all the configuration baselines
+func (c *Client) GetBaselines() ([]models.OmeBaseline, error) {
+	baselines := []models.OmeBaseline{}
+	err := c.GetPaginatedData(BaselineAPI, &baselines)
+	if err != nil {
+		return []models.OmeBaseline{}, err
+	}
+	return baselines, nil
+}
+
+// GetBaselineDeviceComplianceDetail returns the attribute compliance of a device against the template of a baseline
+func (c *Client) GetBaselineDeviceComplianceDetail(baselineID int64, deviceID int64) (models.OMEDeviceComplianceDetail, error) {
+	detail := models.OMEDeviceComplianceDetail{}
+	response, err := c.Get(fmt.Sprintf(BaselineDeviceAttrComplianceReportsAPI, baselineID, deviceID), nil, nil)
+	if err != nil {
+		return detail, err
+	}
+	respData, getBodyError := c.GetBodyData(response.Body)
+	if getBodyError != nil {
+		return detail, getBodyError
+	}
+	err = c.JSONUnMarshal(respData, &detail)
+	return detail, err
+}
+
 func (c *Client) getBaseline(url, name string) (models.OmeBaseline, error) {
 	omeBaselines := models.OmeBaselines{}
 	response, err := c.Get(url, nil, nil)
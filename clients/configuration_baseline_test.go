@@ -293,6 +293,40 @@ func TestClient_GetBaselineDeviceAttrComplianceReportByID(t *testing.T) {
 	}
 }
 
+func TestClient_GetBaselineDeviceComplianceDetail(t *testing.T) {
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	opts := initOptions(ts)
+	c, _ := NewClient(opts)
+
+	detail, err := c.GetBaselineDeviceComplianceDetail(14, 11803)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(326), detail.TemplateID)
+	assert.Equal(t, 1, len(detail.ComplianceAttributeGroups))
+	group := detail.ComplianceAttributeGroups[0].ComplianceSubAttributeGroups[0]
+	assert.Equal(t, "Lifecycle Controller Attributes", group.DisplayName)
+	assert.Nil(t, group.Attributes[0].Value)
+	assert.Equal(t, "Disabled", *group.Attributes[0].ExpectedValue)
+
+	_, err = c.GetBaselineDeviceComplianceDetail(-1, -1)
+	assert.NotNil(t, err)
+}
+
+func TestClient_GetBaselines(t *testing.T) {
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	opts := initOptions(ts)
+	c, _ := NewClient(opts)
+
+	baselines, err := c.GetBaselines()
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(baselines))
+	assert.Equal(t, int64(745), baselines[0].TemplateID)
+	assert.Equal(t, int64(12152), baselines[0].BaselineTargets[0].ID)
+}
+
 func TestClient_GetBaselineByName(t *testing.T) {
 
 	ts := createNewTLSServer(t)
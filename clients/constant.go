@@ -61,6 +61,10 @@ const (
 	DeviceAPI = "/api/DeviceService/Devices"
 	// DeviceRemovalAPI - api to remove multiple devices by ID
 	DeviceRemovalAPI = "/api/DeviceService/Actions/DeviceService.RemoveDevices"
+	// DeviceByIDAPI - api to get and update a device by id
+	DeviceByIDAPI = DeviceAPI + "(%d)"
+	// DeviceLocationSettingAPI - api to get and update the location of a device
+	DeviceLocationSettingAPI = DeviceByIDAPI + "/Settings('Location')"
 	//DeviceInventoryAPI - api for getting device inventory
 	DeviceInventoryAPI = DeviceAPI + "(%d)/InventoryDetails"
 	//DeviceInventoryAPI - api for getting device inventory of a single type
@@ -79,6 +83,12 @@ const (
 	GroupServiceDeviceActionsAPI = "/api/GroupService/Actions/GroupService.%sMemberDevices"
 	//GroupServiceDevicesAPI - api for fetching device ids from a group id
 	GroupServiceDevicesAPI = GroupAPI + "(%d)/Devices"
+	// GroupServiceSubGroupsAPI - api for fetching the direct sub groups of a group id
+	GroupServiceSubGroupsAPI = GroupAPI + "(%d)/SubGroups"
+	// QueryContextAPI - api to get the tables and fields of a query context
+	QueryContextAPI = "/api/QuerySupportService/QueryContexts(%d)"
+	// QueryOperatorsAPI - api to get the operators of the query conditions
+	QueryOperatorsAPI = "/api/QuerySupportService/OperatorInfo"
 	//DeployAPI - api to deploy a template on the given devices
 	DeployAPI = "/api/TemplateService/Actions/TemplateService.Deploy"
 	//ProfileAPI - api to manage profiles
@@ -87,6 +97,16 @@ const (
 	UnAssignProfileAPI = "/api/ProfileService/Actions/ProfileService.UnassignProfiles"
 	//DeleteProfileAPI - api to delete profile
 	DeleteProfileAPI = "/api/ProfileService/Actions/ProfileService.Delete"
+	// ProfileByIDAPI - api to get and update a profile by id
+	ProfileByIDAPI = ProfileAPI + "(%d)"
+	// ProfileAttributesAPI - api to get the attributes of a profile
+	ProfileAttributesAPI = ProfileByIDAPI + "/AttributeDetails"
+	// AssignProfileAPI - api to assign a profile to a device
+	AssignProfileAPI = "/api/ProfileService/Actions/ProfileService.AssignProfile"
+	// MigrateProfileAPI - api to migrate a profile to another device
+	MigrateProfileAPI = "/api/ProfileService/Actions/ProfileService.MigrateProfile"
+	// RedeployProfilesAPI - api to redeploy the assigned profiles
+	RedeployProfilesAPI = "/api/ProfileService/Actions/ProfileService.RedeployProfiles"
 	//CloneTemplateAPI - api to clone a template
 	CloneTemplateAPI = "/api/TemplateService/Actions/TemplateService.Clone"
 	//BaseLineRemoveAPI - api to remove a baseline
@@ -97,8 +117,16 @@ const (
 	BaseLineConfigDeviceCompReport = "/api/TemplateService/Baselines(%d)/DeviceConfigComplianceReports"
 	//VlanNetworksAPI - api to vlan networks
 	VlanNetworksAPI = "/api/NetworkConfigurationService/Networks"
+	// VlanNetworkAPI - api to get, update and delete a vlan network by id
+	VlanNetworkAPI = VlanNetworksAPI + "(%d)"
+	// NetworkTypesAPI - api to get the types of the vlan networks
+	NetworkTypesAPI = "/api/NetworkConfigurationService/NetworkTypes"
 	//ImportTemplateAPI - api to import a template
 	ImportTemplateAPI = "/api/TemplateService/Actions/TemplateService.Import"
+	// ExportTemplateAPI - api to export the content of a template
+	ExportTemplateAPI = "/api/TemplateService/Actions/TemplateService.Export"
+	// TemplateEditInfoAPI - api to get the constraints on the value of a template attribute
+	TemplateEditInfoAPI = "/api/TemplateService/TemplateEditInfo(%d)"
 	// TemplateNameContainsAPI - api to fetch templates by name
 	TemplateNameContainsAPI = "/api/TemplateService/Templates?$filter=contains(Name, '%s')"
 	//UserAPI - api to manage users
@@ -145,6 +173,56 @@ const (
 	RemoveFirmwareBaseline = "/api/UpdateService/Actions/UpdateService.RemoveBaselines"
 	// DeviceComplianceReportAPI gets the details of a specific compliance report
 	DeviceComplianceReportAPI = "/api/UpdateService/Actions/UpdateService.GetBaselinesReportByDeviceids"
+	// AlertSNMPConfigAPI - api to get the SNMP trap destinations
+	AlertSNMPConfigAPI = "/api/AlertService/AlertDestinations/SNMPConfiguration"
+	// AlertSNMPApplyAPI - api to update the SNMP trap destinations
+	AlertSNMPApplyAPI = "/api/AlertService/AlertDestinations/Actions/AlertDestinations.ApplySNMPConfig"
+	// AlertSNMPTestAPI - api to send a test trap to a SNMP destination
+	AlertSNMPTestAPI = "/api/AlertService/AlertDestinations/Actions/AlertDestinations.SendTestTrap"
+	// AlertSyslogConfigAPI - api to get the syslog destinations
+	AlertSyslogConfigAPI = "/api/AlertService/AlertDestinations/SyslogConfiguration"
+	// AlertSyslogApplyAPI - api to update the syslog destinations
+	AlertSyslogApplyAPI = "/api/AlertService/AlertDestinations/Actions/AlertDestinations.ApplySyslogConfig"
+	// AlertSyslogTestAPI - api to send a test message to a syslog destination
+	AlertSyslogTestAPI = "/api/AlertService/AlertDestinations/Actions/AlertDestinations.SendTestSyslog"
+	// AlertPoliciesAPI - api to get and create alert policies
+	AlertPoliciesAPI = "/api/AlertService/AlertPolicies"
+	// AlertPolicyAPI - api to get and update an alert policy by id
+	AlertPolicyAPI = AlertPoliciesAPI + "(%d)"
+	// AlertPolicyEnableAPI - api to enable alert policies
+	AlertPolicyEnableAPI = "/api/AlertService/Actions/AlertService.EnableAlertPolicies"
+	// AlertPolicyDisableAPI - api to disable alert policies
+	AlertPolicyDisableAPI = "/api/AlertService/Actions/AlertService.DisableAlertPolicies"
+	// AlertPolicyRemoveAPI - api to remove alert policies
+	AlertPolicyRemoveAPI = "/api/AlertService/Actions/AlertService.RemoveAlertPolicies"
+	// AlertActionTemplatesAPI - api to get the alert action templates
+	AlertActionTemplatesAPI = "/api/AlertService/AlertActionTemplates"
+	// AlertCategoriesAPI - api to get the alert categories of the message catalogs
+	AlertCategoriesAPI = "/api/AlertService/AlertCategories"
+	// AlertsAPI - api to get the alerts
+	AlertsAPI = "/api/AlertService/Alerts"
+	// AlertAcknowledgeAPI - api to acknowledge alerts
+	AlertAcknowledgeAPI = "/api/AlertService/Actions/AlertService.Acknowledge"
+	// AlertUnacknowledgeAPI - api to unacknowledge alerts
+	AlertUnacknowledgeAPI = "/api/AlertService/Actions/AlertService.Unacknowledge"
+	// AlertRemoveAPI - api to clear alerts
+	AlertRemoveAPI = "/api/AlertService/Actions/AlertService.RemoveAlerts"
+	// AuditLogsAPI - api to get the audit logs of the appliance
+	AuditLogsAPI = "/api/ApplicationService/AuditLogs"
+	// WarrantiesAPI - api to get the warranty entitlements of the devices
+	WarrantiesAPI = "/api/WarrantyService/Warranties"
+	// ManagementDomainsAPI - api to get the chassis of the multi-chassis management group
+	ManagementDomainsAPI = "/api/ManagementDomainService/Domains"
+	// IdentityPoolByIDAPI - api to get, update and delete an identity pool by id
+	IdentityPoolByIDAPI = IdentityPoolAPI + "(%d)"
+	// IdentityPoolUsageSetsAPI - api to get the identity sets of an identity pool
+	IdentityPoolUsageSetsAPI = IdentityPoolByIDAPI + "/UsageIdentitySets"
+	// IdentityPoolUsageDetailsAPI - api to get the identities of an identity set of an identity pool
+	IdentityPoolUsageDetailsAPI = IdentityPoolUsageSetsAPI + "(%d)/Details"
+	// OIDCProvidersAPI - api to get and create the OpenID Connect providers
+	OIDCProvidersAPI = "/api/AccountService/ExternalAccountProvider/OpenIDConnectProvider"
+	// OIDCProviderAPI - api to get, update and delete an OpenID Connect provider by id
+	OIDCProviderAPI = OIDCProvidersAPI + "(%d)"
 )
 
 // Messages constants
@@ -167,6 +245,8 @@ const (
 	ErrEmptyDeviceDetails = "either Device ID or Servicetag is required"
 	// ErrInvalidFqdds = error message for invalid fqdds
 	ErrInvalidFqdds = "Invalid FQDDS for template creation"
+	// ErrInvalidNetworkExpression = error message for invalid IP expressions and host names
+	ErrInvalidNetworkExpression = "Invalid network expression"
 	// ErrInvalidTemplateViewType - error message for invalid template view type
 	ErrInvalidTemplateViewType = "Invalid template view type for template creation"
 	// SuccessMsg - job success message
@@ -282,6 +362,10 @@ const (
 	ErrDeleteTemplate = "Unable to delete template"
 	// ErrImportTemplate - message returned when import template fails
 	ErrImportTemplate = "Unable to import template"
+	// ErrTemplateAttributeOverrides - message returned when the attribute overrides of a template cannot be resolved
+	ErrTemplateAttributeOverrides = "Invalid template attribute overrides"
+	// ErrDeviceAttributeOverrides - message returned when the device attribute overrides of a deployment are invalid
+	ErrDeviceAttributeOverrides = "Invalid device attribute overrides"
 	// ErrGnrConfigurationReport - message returned when report could not be fetched
 	ErrGnrConfigurationReport = "unable to fetch the report"
 	// ErrCronRequired - message returned when run_later is true but cron is not provided
@@ -310,6 +394,126 @@ const (
 	ErrGnrDeleteDiscovery = "error deleting a discovery"
 	// ErrDiscoveryJobIsRunning - device capablity
 	ErrDiscoveryJobIsRunning = "job with id %d is already running please wait for sometime and try again"
+	// ErrGnrCreateAlertDestinations - summary returned when failed to create alert destinations
+	ErrGnrCreateAlertDestinations = "error creating alert destinations"
+	// ErrGnrReadAlertDestinations - summary returned when failed to read alert destinations
+	ErrGnrReadAlertDestinations = "error reading alert destinations"
+	// ErrGnrUpdateAlertDestinations - summary returned when failed to update alert destinations
+	ErrGnrUpdateAlertDestinations = "error updating alert destinations"
+	// ErrGnrDeleteAlertDestinations - summary returned when failed to delete alert destinations
+	ErrGnrDeleteAlertDestinations = "error deleting alert destinations"
+	// ErrGnrImportAlertDestinations - summary returned when failed to import alert destinations
+	ErrGnrImportAlertDestinations = "error importing alert destinations"
+	// ErrAlertDestinationNotFound - message returned when a destination slot does not exist on the appliance
+	ErrAlertDestinationNotFound = "%s destination with id %d does not exist on the appliance"
+	// ErrGnrCreateAlertPolicy - summary returned when failed to create an alert policy
+	ErrGnrCreateAlertPolicy = "error creating alert policy"
+	// ErrGnrReadAlertPolicy - summary returned when failed to read an alert policy
+	ErrGnrReadAlertPolicy = "error reading alert policy"
+	// ErrGnrUpdateAlertPolicy - summary returned when failed to update an alert policy
+	ErrGnrUpdateAlertPolicy = "error updating alert policy"
+	// ErrGnrDeleteAlertPolicy - summary returned when failed to delete an alert policy
+	ErrGnrDeleteAlertPolicy = "error deleting alert policy"
+	// ErrGnrImportAlertPolicy - summary returned when failed to import an alert policy
+	ErrGnrImportAlertPolicy = "error importing alert policy"
+	// ErrAlertPolicyNotEditable - message returned when a default alert policy is managed
+	ErrAlertPolicyNotEditable = "alert policy %s is a default policy and cannot be modified"
+	// ErrGnrReadAlerts - summary returned when failed to read alerts
+	ErrGnrReadAlerts = "error reading alerts"
+	// ErrGnrAlertAction - summary returned when failed to run an action on alerts
+	ErrGnrAlertAction = "error running alert action"
+	// ErrGnrReadAuditLogs - summary returned when failed to read audit logs
+	ErrGnrReadAuditLogs = "error reading audit logs"
+	// ErrGnrCreateOIDCProvider - summary returned when failed to create an OpenID Connect provider
+	ErrGnrCreateOIDCProvider = "error creating OpenID Connect provider"
+	// ErrGnrReadOIDCProvider - summary returned when failed to read an OpenID Connect provider
+	ErrGnrReadOIDCProvider = "error reading OpenID Connect provider"
+	// ErrGnrUpdateOIDCProvider - summary returned when failed to update an OpenID Connect provider
+	ErrGnrUpdateOIDCProvider = "error updating OpenID Connect provider"
+	// ErrGnrDeleteOIDCProvider - summary returned when failed to delete an OpenID Connect provider
+	ErrGnrDeleteOIDCProvider = "error deleting OpenID Connect provider"
+	// ErrGnrImportOIDCProvider - summary returned when failed to import an OpenID Connect provider
+	ErrGnrImportOIDCProvider = "error importing OpenID Connect provider"
+	// ErrGnrCreateQueryGroup - summary returned when failed to create a query group
+	ErrGnrCreateQueryGroup = "error creating query group"
+	// ErrGnrReadQueryGroup - summary returned when failed to read a query group
+	ErrGnrReadQueryGroup = "error reading query group"
+	// ErrGnrUpdateQueryGroup - summary returned when failed to update a query group
+	ErrGnrUpdateQueryGroup = "error updating query group"
+	// ErrGnrDeleteQueryGroup - summary returned when failed to delete a query group
+	ErrGnrDeleteQueryGroup = "error deleting query group"
+	// ErrGnrReadGroupHierarchy - summary returned when failed to read a group hierarchy
+	ErrGnrReadGroupHierarchy = "error reading group hierarchy"
+	// ErrGnrCreateGroupTree - summary returned when failed to create a group tree
+	ErrGnrCreateGroupTree = "error creating group tree"
+	// ErrGnrReadGroupTree - summary returned when failed to read a group tree
+	ErrGnrReadGroupTree = "error reading group tree"
+	// ErrGnrUpdateGroupTree - summary returned when failed to update a group tree
+	ErrGnrUpdateGroupTree = "error updating group tree"
+	// ErrGnrDeleteGroupTree - summary returned when failed to delete a group tree
+	ErrGnrDeleteGroupTree = "error deleting group tree"
+	// ErrGnrCreateDeviceOnboarding - summary returned when failed to onboard a device
+	ErrGnrCreateDeviceOnboarding = "error onboarding device"
+	// ErrGnrReadDeviceOnboarding - summary returned when failed to read an onboarded device
+	ErrGnrReadDeviceOnboarding = "error reading onboarded device"
+	// ErrGnrUpdateDeviceOnboarding - summary returned when failed to update an onboarded device
+	ErrGnrUpdateDeviceOnboarding = "error updating onboarded device"
+	// ErrGnrDeleteDeviceOnboarding - summary returned when failed to delete an onboarded device
+	ErrGnrDeleteDeviceOnboarding = "error deleting onboarded device"
+	// ErrGnrCreateDeviceProperties - summary returned when failed to set the properties of a device
+	ErrGnrCreateDeviceProperties = "error setting device properties"
+	// ErrGnrReadDeviceProperties - summary returned when failed to read the properties of a device
+	ErrGnrReadDeviceProperties = "error reading device properties"
+	// ErrGnrUpdateDeviceProperties - summary returned when failed to update the properties of a device
+	ErrGnrUpdateDeviceProperties = "error updating device properties"
+	// ErrGnrImportDeviceProperties - summary returned when failed to import the properties of a device
+	ErrGnrImportDeviceProperties = "error importing device properties"
+	// ErrGnrCreateDeviceManagement - summary returned when failed to set the management of a device
+	ErrGnrCreateDeviceManagement = "error setting device management"
+	// ErrGnrReadDeviceManagement - summary returned when failed to read the management of a device
+	ErrGnrReadDeviceManagement = "error reading device management"
+	// ErrGnrUpdateDeviceManagement - summary returned when failed to update the management of a device
+	ErrGnrUpdateDeviceManagement = "error updating device management"
+	// ErrGnrReadDeviceHealth - summary returned when failed to read the health of devices
+	ErrGnrReadDeviceHealth = "error reading device health"
+	// ErrGnrReadWarranty - summary returned when failed to read the warranties of devices
+	ErrGnrReadWarranty = "error reading warranty"
+	// ErrGnrReadChassisTopology - summary returned when failed to read the chassis topology
+	ErrGnrReadChassisTopology = "error reading chassis topology"
+	// ErrGnrCreateIdentityPool - summary returned when failed to create an identity pool
+	ErrGnrCreateIdentityPool = "error creating identity pool"
+	// ErrGnrReadIdentityPool - summary returned when failed to read an identity pool
+	ErrGnrReadIdentityPool = "error reading identity pool"
+	// ErrGnrUpdateIdentityPool - summary returned when failed to update an identity pool
+	ErrGnrUpdateIdentityPool = "error updating identity pool"
+	// ErrGnrDeleteIdentityPool - summary returned when failed to delete an identity pool
+	ErrGnrDeleteIdentityPool = "error deleting identity pool"
+	// ErrGnrImportIdentityPool - summary returned when failed to import an identity pool
+	ErrGnrImportIdentityPool = "error importing identity pool"
+	// ErrGnrCreateVlanNetwork - summary returned when failed to create a vlan network
+	ErrGnrCreateVlanNetwork = "error creating vlan network"
+	// ErrGnrReadVlanNetwork - summary returned when failed to read a vlan network
+	ErrGnrReadVlanNetwork = "error reading vlan network"
+	// ErrGnrUpdateVlanNetwork - summary returned when failed to update a vlan network
+	ErrGnrUpdateVlanNetwork = "error updating vlan network"
+	// ErrGnrDeleteVlanNetwork - summary returned when failed to delete a vlan network
+	ErrGnrDeleteVlanNetwork = "error deleting vlan network"
+	// ErrGnrImportVlanNetwork - summary returned when failed to import a vlan network
+	ErrGnrImportVlanNetwork = "error importing vlan network"
+	// ErrGnrReadTemplateExport - summary returned when failed to export or compare a template
+	ErrGnrReadTemplateExport = "error reading template export"
+	// ErrGnrCreateServerProfile - summary returned when failed to create a server profile
+	ErrGnrCreateServerProfile = "error creating server profile"
+	// ErrGnrReadServerProfile - summary returned when failed to read a server profile
+	ErrGnrReadServerProfile = "error reading server profile"
+	// ErrGnrUpdateServerProfile - summary returned when failed to update a server profile
+	ErrGnrUpdateServerProfile = "error updating server profile"
+	// ErrGnrDeleteServerProfile - summary returned when failed to delete a server profile
+	ErrGnrDeleteServerProfile = "error deleting server profile"
+	// ErrGnrImportServerProfile - summary returned when failed to import a server profile
+	ErrGnrImportServerProfile = "error importing server profile"
+	// ErrGnrReadDeploymentPrecheck - summary returned when failed to precheck a deployment or a remediation
+	ErrGnrReadDeploymentPrecheck = "error reading deployment precheck"
 )
 
 // FailureStatusIDs - list of failure status IDs from OME for a job
@@ -325,7 +529,9 @@ const (
 	// ValidComplainceStatus = Valid compliance status supported
 	ValidComplainceStatus string = "Compliant"
 	// ValidTemplateDeviceTypes = Valid template device types supported in template creation
-	ValidTemplateDeviceTypes string = "Server,Chassis"
+	ValidTemplateDeviceTypes string = "Server,Chassis,IO Module"
+	// MaxAlertDestinations - number of SNMP and syslog destination slots available on the appliance
+	MaxAlertDestinations int64 = 4
 )
 
 // constants for Vlan attributes
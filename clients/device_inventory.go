@@ -82,3 +82,29 @@ func (c *Client) RefreshDeviceInventory(deviceIDs []int64, opts JobOpts) (JobRes
 	}
 	return response, nil
 }
+
+// GetDeviceInventoryTypes returns the inventory types of a device which have been collected
+func (c *Client) GetDeviceInventoryTypes(deviceID int64) ([]string, error) {
+	path := fmt.Sprintf(DeviceInventoryAPI, deviceID)
+	response, err := c.Get(path, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error querying device inventory: %w", err)
+	}
+	bodyData, getBodyError := c.GetBodyData(response.Body)
+	if getBodyError != nil {
+		return nil, getBodyError
+	}
+	infos := make([]models.DeviceInventoryInfo, 0)
+	if err := c.JSONUnMarshalValue(bodyData, &infos); err != nil {
+		return nil, err
+	}
+	types := make([]string, 0)
+	for _, info := range infos {
+		items := make([]json.RawMessage, 0)
+		// inventory types which are not collected yet are returned with an empty or null info
+		if err := json.Unmarshal(info.Info, &items); err == nil && len(items) > 0 {
+			types = append(types, info.Type)
+		}
+	}
+	return types, nil
+}
@@ -69,3 +69,25 @@ func TestClient_RefreshDeviceInventory(t *testing.T) {
 	})
 	assert.NotNil(t, err)
 }
+
+func TestClient_GetDeviceInventoryTypes(t *testing.T) {
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	opts := initOptions(ts)
+
+	c, _ := NewClient(opts)
+
+	_, err := c.GetDeviceInventoryTypes(123000)
+	assert.NotNil(t, err)
+
+	v, err := c.GetDeviceInventoryTypes(123456)
+	assert.Nil(t, err)
+	assert.Contains(t, v, "deviceManagement")
+	assert.Contains(t, v, "deviceCapabilities")
+
+	// inventory types which are not collected yet are skipped
+	v, err = c.GetDeviceInventoryTypes(123999)
+	assert.Nil(t, err)
+	assert.Empty(t, v)
+}
@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"fmt"
+	"strconv"
+	"terraform-provider-ome/models"
+)
+
+// OnboardDevices - creates a job to onboard devices with the given connection profile and managed state
+func (c *Client) OnboardDevices(deviceIDs []int64, managedState int64, connectionProfile string, opts JobOpts) (JobResp, error) {
+	targets := make([]models.JobTargetType, 0)
+	for _, id := range deviceIDs {
+		targets = append(targets, models.JobTargetType{
+			ID:         id,
+			TargetType: models.DeviceTargetType,
+		})
+	}
+	payload := models.JobPayload{
+		Enabled:        true,
+		JobName:        opts.Name,
+		JobDescription: opts.Description,
+		Schedule:       opts.getSchedule(),
+		JobType:        models.OnboardingJobType,
+		Params: map[string]string{
+			"connectionProfile": connectionProfile,
+			"managedState":      strconv.FormatInt(managedState, 10),
+		},
+		Targets: targets,
+	}
+	response, err := c.CreateJob(payload)
+	if err != nil {
+		return JobResp{}, fmt.Errorf("error creating device onboarding job: %w", err)
+	}
+	return response, nil
+}
@@ -0,0 +1,44 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_OnboardDevices(t *testing.T) {
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	opts := initOptions(ts)
+
+	c, _ := NewClient(opts)
+
+	v, err := c.OnboardDevices([]int64{1, 2}, 3000, `{"Type":"DISCOVERY"}`, JobOpts{
+		Name:        "valid",
+		Description: "valid job",
+		RunNow:      true,
+	})
+	assert.Nil(t, err)
+	assert.NotEmpty(t, v.JobName)
+
+	_, err = c.OnboardDevices([]int64{1, 2}, 3000, `{"Type":"DISCOVERY"}`, JobOpts{
+		Name:        "invalid",
+		Description: "invalid job",
+		RunNow:      true,
+	})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,70 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"fmt"
+	"terraform-provider-ome/models"
+)
+
+// GetDeviceProperties - returns the name and the asset tag of a device
+func (c *Client) GetDeviceProperties(deviceID int64) (models.DeviceProperties, error) {
+	props := models.DeviceProperties{}
+	response, err := c.Get(fmt.Sprintf(DeviceByIDAPI, deviceID), nil, nil)
+	if err != nil {
+		return props, err
+	}
+	bodyData, getBodyError := c.GetBodyData(response.Body)
+	if getBodyError != nil {
+		return props, getBodyError
+	}
+	err = c.JSONUnMarshal(bodyData, &props)
+	return props, err
+}
+
+// UpdateDeviceProperties - updates the name and the asset tag of a device
+func (c *Client) UpdateDeviceProperties(props models.DeviceProperties) error {
+	data, err := c.JSONMarshal(props)
+	if err != nil {
+		return err
+	}
+	_, err = c.Put(fmt.Sprintf(DeviceByIDAPI, props.ID), nil, data)
+	return err
+}
+
+// GetDeviceLocation - returns the location setting of a device
+func (c *Client) GetDeviceLocation(deviceID int64) (models.DeviceLocationSetting, error) {
+	location := models.DeviceLocationSetting{}
+	response, err := c.Get(fmt.Sprintf(DeviceLocationSettingAPI, deviceID), nil, nil)
+	if err != nil {
+		return location, err
+	}
+	bodyData, getBodyError := c.GetBodyData(response.Body)
+	if getBodyError != nil {
+		return location, getBodyError
+	}
+	err = c.JSONUnMarshal(bodyData, &location)
+	return location, err
+}
+
+// UpdateDeviceLocation - updates the location setting of a device
+func (c *Client) UpdateDeviceLocation(deviceID int64, location models.DeviceLocationSetting) error {
+	location.SettingType = "Location"
+	data, err := c.JSONMarshal(location)
+	if err != nil {
+		return err
+	}
+	_, err = c.Put(fmt.Sprintf(DeviceLocationSettingAPI, deviceID), nil, data)
+	return err
+}
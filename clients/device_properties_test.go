@@ -0,0 +1,70 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"terraform-provider-ome/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_DeviceProperties(t *testing.T) {
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	opts := initOptions(ts)
+
+	c, _ := NewClient(opts)
+
+	props, err := c.GetDeviceProperties(10101)
+	assert.Nil(t, err)
+	assert.Equal(t, "server-1", props.DeviceName)
+	assert.Equal(t, "ASSET-1", props.AssetTag)
+
+	props.AssetTag = "ASSET-2"
+	err = c.UpdateDeviceProperties(props)
+	assert.Nil(t, err)
+
+	_, err = c.GetDeviceProperties(10102)
+	assert.NotNil(t, err)
+
+	err = c.UpdateDeviceProperties(models.DeviceProperties{ID: 10102, DeviceName: "server-2"})
+	assert.NotNil(t, err)
+}
+
+func TestClient_DeviceLocation(t *testing.T) {
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	opts := initOptions(ts)
+
+	c, _ := NewClient(opts)
+
+	location, err := c.GetDeviceLocation(10101)
+	assert.Nil(t, err)
+	assert.Equal(t, "dc1", location.DataCenter)
+	assert.Equal(t, "r1", location.RackName)
+	assert.Equal(t, int64(4), location.RackSlot)
+
+	location.RackSlot = 5
+	err = c.UpdateDeviceLocation(10101, location)
+	assert.Nil(t, err)
+
+	_, err = c.GetDeviceLocation(10102)
+	assert.NotNil(t, err)
+
+	err = c.UpdateDeviceLocation(10102, models.DeviceLocationSetting{DataCenter: "dc2"})
+	assert.NotNil(t, err)
+}
@@ -276,3 +276,65 @@ func (c *Client) GetValidGroupsByNames(names []string) ([]models.Group, error) {
 	}
 	return filteredGroups, nil
 }
+
+// CreateQueryGroup - Creates a new query device group and returns its id
+func (c *Client) CreateQueryGroup(group models.Group, query models.QueryGroupExtension) (int64, error) {
+	group.ID = 0
+	payload := map[string]any{
+		"GroupModel":          group,
+		"GroupModelExtension": query,
+	}
+	payloadb, err := c.JSONMarshal(payload)
+	if err != nil {
+		return 0, err
+	}
+	path := fmt.Sprintf(GroupServiceActionsAPI, "Create")
+	response, err2 := c.Post(path, nil, payloadb)
+	if err2 != nil {
+		return 0, err2
+	}
+	respData, getBodyError := c.GetBodyData(response.Body)
+	if getBodyError != nil {
+		return 0, getBodyError
+	}
+	return strconv.ParseInt(string(respData), 10, 64)
+}
+
+// GetQueryContext - returns the tables and fields of a query context
+func (c *Client) GetQueryContext(id int64) (models.QueryContext, error) {
+	queryContext := models.QueryContext{}
+	response, err := c.Get(fmt.Sprintf(QueryContextAPI, id), nil, nil)
+	if err != nil {
+		return queryContext, err
+	}
+	bodyData, getBodyError := c.GetBodyData(response.Body)
+	if getBodyError != nil {
+		return queryContext, getBodyError
+	}
+	err = c.JSONUnMarshal(bodyData, &queryContext)
+	return queryContext, err
+}
+
+// GetQueryOperators - returns the operators of the query conditions
+func (c *Client) GetQueryOperators() ([]models.QueryOperator, error) {
+	info := models.QueryOperatorInfo{}
+	response, err := c.Get(QueryOperatorsAPI, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyData, getBodyError := c.GetBodyData(response.Body)
+	if getBodyError != nil {
+		return nil, getBodyError
+	}
+	err = c.JSONUnMarshal(bodyData, &info)
+	return info.AvailableOperators, err
+}
+
+// GetSubGroupsByGroupID - returns the direct sub groups of a group
+func (c *Client) GetSubGroupsByGroupID(id int64) ([]models.Group, error) {
+	subGroups := []models.Group{}
+	err := c.GetValueWithPagination(RequestOptions{
+		URL: fmt.Sprintf(GroupServiceSubGroupsAPI, id),
+	}, &subGroups)
+	return subGroups, err
+}
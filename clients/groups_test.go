@@ -449,3 +449,66 @@ func TestClient_DeleteGroup(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_CreateQueryGroup(t *testing.T) {
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	opts := initOptions(ts)
+
+	c, _ := NewClient(opts)
+
+	query := models.QueryGroupExtension{
+		ContextID: 2,
+		Conditions: []models.QueryCondition{
+			{LogicalOperatorID: 1, FieldID: 10, OperatorID: 1, Value: "PowerEdge R650"},
+		},
+	}
+
+	id, err := c.CreateQueryGroup(models.Group{Name: "TestGroup", ParentID: 1011, MembershipTypeID: 24}, query)
+	assert.Nil(t, err)
+	assert.EqualValues(t, 1012, id)
+
+	_, err = c.CreateQueryGroup(models.Group{Name: "TestGroup", ParentID: 1015, MembershipTypeID: 24}, query)
+	assert.NotNil(t, err)
+}
+
+func TestClient_GetQueryMetadata(t *testing.T) {
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	opts := initOptions(ts)
+
+	c, _ := NewClient(opts)
+
+	queryContext, err := c.GetQueryContext(2)
+	assert.Nil(t, err)
+	assert.Len(t, queryContext.Tables, 2)
+	assert.Equal(t, "Device Model", queryContext.Tables[0].Fields[0].Name)
+
+	_, err = c.GetQueryContext(3)
+	assert.NotNil(t, err)
+
+	operators, err := c.GetQueryOperators()
+	assert.Nil(t, err)
+	assert.Len(t, operators, 3)
+	assert.Equal(t, "contains", operators[2].Name)
+}
+
+func TestClient_GetSubGroupsByGroupID(t *testing.T) {
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	opts := initOptions(ts)
+
+	c, _ := NewClient(opts)
+
+	subGroups, err := c.GetSubGroupsByGroupID(1011)
+	assert.Nil(t, err)
+	assert.Len(t, subGroups, 2)
+	assert.Equal(t, "Room1", subGroups[0].Name)
+	assert.EqualValues(t, 1022, subGroups[1].ID)
+
+	_, err = c.GetSubGroupsByGroupID(1055)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,81 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"fmt"
+	"terraform-provider-ome/models"
+)
+
+// CreateIdentityPool - creates an identity pool and returns the created pool
+func (c *Client) CreateIdentityPool(pool models.IdentityPool) (models.IdentityPool, error) {
+	data, errMarshal := c.JSONMarshal(pool)
+	if errMarshal != nil {
+		return models.IdentityPool{}, errMarshal
+	}
+	response, err := c.Post(IdentityPoolAPI, nil, data)
+	if err != nil {
+		return models.IdentityPool{}, err
+	}
+	created := models.IdentityPool{}
+	bodyData, getBodyError := c.GetBodyData(response.Body)
+	if getBodyError != nil {
+		return created, getBodyError
+	}
+	if err = c.JSONUnMarshal(bodyData, &created); err != nil || created.ID == 0 {
+		// the appliance may only return the id of the created pool
+		created, err = c.GetIdentityPoolByName(pool.Name)
+		if err != nil {
+			return created, err
+		}
+	}
+	return c.GetIdentityPoolByID(created.ID)
+}
+
+// UpdateIdentityPool - updates an identity pool and returns the updated pool
+func (c *Client) UpdateIdentityPool(pool models.IdentityPool) (models.IdentityPool, error) {
+	data, errMarshal := c.JSONMarshal(pool)
+	if errMarshal != nil {
+		return models.IdentityPool{}, errMarshal
+	}
+	_, err := c.Put(fmt.Sprintf(IdentityPoolByIDAPI, pool.ID), nil, data)
+	if err != nil {
+		return models.IdentityPool{}, err
+	}
+	return c.GetIdentityPoolByID(pool.ID)
+}
+
+// DeleteIdentityPool - deletes an identity pool
+func (c *Client) DeleteIdentityPool(id int64) error {
+	_, err := c.Delete(fmt.Sprintf(IdentityPoolByIDAPI, id), nil, nil)
+	return err
+}
+
+// GetIdentitySets - returns the identity sets of the usage of an identity pool
+func (c *Client) GetIdentitySets(poolID int64) ([]models.IdentitySet, error) {
+	sets := []models.IdentitySet{}
+	err := c.GetValueWithPagination(RequestOptions{
+		URL: fmt.Sprintf(IdentityPoolUsageSetsAPI, poolID),
+	}, &sets)
+	return sets, err
+}
+
+// GetIdentitySetUsage - returns the assigned and reserved identities of an identity set of an identity pool
+func (c *Client) GetIdentitySetUsage(poolID, setID int64) ([]models.IdentityUsage, error) {
+	usage := []models.IdentityUsage{}
+	err := c.GetValueWithPagination(RequestOptions{
+		URL: fmt.Sprintf(IdentityPoolUsageDetailsAPI, poolID, setID),
+	}, &usage)
+	return usage, err
+}
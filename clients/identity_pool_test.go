@@ -0,0 +1,85 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"terraform-provider-ome/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_CreateUpdateDeleteIdentityPool(t *testing.T) {
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	opts := initOptions(ts)
+	c, _ := NewClient(opts)
+
+	tests := []struct {
+		name    string
+		pool    string
+		wantErr bool
+	}{
+		{"Create identity pool successfully", "pool1", false},
+		{"Create identity pool without id in response", "noid", true},
+		{"Create identity pool failure", "invalid", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pool, err := c.CreateIdentityPool(models.IdentityPool{Name: tt.pool})
+			assert.Equal(t, tt.wantErr, err != nil)
+			if err == nil {
+				assert.Equal(t, int64(501), pool.ID)
+				assert.Equal(t, int64(10), pool.EthernetSettings.Mac.IdentityCount)
+				assert.Equal(t, "iqn.2009-05.com.example", pool.IscsiSettings.InitiatorConfig.IqnPrefix)
+			}
+		})
+	}
+
+	pool, err := c.UpdateIdentityPool(models.IdentityPool{ID: 501, Name: "pool1"})
+	assert.Nil(t, err)
+	assert.Equal(t, "pool1", pool.Name)
+
+	_, err = c.UpdateIdentityPool(models.IdentityPool{ID: 502, Name: "pool2"})
+	assert.NotNil(t, err)
+
+	assert.Nil(t, c.DeleteIdentityPool(501))
+	assert.NotNil(t, c.DeleteIdentityPool(502))
+}
+
+func TestClient_GetIdentityPoolUsage(t *testing.T) {
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	opts := initOptions(ts)
+	c, _ := NewClient(opts)
+
+	sets, err := c.GetIdentitySets(501)
+	assert.Nil(t, err)
+	assert.Len(t, sets, 3)
+	assert.Equal(t, "Ethernet", sets[0].Name)
+
+	usage, err := c.GetIdentitySetUsage(501, 1)
+	assert.Nil(t, err)
+	assert.Len(t, usage, 3)
+	assert.Equal(t, "Reserved", usage[1].Status)
+
+	usage, err = c.GetIdentitySetUsage(501, 2)
+	assert.Nil(t, err)
+	assert.Empty(t, usage)
+
+	_, err = c.GetIdentitySets(502)
+	assert.NotNil(t, err)
+}
@@ -92,7 +92,9 @@ func createNewTLSServer(t *testing.T) *httptest.Server {
 			return
 		}
 
-		shouldReturn8 := mockNetworkSettingAPIs(r, w)
+		shouldReturn8 := mockNetworkSettingAPIs(r, w) || mockAlertDestinationsAPIs(r, w) || mockAlertPolicyAPIs(r, w) || mockAlertsAPIs(r, w) ||
+			mockAuditLogsAPIs(r, w) || mockOIDCProviderAPIs(r, w) || mockQueryGroupAPIs(r, w) || mockGroupHierarchyAPIs(r, w) || mockDeviceOnboardingAPIs(r, w) || mockDevicePropertiesAPIs(r, w) ||
+			mockWarrantyAPIs(r, w) || mockChassisTopologyAPIs(r, w) || mockIdentityPoolAPIs(r, w) || mockVlanNetworkAPIs(r, w) || mockTemplateExportAPIs(r, w) || mockServerProfileAPIs(r, w)
 		if shouldReturn8 {
 			return
 		}
@@ -3205,3 +3207,440 @@ func mockNetworkSettingAPIs(r *http.Request, w http.ResponseWriter) bool {
 	}
 	return false
 }
+
+func mockAlertDestinationsAPIs(r *http.Request, w http.ResponseWriter) bool {
+	if r.URL.Path == AlertSNMPConfigAPI && r.Method == "GET" {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"value":[{"Id":1,"Enabled":true,"DestinationAddress":"192.0.2.10","PortNumber":162,"SnmpVersion":"SNMPV2","SnmpV1V2Credential":{"Community":"public"}},{"Id":2,"Enabled":false,"DestinationAddress":"","PortNumber":162,"SnmpVersion":"SNMPV1","SnmpV1V2Credential":{"Community":""}}]}`))
+		return true
+	}
+	if r.URL.Path == AlertSyslogConfigAPI && r.Method == "GET" {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"value":[{"Id":1,"Enabled":true,"DestinationAddress":"192.0.2.20","PortNumber":514},{"Id":2,"Enabled":false,"DestinationAddress":"","PortNumber":514}]}`))
+		return true
+	}
+	if (r.URL.Path == AlertSNMPApplyAPI || r.URL.Path == AlertSNMPTestAPI || r.URL.Path == AlertSyslogApplyAPI || r.URL.Path == AlertSyslogTestAPI) && r.Method == "POST" {
+		body, _ := io.ReadAll(r.Body)
+		if strings.Contains(string(body), "invalid") {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":{"code":"Base.1.0.GeneralError","message":"invalid destination address"}}`))
+		} else {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{}`))
+		}
+		return true
+	}
+	return false
+}
+
+func mockAlertPolicyAPIs(r *http.Request, w http.ResponseWriter) bool {
+	policy := `{"Id":1,"Name":"policy1","Description":"","Enabled":true,"DefaultPolicy":false,"Editable":true,"Visible":true,"State":true,
+	"PolicyData":{"Catalogs":[{"CatalogName":"iDRAC","Categories":[4],"SubCategories":[]}],"Severities":[16],"Devices":[10],"Groups":[],
+	"Schedule":{"StartTime":"2025-01-01 00:00:00.000","EndTime":"","CronString":"* * * ? * * *","Interval":false},
+	"Actions":[{"Id":5,"Name":"Trap","TemplateId":60,"ParameterDetails":[{"Id":1,"Name":"trapTarget","Value":"192.0.2.10:162","Type":"string"}]}]}}`
+	if r.URL.Path == fmt.Sprintf(AlertPolicyAPI, 1) && (r.Method == "GET" || r.Method == "PUT") {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(policy))
+		return true
+	}
+	if r.URL.Path == fmt.Sprintf(AlertPolicyAPI, 2) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":{"code":"Base.1.0.GeneralError","message":"policy not found"}}`))
+		return true
+	}
+	if r.URL.Path == AlertPoliciesAPI && r.Method == "GET" {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"value":[` + policy + `]}`))
+		return true
+	}
+	if r.URL.Path == AlertPoliciesAPI && r.Method == "POST" {
+		body, _ := io.ReadAll(r.Body)
+		if strings.Contains(string(body), "invalid") {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":{"code":"Base.1.0.GeneralError","message":"invalid alert policy"}}`))
+		} else if strings.Contains(string(body), "noid") {
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(``))
+		} else {
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(policy))
+		}
+		return true
+	}
+	if (r.URL.Path == AlertPolicyEnableAPI || r.URL.Path == AlertPolicyDisableAPI || r.URL.Path == AlertPolicyRemoveAPI) && r.Method == "POST" {
+		w.WriteHeader(http.StatusNoContent)
+		return true
+	}
+	if r.URL.Path == AlertActionTemplatesAPI && r.Method == "GET" {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"value":[{"Id":60,"Name":"Trap","Description":"Trap","Disabled":false,"ParameterDetails":[{"Id":1,"Name":"trapTarget","Value":"","Type":"string"}]},
+		{"Id":50,"Name":"Email","Description":"Email","Disabled":false,"ParameterDetails":[{"Id":1,"Name":"subject","Value":"","Type":"string"},{"Id":2,"Name":"to","Value":"","Type":"string"}]}]}`))
+		return true
+	}
+	if r.URL.Path == AlertCategoriesAPI && r.Method == "GET" {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"value":[{"Name":"iDRAC","CategoriesDetails":[{"Id":4,"Name":"System Health","CatalogName":"iDRAC","SubCategoryDetails":[{"Id":41,"Name":"Temperature","Description":"Temperature"}]}]},
+		{"Name":"Application","CategoriesDetails":[{"Id":7,"Name":"Audit","CatalogName":"Application","SubCategoryDetails":[]}]}]}`))
+		return true
+	}
+	return false
+}
+
+func mockAlertsAPIs(r *http.Request, w http.ResponseWriter) bool {
+	if r.URL.Path == AlertsAPI && r.Method == "GET" {
+		if strings.Contains(r.URL.Query().Get("$filter"), "invalid") {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":{"code":"Base.1.0.GeneralError","message":"invalid filter"}}`))
+			return true
+		}
+		w.WriteHeader(http.StatusOK)
+		if r.URL.Query().Get("$skip") == "" {
+			w.Write([]byte(`{"value":[{"Id":1,"SeverityType":16,"SeverityName":"Critical","AlertDeviceId":10,"AlertDeviceIdentifier":"SVCTAG1",
+			"CategoryName":"System Health","StatusType":2000,"StatusName":"Not-Acknowledged","TimeStamp":"2025-01-01 10:00:00.000"}],
+			"@odata.nextLink":"` + AlertsAPI + `?$skip=1"}`))
+		} else {
+			w.Write([]byte(`{"value":[{"Id":2,"SeverityType":8,"SeverityName":"Warning","AlertDeviceId":11,"AlertDeviceIdentifier":"SVCTAG2",
+			"CategoryName":"Audit","StatusType":1000,"StatusName":"Acknowledged","TimeStamp":"2025-01-02 10:00:00.000"}]}`))
+		}
+		return true
+	}
+	if (r.URL.Path == AlertAcknowledgeAPI || r.URL.Path == AlertUnacknowledgeAPI || r.URL.Path == AlertRemoveAPI) && r.Method == "POST" {
+		w.WriteHeader(http.StatusNoContent)
+		return true
+	}
+	return false
+}
+
+func mockAuditLogsAPIs(r *http.Request, w http.ResponseWriter) bool {
+	if r.URL.Path == AuditLogsAPI && r.Method == "GET" {
+		if strings.Contains(r.URL.Query().Get("$filter"), "invalid") {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":{"code":"Base.1.0.GeneralError","message":"invalid filter"}}`))
+			return true
+		}
+		w.WriteHeader(http.StatusOK)
+		if r.URL.Query().Get("$skip") == "" {
+			w.Write([]byte(`{"value":[{"Id":1,"Severity":"1000","Message":"Successfully logged in.","Category":"Audit","UserName":"admin",
+			"IpAddress":"192.0.2.1","MessageArgs":"","MessageID":"CUSR1101","CreatedDate":"2025-01-01 10:00:00.000"}],
+			"@odata.nextLink":"` + AuditLogsAPI + `?$skip=1"}`))
+		} else {
+			w.Write([]byte(`{"value":[{"Id":2,"Severity":"2000","Message":"Unable to log in.","Category":"Audit","UserName":"root",
+			"IpAddress":"192.0.2.2","MessageArgs":"","MessageID":"CUSR1102","CreatedDate":"2025-01-02 10:00:00.000"}]}`))
+		}
+		return true
+	}
+	return false
+}
+
+func mockOIDCProviderAPIs(r *http.Request, w http.ResponseWriter) bool {
+	provider := `{"Id":1,"Name":"oidc1","DiscoveryURI":"https://idp.example.com/.well-known/openid-configuration","AuthType":"CLIENT_ID",
+	"ClientId":"ome","VerifyCertificate":true,"Enabled":true}`
+	if r.URL.Path == fmt.Sprintf(OIDCProviderAPI, 1) && (r.Method == "GET" || r.Method == "PUT") {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(provider))
+		return true
+	}
+	if r.URL.Path == fmt.Sprintf(OIDCProviderAPI, 1) && r.Method == "DELETE" {
+		w.WriteHeader(http.StatusNoContent)
+		return true
+	}
+	if r.URL.Path == fmt.Sprintf(OIDCProviderAPI, 2) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":{"code":"Base.1.0.GeneralError","message":"provider not found"}}`))
+		return true
+	}
+	if r.URL.Path == OIDCProvidersAPI && r.Method == "GET" {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"value":[` + provider + `]}`))
+		return true
+	}
+	if r.URL.Path == OIDCProvidersAPI && r.Method == "POST" {
+		body, _ := io.ReadAll(r.Body)
+		if strings.Contains(string(body), "invalid") {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":{"code":"Base.1.0.GeneralError","message":"invalid OpenID Connect provider"}}`))
+		} else if strings.Contains(string(body), "noid") {
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(``))
+		} else {
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(provider))
+		}
+		return true
+	}
+	return false
+}
+
+func mockQueryGroupAPIs(r *http.Request, w http.ResponseWriter) bool {
+	if r.URL.Path == fmt.Sprintf(QueryContextAPI, 2) && r.Method == "GET" {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"Id":2,"Name":"Devices","Tables":[
+			{"Id":1,"Name":"Device","Fields":[{"Id":10,"Name":"Device Model","FieldTypeId":1},{"Id":11,"Name":"Name","FieldTypeId":1}]},
+			{"Id":2,"Name":"Firmware","Fields":[{"Id":20,"Name":"Version","FieldTypeId":1},{"Id":21,"Name":"Name","FieldTypeId":1}]}]}`))
+		return true
+	}
+	if r.URL.Path == fmt.Sprintf(QueryContextAPI, 3) && r.Method == "GET" {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":{"code":"Base.1.0.GeneralError","message":"query context not found"}}`))
+		return true
+	}
+	if r.URL.Path == QueryOperatorsAPI && r.Method == "GET" {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"AvailableOperators":[{"Id":1,"Name":"="},{"Id":2,"Name":"!="},{"Id":7,"Name":"contains"}]}`))
+		return true
+	}
+	return false
+}
+
+func mockGroupHierarchyAPIs(r *http.Request, w http.ResponseWriter) bool {
+	if r.URL.Path == fmt.Sprintf(GroupServiceSubGroupsAPI, 1011) && r.Method == "GET" {
+		w.WriteHeader(http.StatusOK)
+		if r.URL.Query().Get("$skip") == "" {
+			w.Write([]byte(`{"value":[{"Id":1021,"Name":"Room1","ParentId":1011,"MembershipTypeId":12}],
+			"@odata.nextLink":"` + fmt.Sprintf(GroupServiceSubGroupsAPI, 1011) + `?$skip=1"}`))
+		} else {
+			w.Write([]byte(`{"value":[{"Id":1022,"Name":"Room2","ParentId":1011,"MembershipTypeId":12}]}`))
+		}
+		return true
+	}
+	if r.URL.Path == fmt.Sprintf(GroupServiceSubGroupsAPI, 1055) && r.Method == "GET" {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":{"code":"Base.1.0.GeneralError","message":"group not found"}}`))
+		return true
+	}
+	return false
+}
+
+func mockDeviceOnboardingAPIs(r *http.Request, w http.ResponseWriter) bool {
+	if r.URL.Path == fmt.Sprintf(DeviceInventoryAPI, 123999) && r.Method == "GET" {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"value":[{"InventoryType":"serverDeviceCards","InventoryInfo":[]},{"InventoryType":"deviceSoftware","InventoryInfo":null}]}`))
+		return true
+	}
+	return false
+}
+
+func mockDevicePropertiesAPIs(r *http.Request, w http.ResponseWriter) bool {
+	if r.URL.Path == fmt.Sprintf(DeviceByIDAPI, 10101) {
+		w.WriteHeader(http.StatusOK)
+		if r.Method == "GET" {
+			w.Write([]byte(`{"Id":10101,"DeviceName":"server-1","AssetTag":"ASSET-1","DeviceServiceTag":"SVT101"}`))
+		}
+		return true
+	}
+	if r.URL.Path == fmt.Sprintf(DeviceLocationSettingAPI, 10101) {
+		w.WriteHeader(http.StatusOK)
+		if r.Method == "GET" {
+			w.Write([]byte(`{"SettingType":"Location","DataCenter":"dc1","Room":"room1","Aisle":"a1","RackName":"r1","RackSlot":4}`))
+		}
+		return true
+	}
+	if r.URL.Path == fmt.Sprintf(DeviceByIDAPI, 10102) || r.URL.Path == fmt.Sprintf(DeviceLocationSettingAPI, 10102) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":{"code":"Base.1.0.GeneralError","message":"device not found"}}`))
+		return true
+	}
+	return false
+}
+
+func mockWarrantyAPIs(r *http.Request, w http.ResponseWriter) bool {
+	if r.URL.Path == WarrantiesAPI && r.Method == "GET" {
+		w.WriteHeader(http.StatusOK)
+		if r.URL.Query().Get("$skip") == "" {
+			w.Write([]byte(`{"value":[{"Id":1,"DeviceId":10101,"DeviceModel":"PowerEdge R740","DeviceIdentifier":"SVC0001",
+			"ServiceLevelCode":"ND","ServiceLevelDescription":"Next Business Day","ServiceProvider":"DELL",
+			"StartDate":"2022-01-01T00:00:00Z","EndDate":"2027-01-01T00:00:00Z","DaysRemaining":74}],
+			"@odata.nextLink":"` + WarrantiesAPI + `?$skip=1"}`))
+		} else {
+			w.Write([]byte(`{"value":[{"Id":2,"DeviceId":10102,"DeviceModel":"PowerEdge R640","DeviceIdentifier":"SVC0002",
+			"ServiceLevelCode":"PS","ServiceLevelDescription":"ProSupport","ServiceProvider":"DELL",
+			"StartDate":"2020-01-01T00:00:00Z","EndDate":"2023-01-01T00:00:00Z","DaysRemaining":0}]}`))
+		}
+		return true
+	}
+	return false
+}
+
+func mockChassisTopologyAPIs(r *http.Request, w http.ResponseWriter) bool {
+	if r.URL.Path == ManagementDomainsAPI && r.Method == "GET" {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"value":[{"Id":1,"Name":"MX-LEAD","Identifier":"CHS0001","DeviceId":20001,"DomainRoleTypeValue":"LEAD"},
+		{"Id":2,"Name":"MX-MEMBER","Identifier":"CHS0002","DeviceId":20002,"DomainRoleTypeValue":"MEMBER"}]}`))
+		return true
+	}
+	return false
+}
+
+func mockIdentityPoolAPIs(r *http.Request, w http.ResponseWriter) bool {
+	pool := `{"Id":501,"Name":"pool1","Description":"pool","EthernetSettings":{"Mac":{"IdentityCount":10,"StartingMacAddress":"qrvM3e4A"}},
+	"IscsiSettings":{"Mac":{"IdentityCount":5,"StartingMacAddress":"qrvM3e8A"},"InitiatorConfig":{"IqnPrefix":"iqn.2009-05.com.example"},
+	"InitiatorIpPoolSettings":{"IpRange":"10.33.0.1-10.33.0.255","SubnetMask":"255.255.255.0"}},
+	"FcSettings":{"Wwnn":{"IdentityCount":8,"StartingAddress":"IACqu8zd7gA="},"Wwpn":{"IdentityCount":8,"StartingAddress":"IAGqu8zd7gA="}}}`
+	if r.URL.Path == fmt.Sprintf(IdentityPoolByIDAPI, 501) && (r.Method == "GET" || r.Method == "PUT") {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(pool))
+		return true
+	}
+	if r.URL.Path == fmt.Sprintf(IdentityPoolByIDAPI, 501) && r.Method == "DELETE" {
+		w.WriteHeader(http.StatusNoContent)
+		return true
+	}
+	if r.URL.Path == fmt.Sprintf(IdentityPoolByIDAPI, 502) || r.URL.Path == fmt.Sprintf(IdentityPoolUsageSetsAPI, 502) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":{"code":"Base.1.0.GeneralError","message":"identity pool not found"}}`))
+		return true
+	}
+	if r.URL.Path == fmt.Sprintf(IdentityPoolUsageSetsAPI, 501) && r.Method == "GET" {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"value":[{"IdentitySetId":1,"Name":"Ethernet"},{"IdentitySetId":2,"Name":"iSCSI"},{"IdentitySetId":4,"Name":"FC"}]}`))
+		return true
+	}
+	if r.URL.Path == fmt.Sprintf(IdentityPoolUsageDetailsAPI, 501, 1) && r.Method == "GET" {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"value":[{"ServerName":"server1","NicIdentifier":"NIC.Mezzanine.1A-1-1","MacAddress":"AA:BB:CC:DD:EE:00","Status":"Assigned"},
+			{"ServerName":"server2","NicIdentifier":"NIC.Mezzanine.1A-1-1","MacAddress":"AA:BB:CC:DD:EE:01","Status":"Reserved"},
+			{"ServerName":"server3","NicIdentifier":"NIC.Mezzanine.1A-1-1","MacAddress":"AA:BB:CC:DD:EE:02","Status":"Assigned"}]}`))
+		return true
+	}
+	if strings.HasPrefix(r.URL.Path, fmt.Sprintf(IdentityPoolUsageSetsAPI, 501)+"(") && r.Method == "GET" {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"value":[]}`))
+		return true
+	}
+	if r.URL.Path == IdentityPoolAPI && r.Method == "POST" {
+		body, _ := io.ReadAll(r.Body)
+		if strings.Contains(string(body), "invalid") {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":{"code":"Base.1.0.GeneralError","message":"invalid identity pool"}}`))
+		} else if strings.Contains(string(body), "noid") {
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(``))
+		} else {
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(pool))
+		}
+		return true
+	}
+	return false
+}
+
+func mockVlanNetworkAPIs(r *http.Request, w http.ResponseWriter) bool {
+	network := `{"Id":601,"Name":"vlan601","Description":"vlan","VlanMinimum":601,"VlanMaximum":610,"Type":3,"InternalRefNWUUId":"8a2f6e3c-7d1b-4f5e-9c0a-1b2c3d4e5f60"}`
+	if r.URL.Path == fmt.Sprintf(VlanNetworkAPI, 601) && (r.Method == "GET" || r.Method == "PUT") {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(network))
+		return true
+	}
+	if r.URL.Path == fmt.Sprintf(VlanNetworkAPI, 601) && r.Method == "DELETE" {
+		w.WriteHeader(http.StatusNoContent)
+		return true
+	}
+	if r.URL.Path == fmt.Sprintf(VlanNetworkAPI, 602) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":{"code":"Base.1.0.GeneralError","message":"network not found"}}`))
+		return true
+	}
+	if r.URL.Path == NetworkTypesAPI && r.Method == "GET" {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"value":[{"Id":1,"Name":"General Purpose (Bronze)"},{"Id":3,"Name":"General Purpose (Gold)"},
+			{"Id":7,"Name":"Storage - iSCSI"},{"Id":10,"Name":"VM Migration"}]}`))
+		return true
+	}
+	if r.URL.Path == VlanNetworksAPI && r.Method == "POST" {
+		body, _ := io.ReadAll(r.Body)
+		if strings.Contains(string(body), "invalid") {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":{"code":"Base.1.0.GeneralError","message":"invalid network"}}`))
+		} else if strings.Contains(string(body), "noid") {
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(``))
+		} else {
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(network))
+		}
+		return true
+	}
+	return false
+}
+
+func mockTemplateExportAPIs(r *http.Request, w http.ResponseWriter) bool {
+	if r.URL.Path == ExportTemplateAPI && r.Method == "POST" {
+		body, _ := io.ReadAll(r.Body)
+		if strings.Contains(string(body), `"TemplateId":23`) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"TemplateId":23,"ViewTypeId":2,"Content":"<SystemConfiguration Model=\"PowerEdge R740\"></SystemConfiguration>"}`))
+			return true
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error":{"code":"Base.1.0.GeneralError","message":"template not found"}}`))
+		return true
+	}
+	if r.URL.Path == fmt.Sprintf(TemplateEditInfoAPI, 2173) && r.Method == "GET" {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"Id":2173,"DataType":"Enumeration","PossibleValues":[{"Value":"Enabled","DisplayValue":"Enabled"},{"Value":"Disabled","DisplayValue":"Disabled"}]}`))
+		return true
+	}
+	if r.URL.Path == fmt.Sprintf(TemplateEditInfoAPI, 2174) && r.Method == "GET" {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":{"code":"Base.1.0.GeneralError","message":"edit info not found"}}`))
+		return true
+	}
+	return false
+}
+
+func mockServerProfileAPIs(r *http.Request, w http.ResponseWriter) bool {
+	profile := `{"Id":701,"ProfileName":"profile1","ProfileDescription":"profile","TemplateId":23,"TemplateName":"template1",
+	"TargetId":10001,"TargetName":"server1","ProfileState":4,"LastRunStatus":2060}`
+	if r.URL.Path == ProfileAPI && r.Method == "POST" {
+		body, _ := io.ReadAll(r.Body)
+		if strings.Contains(string(body), `"NamePrefix":"profile1"`) {
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`[701]`))
+		} else if strings.Contains(string(body), `"NamePrefix":"many"`) {
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`[701,702]`))
+		} else {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":{"code":"Base.1.0.GeneralError","message":"template not found"}}`))
+		}
+		return true
+	}
+	if r.URL.Path == ProfileAPI && r.Method == "GET" {
+		w.WriteHeader(http.StatusOK)
+		if r.URL.Query().Get("$filter") == "ProfileName eq 'profile1'" {
+			w.Write([]byte(`{"value":[` + profile + `]}`))
+		} else {
+			w.Write([]byte(`{"value":[]}`))
+		}
+		return true
+	}
+	if r.URL.Path == fmt.Sprintf(ProfileByIDAPI, 701) && (r.Method == "GET" || r.Method == "PUT") {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(profile))
+		return true
+	}
+	if r.URL.Path == fmt.Sprintf(ProfileAttributesAPI, 701) && r.Method == "GET" {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"Id":701,"AttributeGroups":[{"GroupNameId":1,"DisplayName":"BIOS","Attributes":[],
+		"SubAttributeGroups":[{"GroupNameId":2,"DisplayName":"Boot Settings","SubAttributeGroups":[],
+		"Attributes":[{"AttributeId":1001,"DisplayName":"Boot Mode","Value":"Uefi","IsIgnored":false}]}]}]}`))
+		return true
+	}
+	if r.URL.Path == fmt.Sprintf(ProfileByIDAPI, 702) || r.URL.Path == fmt.Sprintf(ProfileAttributesAPI, 702) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":{"code":"Base.1.0.GeneralError","message":"profile not found"}}`))
+		return true
+	}
+	if (r.URL.Path == AssignProfileAPI || r.URL.Path == MigrateProfileAPI || r.URL.Path == RedeployProfilesAPI) && r.Method == "POST" {
+		body, _ := io.ReadAll(r.Body)
+		if strings.Contains(string(body), "701") {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`10870`))
+		} else {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":{"code":"Base.1.0.GeneralError","message":"profile not found"}}`))
+		}
+		return true
+	}
+	return false
+}
@@ -13,7 +13,10 @@ limitations under the License.
 
 package clients
 
-import "terraform-provider-ome/models"
+import (
+	"fmt"
+	"terraform-provider-ome/models"
+)
 
 // GetAllVlanNetworks returns the vlan data from OME
 func (c *Client) GetAllVlanNetworks() ([]models.VLanNetworks, error) {
@@ -24,3 +27,80 @@ func (c *Client) GetAllVlanNetworks() ([]models.VLanNetworks, error) {
 	}
 	return vlanData, nil
 }
+
+// GetVlanNetworkByID returns the vlan network with the given id
+func (c *Client) GetVlanNetworkByID(id int64) (models.VLanNetworks, error) {
+	network := models.VLanNetworks{}
+	response, err := c.Get(fmt.Sprintf(VlanNetworkAPI, id), nil, nil)
+	if err != nil {
+		return network, err
+	}
+	bodyData, getBodyError := c.GetBodyData(response.Body)
+	if getBodyError != nil {
+		return network, getBodyError
+	}
+	err = c.JSONUnMarshal(bodyData, &network)
+	return network, err
+}
+
+// GetVlanNetworkByName returns the vlan network with the given name
+func (c *Client) GetVlanNetworkByName(name string) (models.VLanNetworks, error) {
+	networks, err := c.GetAllVlanNetworks()
+	if err != nil {
+		return models.VLanNetworks{}, err
+	}
+	for _, network := range networks {
+		if network.Name == name {
+			return network, nil
+		}
+	}
+	return models.VLanNetworks{}, fmt.Errorf("vlan network %s does not exist on the appliance", name)
+}
+
+// CreateVlanNetwork creates a vlan network and returns the created network
+func (c *Client) CreateVlanNetwork(network models.VLanNetworks) (models.VLanNetworks, error) {
+	data, errMarshal := c.JSONMarshal(network)
+	if errMarshal != nil {
+		return models.VLanNetworks{}, errMarshal
+	}
+	response, err := c.Post(VlanNetworksAPI, nil, data)
+	if err != nil {
+		return models.VLanNetworks{}, err
+	}
+	created := models.VLanNetworks{}
+	bodyData, getBodyError := c.GetBodyData(response.Body)
+	if getBodyError != nil {
+		return created, getBodyError
+	}
+	if err = c.JSONUnMarshal(bodyData, &created); err != nil || created.ID == 0 {
+		// some appliance versions do not return the created network
+		return c.GetVlanNetworkByName(network.Name)
+	}
+	return created, nil
+}
+
+// UpdateVlanNetwork updates a vlan network and returns the updated network
+func (c *Client) UpdateVlanNetwork(network models.VLanNetworks) (models.VLanNetworks, error) {
+	data, errMarshal := c.JSONMarshal(network)
+	if errMarshal != nil {
+		return models.VLanNetworks{}, errMarshal
+	}
+	_, err := c.Put(fmt.Sprintf(VlanNetworkAPI, network.ID), nil, data)
+	if err != nil {
+		return models.VLanNetworks{}, err
+	}
+	return c.GetVlanNetworkByID(network.ID)
+}
+
+// DeleteVlanNetwork deletes a vlan network
+func (c *Client) DeleteVlanNetwork(id int64) error {
+	_, err := c.Delete(fmt.Sprintf(VlanNetworkAPI, id), nil, nil)
+	return err
+}
+
+// GetNetworkTypes returns the types of the vlan networks
+func (c *Client) GetNetworkTypes() ([]models.NetworkType, error) {
+	networkTypes := []models.NetworkType{}
+	err := c.GetPaginatedData(NetworkTypesAPI, &networkTypes)
+	return networkTypes, err
+}
@@ -58,3 +58,68 @@ func TestClient_GetAllVlanNetworksAnAuth(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Equal(t, []models.VLanNetworks{}, resp)
 }
+
+func TestClient_GetVlanNetwork(t *testing.T) {
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	opts := initOptions(ts)
+	c, _ := NewClient(opts)
+
+	network, err := c.GetVlanNetworkByID(601)
+	assert.Nil(t, err)
+	assert.Equal(t, "vlan601", network.Name)
+	assert.Equal(t, int64(610), network.VLANMaximum)
+
+	_, err = c.GetVlanNetworkByID(602)
+	assert.NotNil(t, err)
+
+	network, err = c.GetVlanNetworkByName("VLAN2")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1235), network.ID)
+
+	_, err = c.GetVlanNetworkByName("unknown")
+	assert.NotNil(t, err)
+
+	networkTypes, err := c.GetNetworkTypes()
+	assert.Nil(t, err)
+	assert.Len(t, networkTypes, 4)
+	assert.Equal(t, "Storage - iSCSI", networkTypes[2].Name)
+}
+
+func TestClient_CreateUpdateDeleteVlanNetwork(t *testing.T) {
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	opts := initOptions(ts)
+	c, _ := NewClient(opts)
+
+	tests := []struct {
+		name    string
+		network models.VLanNetworks
+		wantID  int64
+		wantErr bool
+	}{
+		{"Create vlan network successfully", models.VLanNetworks{Name: "vlan601"}, 601, false},
+		{"Create vlan network without id in response", models.VLanNetworks{Name: "VLAN2", Description: "noid"}, 1235, false},
+		{"Create vlan network without id in response and unknown name", models.VLanNetworks{Name: "noid"}, 0, true},
+		{"Create vlan network failure", models.VLanNetworks{Name: "invalid"}, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			network, err := c.CreateVlanNetwork(tt.network)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.wantID, network.ID)
+		})
+	}
+
+	network, err := c.UpdateVlanNetwork(models.VLanNetworks{ID: 601, Name: "vlan601"})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(601), network.VLANMinimum)
+
+	_, err = c.UpdateVlanNetwork(models.VLanNetworks{ID: 602, Name: "vlan602"})
+	assert.NotNil(t, err)
+
+	assert.Nil(t, c.DeleteVlanNetwork(601))
+	assert.NotNil(t, c.DeleteVlanNetwork(602))
+}
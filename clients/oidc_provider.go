@@ -0,0 +1,92 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"fmt"
+	"terraform-provider-ome/models"
+)
+
+// GetOIDCProviderByID - returns the OpenID Connect provider with the given id
+func (c *Client) GetOIDCProviderByID(id int64) (models.OIDCProvider, error) {
+	provider := models.OIDCProvider{}
+	response, err := c.Get(fmt.Sprintf(OIDCProviderAPI, id), nil, nil)
+	if err != nil {
+		return provider, err
+	}
+	bodyData, getBodyError := c.GetBodyData(response.Body)
+	if getBodyError != nil {
+		return provider, getBodyError
+	}
+	err = c.JSONUnMarshal(bodyData, &provider)
+	return provider, err
+}
+
+// GetOIDCProviderByName - returns the OpenID Connect provider with the given name
+func (c *Client) GetOIDCProviderByName(name string) (models.OIDCProvider, error) {
+	providers := []models.OIDCProvider{}
+	err := c.GetValueWithPagination(RequestOptions{
+		URL: OIDCProvidersAPI,
+	}, &providers)
+	if err != nil {
+		return models.OIDCProvider{}, err
+	}
+	for _, provider := range providers {
+		if provider.Name == name {
+			return provider, nil
+		}
+	}
+	return models.OIDCProvider{}, fmt.Errorf("OpenID Connect provider %s does not exist on the appliance", name)
+}
+
+// CreateOIDCProvider - registers an OpenID Connect provider and returns the created provider
+func (c *Client) CreateOIDCProvider(provider models.OIDCProvider) (models.OIDCProvider, error) {
+	data, errMarshal := c.JSONMarshal(provider)
+	if errMarshal != nil {
+		return models.OIDCProvider{}, errMarshal
+	}
+	response, err := c.Post(OIDCProvidersAPI, nil, data)
+	if err != nil {
+		return models.OIDCProvider{}, err
+	}
+	created := models.OIDCProvider{}
+	bodyData, getBodyError := c.GetBodyData(response.Body)
+	if getBodyError != nil {
+		return created, getBodyError
+	}
+	if err = c.JSONUnMarshal(bodyData, &created); err != nil || created.ID == 0 {
+		// some appliance versions do not return the created provider
+		return c.GetOIDCProviderByName(provider.Name)
+	}
+	return created, nil
+}
+
+// UpdateOIDCProvider - updates an OpenID Connect provider and returns the updated provider
+func (c *Client) UpdateOIDCProvider(provider models.OIDCProvider) (models.OIDCProvider, error) {
+	data, errMarshal := c.JSONMarshal(provider)
+	if errMarshal != nil {
+		return models.OIDCProvider{}, errMarshal
+	}
+	_, err := c.Put(fmt.Sprintf(OIDCProviderAPI, provider.ID), nil, data)
+	if err != nil {
+		return models.OIDCProvider{}, err
+	}
+	return c.GetOIDCProviderByID(provider.ID)
+}
+
+// DeleteOIDCProvider - removes an OpenID Connect provider
+func (c *Client) DeleteOIDCProvider(id int64) error {
+	_, err := c.Delete(fmt.Sprintf(OIDCProviderAPI, id), nil, nil)
+	return err
+}
@@ -0,0 +1,78 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"terraform-provider-ome/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_GetOIDCProvider(t *testing.T) {
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	opts := initOptions(ts)
+	c, _ := NewClient(opts)
+
+	provider, err := c.GetOIDCProviderByID(1)
+	assert.Nil(t, err)
+	assert.Equal(t, "oidc1", provider.Name)
+	assert.Equal(t, "CLIENT_ID", provider.AuthType)
+
+	_, err = c.GetOIDCProviderByID(2)
+	assert.NotNil(t, err)
+
+	provider, err = c.GetOIDCProviderByName("oidc1")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), provider.ID)
+
+	_, err = c.GetOIDCProviderByName("unknown")
+	assert.NotNil(t, err)
+}
+
+func TestClient_CreateUpdateDeleteOIDCProvider(t *testing.T) {
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	opts := initOptions(ts)
+	c, _ := NewClient(opts)
+
+	tests := []struct {
+		name     string
+		provider string
+		wantErr  bool
+	}{
+		{"Create OpenID Connect provider successfully", "oidc1", false},
+		{"Create OpenID Connect provider without id in response", "noid", true},
+		{"Create OpenID Connect provider failure", "invalid", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider, err := c.CreateOIDCProvider(models.OIDCProvider{Name: tt.provider})
+			assert.Equal(t, tt.wantErr, err != nil)
+			if err == nil {
+				assert.Equal(t, int64(1), provider.ID)
+			}
+		})
+	}
+
+	provider, err := c.UpdateOIDCProvider(models.OIDCProvider{ID: 1, Name: "oidc1", Enabled: false})
+	assert.Nil(t, err)
+	assert.Equal(t, "oidc1", provider.Name)
+
+	assert.Nil(t, c.DeleteOIDCProvider(1))
+	assert.NotNil(t, c.DeleteOIDCProvider(2))
+}
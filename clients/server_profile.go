@@ -0,0 +1,156 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"terraform-provider-ome/models"
+)
+
+// CreateProfile creates a server profile from a template and returns its id
+func (c *Client) CreateProfile(profile models.OMECreateProfile) (int64, error) {
+	data, errMarshal := c.JSONMarshal(profile)
+	if errMarshal != nil {
+		return -1, errMarshal
+	}
+	response, err := c.Post(ProfileAPI, nil, data)
+	if err != nil {
+		return -1, err
+	}
+	respData, getBodyError := c.GetBodyData(response.Body)
+	if getBodyError != nil {
+		return -1, getBodyError
+	}
+	ids := []int64{}
+	err = c.JSONUnMarshal(respData, &ids)
+	if err != nil {
+		return -1, err
+	}
+	if len(ids) != 1 {
+		return -1, fmt.Errorf("expected one profile to be created, got %d", len(ids))
+	}
+	return ids[0], nil
+}
+
+// GetProfileByID returns the server profile by id
+func (c *Client) GetProfileByID(id int64) (models.OMEProfile, error) {
+	profile := models.OMEProfile{}
+	response, err := c.Get(fmt.Sprintf(ProfileByIDAPI, id), nil, nil)
+	if err != nil {
+		return profile, err
+	}
+	respData, getBodyError := c.GetBodyData(response.Body)
+	if getBodyError != nil {
+		return profile, getBodyError
+	}
+	err = c.JSONUnMarshal(respData, &profile)
+	return profile, err
+}
+
+// GetProfileByName returns the server profile by name
+func (c *Client) GetProfileByName(name string) (models.OMEProfile, error) {
+	profiles := []models.OMEProfile{}
+	err := c.GetPaginatedDataWithQueryParam(ProfileAPI, map[string]string{"$filter": fmt.Sprintf("ProfileName eq '%s'", name)}, &profiles)
+	if err != nil {
+		return models.OMEProfile{}, err
+	}
+	for _, profile := range profiles {
+		if profile.ProfileName == name {
+			return profile, nil
+		}
+	}
+	return models.OMEProfile{}, fmt.Errorf("server profile %s does not exist", name)
+}
+
+// UpdateProfile updates the name, description and attributes of a server profile
+func (c *Client) UpdateProfile(profile models.OMEUpdateProfile) error {
+	data, errMarshal := c.JSONMarshal(profile)
+	if errMarshal != nil {
+		return errMarshal
+	}
+	_, err := c.Put(fmt.Sprintf(ProfileByIDAPI, profile.ID), nil, data)
+	return err
+}
+
+// GetProfileAttributes returns the attributes of a server profile
+func (c *Client) GetProfileAttributes(id int64) ([]models.OmeAttribute, error) {
+	response, err := c.Get(fmt.Sprintf(ProfileAttributesAPI, id), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	respData, getBodyError := c.GetBodyData(response.Body)
+	if getBodyError != nil {
+		return nil, getBodyError
+	}
+	attrGroups := models.OMETemplateAttrGroups{}
+	err = c.JSONUnMarshal(respData, &attrGroups)
+	if err != nil {
+		return nil, err
+	}
+	omeAttributes := []models.OmeAttribute{}
+	refreshAllAttributes(attrGroups.AttributeGroups, &omeAttributes)
+	return omeAttributes, nil
+}
+
+// AssignProfile assigns a server profile to a device and returns the id of the deployment job
+func (c *Client) AssignProfile(assign models.OMEAssignProfile) (int64, error) {
+	return c.postProfileAction(AssignProfileAPI, assign)
+}
+
+// MigrateProfile migrates a server profile with its identities to another device and returns the id of the migration job
+func (c *Client) MigrateProfile(migrate models.OMEMigrateProfile) (int64, error) {
+	return c.postProfileAction(MigrateProfileAPI, migrate)
+}
+
+// RedeployProfiles redeploys the server profiles to their devices and returns the id of the deployment job
+func (c *Client) RedeployProfiles(redeploy models.OMERedeployProfiles) (int64, error) {
+	return c.postProfileAction(RedeployProfilesAPI, redeploy)
+}
+
+// UnassignProfiles unassigns the server profiles from their devices and returns the id of the job
+func (c *Client) UnassignProfiles(ids []int64) (int64, error) {
+	return c.postProfileAction(UnAssignProfileAPI, models.ProfileDeleteRequest{ProfileIds: ids})
+}
+
+// DeleteProfiles deletes the unassigned server profiles
+func (c *Client) DeleteProfiles(ids []int64) error {
+	data, errMarshal := c.JSONMarshal(models.ProfileDeleteRequest{ProfileIds: ids})
+	if errMarshal != nil {
+		return errMarshal
+	}
+	_, err := c.Post(DeleteProfileAPI, nil, data)
+	return err
+}
+
+func (c *Client) postProfileAction(url string, payload interface{}) (int64, error) {
+	data, errMarshal := c.JSONMarshal(payload)
+	if errMarshal != nil {
+		return -1, errMarshal
+	}
+	response, err := c.Post(url, nil, data)
+	if err != nil {
+		return -1, err
+	}
+	respData, getBodyError := c.GetBodyData(response.Body)
+	if getBodyError != nil {
+		return -1, getBodyError
+	}
+	jobID, parseErr := strconv.ParseInt(strings.TrimSpace(string(respData)), 10, 64)
+	if parseErr != nil {
+		return -1, parseErr
+	}
+	return jobID, nil
+}
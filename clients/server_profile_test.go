@@ -0,0 +1,115 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"terraform-provider-ome/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_CreateProfile(t *testing.T) {
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	opts := initOptions(ts)
+	c, _ := NewClient(opts)
+
+	tests := []struct {
+		name    string
+		prefix  string
+		wantErr bool
+	}{
+		{"Create server profile successfully", "profile1", false},
+		{"Create several server profiles", "many", true},
+		{"Create server profile failure", "invalid", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := c.CreateProfile(models.OMECreateProfile{TemplateID: 23, NamePrefix: tt.prefix, NumberOfProfilesToCreate: 1})
+			assert.Equal(t, tt.wantErr, err != nil)
+			if err == nil {
+				assert.Equal(t, int64(701), id)
+			}
+		})
+	}
+}
+
+func TestClient_GetProfile(t *testing.T) {
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	opts := initOptions(ts)
+	c, _ := NewClient(opts)
+
+	profile, err := c.GetProfileByID(701)
+	assert.Nil(t, err)
+	assert.Equal(t, "profile1", profile.ProfileName)
+	assert.Equal(t, int64(10001), profile.TargetID)
+
+	_, err = c.GetProfileByID(702)
+	assert.NotNil(t, err)
+
+	profile, err = c.GetProfileByName("profile1")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(701), profile.ID)
+
+	_, err = c.GetProfileByName("profile2")
+	assert.NotNil(t, err)
+
+	attributes, err := c.GetProfileAttributes(701)
+	assert.Nil(t, err)
+	assert.Len(t, attributes, 1)
+	assert.Equal(t, "BIOS,Boot Settings,Boot Mode", attributes[0].DisplayName)
+
+	_, err = c.GetProfileAttributes(702)
+	assert.NotNil(t, err)
+
+	assert.Nil(t, c.UpdateProfile(models.OMEUpdateProfile{ID: 701, ProfileName: "profile1", TemplateID: 23}))
+	assert.NotNil(t, c.UpdateProfile(models.OMEUpdateProfile{ID: 702, ProfileName: "profile2", TemplateID: 23}))
+}
+
+func TestClient_ProfileActions(t *testing.T) {
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	opts := initOptions(ts)
+	c, _ := NewClient(opts)
+
+	jobID, err := c.AssignProfile(models.OMEAssignProfile{ID: 701, TargetID: 10001})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10870), jobID)
+
+	_, err = c.AssignProfile(models.OMEAssignProfile{ID: 702, TargetID: 10001})
+	assert.NotNil(t, err)
+
+	jobID, err = c.MigrateProfile(models.OMEMigrateProfile{SourceID: 701, TargetID: 10002})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10870), jobID)
+
+	jobID, err = c.RedeployProfiles(models.OMERedeployProfiles{SourceIDList: []int64{701}})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10870), jobID)
+
+	jobID, err = c.UnassignProfiles([]int64{10850})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10860), jobID)
+
+	_, err = c.UnassignProfiles([]int64{10851})
+	assert.NotNil(t, err)
+
+	assert.Nil(t, c.DeleteProfiles([]int64{10850}))
+	assert.NotNil(t, c.DeleteProfiles([]int64{10852}))
+}
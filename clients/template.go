@@ -118,10 +118,12 @@ func findAttributes(displayName string, subAttrGroups []models.SubAttributeGroup
 			for _, attr := range attributes {
 
 				attribute := models.OmeAttribute{
-					DisplayName: fmt.Sprintf("%s,%s,%s", displayName, currentSubAttrGroup.DisplayName, attr.DisplayName),
-					AttributeID: attr.AttributeID,
-					Value:       attr.Value,
-					IsIgnored:   attr.IsIgnored,
+					DisplayName:         fmt.Sprintf("%s,%s,%s", displayName, currentSubAttrGroup.DisplayName, attr.DisplayName),
+					AttributeID:         attr.AttributeID,
+					AttributeEditInfoID: attr.AttributeEditInfoID,
+					Value:               attr.Value,
+					IsIgnored:           attr.IsIgnored,
+					IsReadOnly:          attr.IsReadOnly,
 				}
 				*omeAttributes = append(*omeAttributes, attribute)
 			}
@@ -456,6 +458,46 @@ func (c *Client) ImportTemplate(importTemplateRequest models.OMEImportTemplate)
 	return newTemplateID, nil
 }
 
+// ExportTemplate returns the content of the template in the given format
+func (c *Client) ExportTemplate(templateID int64, format string) (string, error) {
+	data, errMarshal := c.JSONMarshal(models.OMEExportTemplate{
+		TemplateID: templateID,
+		Format:     format,
+	})
+	if errMarshal != nil {
+		return "", errMarshal
+	}
+	response, err := c.Post(ExportTemplateAPI, nil, data)
+	if err != nil {
+		return "", err
+	}
+	respData, getBodyError := c.GetBodyData(response.Body)
+	if getBodyError != nil {
+		return "", getBodyError
+	}
+	exported := models.OMEExportedTemplate{}
+	err = c.JSONUnMarshal(respData, &exported)
+	if err != nil {
+		return "", err
+	}
+	return exported.Content, nil
+}
+
+// GetTemplateEditInfo returns the constraints on the value of a template attribute
+func (c *Client) GetTemplateEditInfo(editInfoID int64) (models.OMEAttributeEditInfo, error) {
+	editInfo := models.OMEAttributeEditInfo{}
+	response, err := c.Get(fmt.Sprintf(TemplateEditInfoAPI, editInfoID), nil, nil)
+	if err != nil {
+		return editInfo, err
+	}
+	respData, getBodyError := c.GetBodyData(response.Body)
+	if getBodyError != nil {
+		return editInfo, getBodyError
+	}
+	err = c.JSONUnMarshal(respData, &editInfo)
+	return editInfo, err
+}
+
 func getAllVlanAttributes(nags []models.NetworkAttributeGroup) []models.OMEVlanAttribute {
 	vlanAttrs := []models.OMEVlanAttribute{}
 	for _, nicIdentifier := range nags { // Loops NIC identifiers
@@ -514,6 +514,7 @@ func TestClient_GetDeviceTypeID(t *testing.T) {
 	}{
 		{"Get Device Type ID Successfully - Server", "Server", 2},
 		{"Get Device Type ID Successfully - Chassis", "Chassis", 4},
+		{"Get Device Type ID Successfully - IO Module", "IO Module", 3},
 		{"Get Device Type ID Fail", "test-device-type", -1},
 	}
 	for _, tt := range tests {
@@ -1067,3 +1068,36 @@ func TestClient_ImportTemplate(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_ExportTemplate(t *testing.T) {
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	opts := initOptions(ts)
+	c, _ := NewClient(opts)
+
+	content, err := c.ExportTemplate(23, "xml")
+	assert.Nil(t, err)
+	assert.Contains(t, content, "<SystemConfiguration")
+
+	content, err = c.ExportTemplate(-1, "xml")
+	assert.NotNil(t, err)
+	assert.Empty(t, content)
+}
+
+func TestClient_GetTemplateEditInfo(t *testing.T) {
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	opts := initOptions(ts)
+	c, _ := NewClient(opts)
+
+	editInfo, err := c.GetTemplateEditInfo(2173)
+	assert.Nil(t, err)
+	assert.Equal(t, "Enumeration", editInfo.DataType)
+	assert.Len(t, editInfo.PossibleValues, 2)
+	assert.Nil(t, editInfo.MaxLength)
+
+	_, err = c.GetTemplateEditInfo(2174)
+	assert.NotNil(t, err)
+}
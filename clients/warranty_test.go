@@ -0,0 +1,35 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_GetWarranties(t *testing.T) {
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	opts := initOptions(ts)
+	c, _ := NewClient(opts)
+
+	warranties, err := c.GetWarranties(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(warranties))
+	assert.Equal(t, "SVC0001", warranties[0].DeviceIdentifier)
+	assert.Equal(t, int64(74), warranties[0].DaysRemaining)
+	assert.Equal(t, int64(10102), warranties[1].DeviceID)
+}
@@ -0,0 +1,35 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Get all the alerts
+data "ome_alerts" "all" {
+}
+
+# Get the critical and warning alerts, which are not acknowledged, of some devices raised in a time range
+# All the configured filters must match, device_ids, device_servicetags and group_ids together select the devices
+data "ome_alerts" "maintenance" {
+  filters = {
+    severities         = ["Critical", "Warning"]
+    acknowledged       = false
+    category_names     = ["System Health"]
+    device_ids         = [10001]
+    device_servicetags = ["SVCTAG1"]
+    group_ids          = [1011]
+    start_time         = "2025-01-01 00:00:00.000"
+    end_time           = "2025-01-02 00:00:00.000"
+  }
+}
+
+output "alerts" {
+  value = data.ome_alerts.maintenance.alerts
+}
@@ -0,0 +1,34 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Get all the audit logs
+data "ome_audit_logs" "all" {
+}
+
+# Get the audit logs of a user from a source IP in a time range
+# The filters are applied by the appliance and all the configured filters must match
+data "ome_audit_logs" "evidence" {
+  filters = {
+    user_name  = "admin"
+    source_ip  = "192.0.2.1"
+    category   = "Configuration"
+    severity   = "Info"
+    start_time = "2025-01-01 00:00:00.000"
+    end_time   = "2025-02-01 00:00:00.000"
+  }
+}
+
+# Export the audit logs, for example as JSON to an evidence store
+output "audit_logs" {
+  value = jsonencode(data.ome_audit_logs.evidence.audit_logs)
+}
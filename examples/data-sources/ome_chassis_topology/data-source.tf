@@ -0,0 +1,40 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Topology of all the chassis
+data "ome_chassis_topology" "all" {
+}
+
+# Topology of a single chassis
+data "ome_chassis_topology" "mx1" {
+  chassis_service_tags = ["CHSTAG1"]
+}
+
+# Service tag of the lead chassis of the multi-chassis management group
+output "mcm_lead" {
+  value = data.ome_chassis_topology.all.mcm_lead_service_tag
+}
+
+# IDs of the compute sleds by chassis and slot, to target deployments by physical location
+output "compute_sleds" {
+  value = {
+    for chassis in data.ome_chassis_topology.all.chassis : chassis.service_tag => {
+      for slot in chassis.slots : slot.number => slot.device_id if slot.type == "compute"
+    }
+  }
+}
+
+# Models of the IOMs of a chassis
+output "mx1_ioms" {
+  value = [for slot in data.ome_chassis_topology.mx1.chassis[0].slots : slot.model if slot.type == "network_iom"]
+}
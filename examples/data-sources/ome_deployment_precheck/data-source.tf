@@ -0,0 +1,38 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Precheck the deployment of a template, the precheck job runs each time the data source is read
+data "ome_deployment_precheck" "deployment" {
+  template_name                = "template_1"
+  device_servicetags           = ["MXL1234", "MXL1235"]
+  options_strict_checking_vlan = true
+}
+
+# Precheck the remediation of a configuration baseline for its non compliant devices
+data "ome_deployment_precheck" "remediation" {
+  baseline_name = "baseline_1"
+}
+
+# Precheck the remediation of a configuration baseline for some of its devices
+data "ome_deployment_precheck" "remediation_devices" {
+  baseline_id = 10
+  device_ids  = [10001, 10002]
+}
+
+# Findings of the devices which failed the precheck, keyed by service tag
+output "deployment_precheck_failures" {
+  value = {
+    for device in data.ome_deployment_precheck.deployment.devices : device.device_servicetag => device.findings
+    if !device.passed
+  }
+}
@@ -21,12 +21,15 @@ data "ome_device" "devi" {
 # get device by their network
 data "ome_device" "devn" {
   filters = {
-    ip_expressions = [
+    management_ip_filter = [
       "10.10.10.10",
       "10.36.0.0-192.36.0.255",
       "fe80::ffff:ffff:ffff:ffff",
       "fe80::ffff:192.0.2.0/125",
-      "fe80::ffff:ffff:ffff:1111-fe80::ffff:ffff:ffff:ffff"
+      "fe80::ffff:ffff:ffff:1111-fe80::ffff:ffff:ffff:ffff",
+      "2001:db8::/32",
+      "10.10.20.*",
+      "idrac-01.example.com"
     ]
   }
 }
@@ -49,8 +52,8 @@ data "ome_device" "devf" {
 
 data "ome_device" "devs" {
   filters = {
-    ip_expressions    = ["10.10.10.10/26"]
-    filter_expression = "Model eq 'PowerEdge MX840c'"
+    management_ip_filter = ["10.10.10.10/26"]
+    filter_expression    = "Model eq 'PowerEdge MX840c'"
   }
 }
 
@@ -59,13 +62,13 @@ data "ome_device" "devs" {
 
 data "ome_device" "dev_invent_full" {
   filters = {
-    ip_expressions = ["10.10.10.10"]
+    management_ip_filter = ["10.10.10.10"]
   }
 }
 
 data "ome_device" "dev_invent" {
   filters = {
-    ip_expressions = ["10.10.10.10"]
+    management_ip_filter = ["10.10.10.10"]
   }
   inventory_types = ["serverNetworkInterfaces", "serverArrayDisks"]
 }
@@ -77,3 +80,17 @@ output "dev_inv_out" {
     serverArrayDisks        = data.ome_device.dev_invent.devices.detailed_inventory.disks
   }
 }
+
+# get selected inventory of many devices, fetching 10 devices at the same time
+# a device whose inventory cannot be fetched is reported in inventory_errors without failing the read
+data "ome_device" "dev_invent_rack" {
+  filters = {
+    management_ip_filter = ["10.10.10.0/24"]
+  }
+  inventory_types       = ["serverNetworkInterfaces", "serverArrayDisks"]
+  inventory_concurrency = 10
+}
+
+output "dev_invent_rack_errors" {
+  value = data.ome_device.dev_invent_rack.inventory_errors
+}
@@ -0,0 +1,52 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Health of all the devices with the rollup status of their subsystems
+data "ome_device_health" "all" {
+}
+
+# Critical and warning devices of a group, without the subsystems
+data "ome_device_health" "rack" {
+  device_group_names = ["Rack-A1"]
+  status_filter      = ["critical", "warning"]
+  include_subsystems = false
+}
+
+# Health of the servers selected with an OData filter
+data "ome_device_health" "servers" {
+  filter_expression = "Type eq 1000"
+}
+
+# Health of devices by service tags
+data "ome_device_health" "by_tags" {
+  device_service_tags = ["SVCTAG1", "SVCTAG2"]
+}
+
+# Fail the pipeline before maintenance when a device of the rack is not healthy
+output "rack_is_healthy" {
+  value = length(data.ome_device_health.rack.devices) == 0
+}
+
+# Number of servers by global status
+output "server_status_counts" {
+  value = data.ome_device_health.servers.status_counts
+}
+
+# Subsystems which are not normal, keyed by service tag
+output "unhealthy_subsystems" {
+  value = {
+    for device in data.ome_device_health.all.devices : device.service_tag => [
+      for subsystem in device.subsystems : subsystem.name if subsystem.status != "normal"
+    ]
+  }
+}
@@ -0,0 +1,35 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Walk the whole tree of the static groups
+data "ome_group_hierarchy" "static" {
+  root_group_name = "Static Groups"
+}
+
+# Walk only the first two levels of the tree
+data "ome_group_hierarchy" "datacenters" {
+  root_group_name = "Static Groups"
+  max_depth       = 1
+}
+
+# Devices of each group, including the devices of its sub groups, keyed by the path of the group
+output "recursive_devices" {
+  value = {
+    for group in data.ome_group_hierarchy.static.groups : join("/", group.path) => group.recursive_device_ids
+  }
+}
+
+# Groups of the second level of the tree
+output "datacenter_group_ids" {
+  value = data.ome_group_hierarchy.datacenters.levels[1].group_ids
+}
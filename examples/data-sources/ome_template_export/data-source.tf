@@ -0,0 +1,55 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Full content of a template in XML, and its attributes keyed by path
+data "ome_template_export" "template" {
+  template_name = "template_1"
+}
+
+# Full content of a template in JSON
+data "ome_template_export" "template_json" {
+  template_id = 10
+  format      = "JSON"
+}
+
+# Differences between a template and another template
+data "ome_template_export" "compare_template" {
+  template_name         = "template_1"
+  compare_template_name = "template_2"
+}
+
+# Differences between a template and the configuration of a device.
+# The template must be used by a configuration baseline which targets the device, and the configuration
+# of the device is the one of the last compliance check of this baseline.
+data "ome_template_export" "compare_device" {
+  template_name             = "template_1"
+  compare_device_servicetag = "SVCTAG1"
+}
+
+# Store the exported content in a file, to review it in a pull request
+resource "local_file" "template_1" {
+  content  = data.ome_template_export.template.content
+  filename = "${path.module}/template_1.xml"
+}
+
+# Changes between the templates, in a reviewable form
+output "template_changes" {
+  value = {
+    for diff in data.ome_template_export.compare_template.differences : diff.path => "${diff.status}: ${coalesce(diff.reference_value, "<unset>")} -> ${coalesce(diff.value, "<unset>")}"
+  }
+}
+
+# Attributes of the device which do not match the template
+output "device_changes" {
+  value = [for diff in data.ome_template_export.compare_device.differences : diff.path]
+}
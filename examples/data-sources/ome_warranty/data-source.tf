@@ -0,0 +1,41 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Active warranty entitlements of all the devices
+data "ome_warranty" "all" {
+}
+
+# Entitlements of a group ending within 90 days, including those which have already expired
+data "ome_warranty" "refresh" {
+  device_group_names   = ["Rack-A1"]
+  expiring_within_days = 90
+  include_expired      = true
+}
+
+# Entitlements of devices by service tags
+data "ome_warranty" "by_tags" {
+  device_service_tags = ["SVCTAG1", "SVCTAG2"]
+}
+
+# Service tags of the devices to plan for hardware refresh
+output "refresh_service_tags" {
+  value = distinct([for warranty in data.ome_warranty.refresh.warranties : warranty.service_tag])
+}
+
+# Warn when an entitlement of the rack ends within 90 days
+check "rack_warranty" {
+  assert {
+    condition     = length(data.ome_warranty.refresh.warranties) == 0
+    error_message = "Warranty of ${data.ome_warranty.refresh.warranties[0].service_tag} ends on ${data.ome_warranty.refresh.warranties[0].end_date}."
+  }
+}
@@ -96,7 +96,7 @@ resource "ome_discovery" "discovery_1" {
 data "ome_device" "discovered_devices" {
   depends_on = [ome_discovery.discovery_1]
   filters = {
-    ip_expressions = ome_discovery.discovery_1.discovery_config_targets[*].network_address_detail[*]
+    management_ip_filter = ome_discovery.discovery_1.discovery_config_targets[*].network_address_detail[*]
   }
 }
 
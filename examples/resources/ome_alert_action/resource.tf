@@ -0,0 +1,36 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Get the alerts raised during a maintenance window
+data "ome_alerts" "maintenance" {
+  filters = {
+    acknowledged       = false
+    device_servicetags = ["SVCTAG1"]
+    start_time         = "2025-01-01 00:00:00.000"
+    end_time           = "2025-01-02 00:00:00.000"
+  }
+}
+
+# Acknowledge the alerts
+# The action runs on apply, any change of the alert ids or of the action runs it again
+resource "ome_alert_action" "acknowledge" {
+  action    = "acknowledge"
+  alert_ids = [for alert in data.ome_alerts.maintenance.alerts : alert.id]
+}
+
+# Clear the alerts
+# Accepted actions are acknowledge, unacknowledge and clear
+resource "ome_alert_action" "clear" {
+  action    = "clear"
+  alert_ids = [for alert in data.ome_alerts.maintenance.alerts : alert.id]
+}
@@ -0,0 +1,60 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Resource to manage the SNMP trap and syslog alert destinations of the appliance
+resource "ome_alert_destinations" "alert_destinations" {
+  # Send a test trap or syslog message to every enabled destination after create or update.
+  # Defaults to false.
+  send_test = false
+
+  # Up to 4 SNMP trap destinations.
+  # Destinations removed from this list are disabled on the appliance.
+  snmp_destinations = [
+    {
+      # Slot of the destination on the appliance, from 1 to 4.
+      id                  = 1
+      destination_address = "192.0.2.10"
+      # Defaults to 162.
+      port_number  = 162
+      snmp_version = "SNMPV2"
+      # Community string, required for SNMPV1 and SNMPV2.
+      community = "public"
+    },
+    {
+      id                  = 2
+      destination_address = "nms.example.com"
+      snmp_version        = "SNMPV3"
+      # Username, required for SNMPV3.
+      username = "trapuser"
+      # Options are SHA, MD5 and NONE.
+      authentication_protocol   = "SHA"
+      authentication_passphrase = "authpassphrase"
+      # Options are DES, AES_128_CFB and NONE.
+      privacy_protocol   = "AES_128_CFB"
+      privacy_passphrase = "privpassphrase"
+    }
+  ]
+
+  # Up to 4 syslog destinations, syslog messages are forwarded over UDP.
+  # Destinations removed from this list are disabled on the appliance.
+  syslog_destinations = [
+    {
+      id                  = 1
+      destination_address = "192.0.2.20"
+      # Defaults to 514.
+      port_number = 514
+      # Defaults to true.
+      enabled = true
+    }
+  ]
+}
@@ -0,0 +1,81 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+data "ome_groupdevices_info" "ome_root" {
+  device_group_names = ["Static Groups"]
+}
+
+# Resource to manage a new static group used as alert policy target
+resource "ome_static_group" "critical_servers" {
+  name        = "critical-servers"
+  description = "Servers forwarding alerts to the NMS"
+  parent_id   = data.ome_groupdevices_info.ome_root.device_groups["Static Groups"].id
+  device_ids  = [10001, 10002]
+}
+
+# Resource to manage a new alert policy
+resource "ome_alert_policy" "alert_policy" {
+  # Name of the alert policy, required.
+  name        = "forward-critical-alerts"
+  description = "Forward critical alerts to the NMS"
+
+  # Enable or disable the policy. Defaults to true.
+  enabled = true
+
+  # Targets of the policy, at least one of device_ids, device_servicetags or group_ids is required.
+  device_servicetags = ["SVCTAG1"]
+  group_ids          = [ome_static_group.critical_servers.id]
+
+  # Severities of the alerts, options are Unknown, Info, Normal, Warning and Critical.
+  severities = ["Critical", "Warning"]
+
+  # Message catalogs and categories of the alerts.
+  # If not set, all the categories of all the catalogs are included.
+  categories = [
+    {
+      catalog_name   = "iDRAC"
+      categories     = ["System Health"]
+      sub_categories = ["Temperature"]
+    },
+    {
+      # all the categories of the catalog are included
+      catalog_name = "Application"
+    }
+  ]
+
+  # Time window in which the policy is active. If not set, the policy is always active.
+  schedule = {
+    start_time = "2025-01-01 00:00:00.000"
+    end_time   = "2025-12-31 23:59:59.000"
+    # Defaults to every day.
+    cron_string = "* * * ? * mon,tue,wed,thu,fri *"
+  }
+
+  # Actions of the policy by action template name, for example Email, Trap, Syslog, PowerControl, RemoteCommand or Ignore.
+  # Parameters which are not set use the default value of the action template.
+  actions = [
+    {
+      name = "Email"
+      parameters = {
+        subject = "Device Name: $name,  Device IP Address: $ip,  Severity: $severity"
+        to      = "noc@example.com"
+      }
+    },
+    {
+      name = "Trap"
+    },
+    {
+      name = "Syslog"
+    }
+  ]
+}
@@ -11,4 +11,8 @@
 # limitations under the License.
 # */
 
-terraform import ome_deployment.deploy-template-3 "<existing_deployment_name>"
\ No newline at end of file
+# import the deployment of a server template by the template name
+terraform import ome_deployment.deploy-template-3 "<existing_deployment_name>"
+
+# import the deployment of a chassis or IO module template, which leaves no server profile, by the template name and the ids of its deployment jobs
+terraform import ome_deployment.deploy-chassis-template "<existing_deployment_name>:<job_id1>,<job_id2>"
\ No newline at end of file
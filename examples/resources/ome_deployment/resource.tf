@@ -63,6 +63,30 @@ resource "ome_deployment" "deploy-template-5" {
   ]
 }
 
+# Deploy template using Device servicetags and deploy device attributes keyed by the path of their display names
+# The leading group names of the path can be omitted as long as the path matches a single attribute of the template
+# The paths and values are checked against the attributes of the template during the plan
+resource "ome_deployment" "deploy-template-5-overrides" {
+  template_name      = "deploy-template-5"
+  device_servicetags = ["MXL12345", "MXL23456"]
+  device_attribute_overrides = [
+    {
+      device_servicetags = ["MXL12345"]
+      attributes = {
+        "ServerTopology 1 Aisle Name"           = "aisle-1"
+        "iDRAC,NIC Information,DNS Domain Name" = "web01.example.com"
+      }
+    },
+    {
+      device_servicetags = ["MXL23456"]
+      attributes = {
+        "ServerTopology 1 Aisle Name"           = "aisle-2"
+        "iDRAC,NIC Information,DNS Domain Name" = "web02.example.com"
+      }
+    }
+  ]
+}
+
 # Deploy template using Device ids and boot to network iso
 resource "ome_deployment" "deploy-template-6" {
   template_name = "deploy-template-6"
@@ -101,4 +125,35 @@ resource "ome_deployment" "deploy-template-7" {
 resource "ome_deployment" "deploy-template-8" {
   template_id        = 614
   device_servicetags = concat(data.ome_groupdevices_info.gd.device_servicetags, ["MXL1235"])
-}
\ No newline at end of file
+}
+# Deploy template to the devices of a group in batches of 10 devices, waiting 5 minutes between two batches
+# The deployment stops and the apply fails once more than 2 devices failed, the pending devices of device_status are deployed by the next apply
+# The failed devices are deployed again when retry_failed_devices is set, otherwise the refresh reads the status of their deployment jobs again
+resource "ome_deployment" "deploy-template-9" {
+  template_id           = 614
+  device_servicetags    = data.ome_groupdevices_info.gd.device_servicetags
+  batch_size            = 10
+  max_failures          = 2
+  pause_between_batches = 300
+  retry_failed_devices  = true
+}
+
+# Deploy a chassis template to the chassis of a multi-chassis management group and set the power and network settings of the lead chassis
+# The settings are keyed by the path of the display names of the attributes of the chassis template, like in device_attribute_overrides
+# The chassis templates are deployed to chassis and the IO module templates to IO modules, without boot_to_network_iso
+resource "ome_deployment" "deploy-template-chassis" {
+  template_name      = "template_chassis"
+  device_servicetags = ["MXC1234", "MXC1235"]
+  chassis_options = {
+    power_settings = {
+      "Power Cap" = "3000"
+    }
+    network_settings = {
+      "DNS Domain Name" = "example.com"
+    }
+  }
+}
+
+output "deploy-template-9-failed-devices" {
+  value = [for device in ome_deployment.deploy-template-9.device_status : device.device_servicetag if device.status != "deployed"]
+}
@@ -0,0 +1,38 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+variable "idrac_password" {
+  type      = string
+  sensitive = true
+}
+
+# Manage a server with the rotated iDRAC password, changing the password runs a new onboarding job
+resource "ome_device_management" "server" {
+  service_tag   = "SVCTAG1"
+  managed_state = "managed"
+  redfish = {
+    username = "root"
+    password = var.idrac_password
+  }
+}
+
+# Switch a server to the monitored state
+resource "ome_device_management" "monitored" {
+  device_id     = 10042
+  managed_state = "monitored"
+  wsman = {
+    username = "root"
+    password = var.idrac_password
+  }
+  timeout = 30
+}
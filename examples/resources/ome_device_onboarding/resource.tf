@@ -0,0 +1,49 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Get the id of the "Static Groups" group, parent of the static groups
+data "ome_groupdevices_info" "static_groups" {
+  device_group_names = ["Static Groups"]
+}
+
+resource "ome_static_group" "new_servers" {
+  name      = "New servers"
+  parent_id = data.ome_groupdevices_info.static_groups.device_groups["Static Groups"].id
+}
+
+# Discover a server with its iDRAC IP, onboard it as managed, add it to a group
+# and wait until its inventory is collected
+resource "ome_device_onboarding" "server" {
+  management_ip = "10.10.10.10"
+  device_type   = "SERVER"
+  redfish = {
+    username = "root"
+    password = "calvin"
+  }
+  managed_state = "managed"
+  group_ids     = [ome_static_group.new_servers.id]
+
+  # minutes to wait for each of the discovery, the onboarding and the inventory collection
+  timeout = 30
+
+  # keep the device on OME when the resource is destroyed
+  remove_on_destroy = false
+}
+
+# The ID and service tag of the onboarded device
+output "server" {
+  value = {
+    id          = ome_device_onboarding.server.id
+    service_tag = ome_device_onboarding.server.service_tag
+  }
+}
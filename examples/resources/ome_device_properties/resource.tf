@@ -0,0 +1,36 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Set the name, the asset tag and the location of a server
+resource "ome_device_properties" "server" {
+  service_tag = "SVCTAG1"
+  name        = "esx-prod-01"
+  asset_tag   = "ASSET-0042"
+  location = {
+    datacenter = "Austin"
+    room       = "Room 101"
+    aisle      = "A"
+    rack       = "R12"
+    rack_slot  = 22
+  }
+}
+
+# Only the location is managed, the name and the asset tag of the device are left untouched
+resource "ome_device_properties" "chassis" {
+  service_tag = "SVCTAG2"
+  location = {
+    datacenter = "Austin"
+    room       = "Room 101"
+    rack       = "R14"
+  }
+}
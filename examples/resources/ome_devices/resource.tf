@@ -15,6 +15,11 @@ limitations under the License.
 resource "ome_devices" "dev_list_1" {
 }
 
+# Resource to manage the devices of a network, host names are resolved to their IPs
+resource "ome_devices" "dev_list_3" {
+  management_ip_filter = ["10.10.10.0/24", "10.10.20.*", "idrac-01.example.com"]
+}
+
 # Resource to manage specific devices
 resource "ome_devices" "dev_list_2" {
   devices = [
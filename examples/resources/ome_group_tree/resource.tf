@@ -0,0 +1,56 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Get the id of the "Static Groups" group, parent of the tree
+data "ome_groupdevices_info" "static_groups" {
+  device_group_names = ["Static Groups"]
+}
+
+# Nested layout of the datacenters, rooms and racks
+locals {
+  layout = {
+    dc1 = {
+      room1 = ["rack1", "rack2"]
+      room2 = ["rack3"]
+    }
+  }
+}
+
+# Declare the whole tree in one resource, each group references its parent by name
+# The nested layout is flattened into the groups, the groups without parent are created under parent_id
+resource "ome_group_tree" "layout" {
+  parent_id = data.ome_groupdevices_info.static_groups.device_groups["Static Groups"].id
+  groups = merge(
+    { for dc, rooms in local.layout : dc => { parent = "", description = "Datacenter ${dc}" } },
+    merge([for dc, rooms in local.layout : {
+      for room, racks in rooms : room => { parent = dc, description = "Room ${room}" }
+    }]...),
+    merge(flatten([for dc, rooms in local.layout : [
+      for room, racks in rooms : { for rack in racks : rack => { parent = room, description = "Rack ${rack}" } }
+    ]])...),
+  )
+}
+
+# The members of a group can also be managed by the tree
+resource "ome_group_tree" "lab" {
+  parent_id = data.ome_groupdevices_info.static_groups.device_groups["Static Groups"].id
+  groups = {
+    lab = {
+      description = "Lab"
+    }
+    lab-rack1 = {
+      parent     = "lab"
+      device_ids = [10001, 10002]
+    }
+  }
+}
@@ -0,0 +1,74 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Create an identity pool of ethernet MAC addresses
+resource "ome_identity_pool" "ethernet" {
+  name        = "ethernet-pool"
+  description = "Virtual MAC addresses of the server NICs"
+
+  ethernet_settings = {
+    starting_mac_address = "02:00:00:00:10:00"
+    identity_count       = 500
+  }
+}
+
+# Create an identity pool of ethernet, iSCSI, FCoE and FC identities
+# The MAC address ranges of ethernet, iSCSI and FCoE must not overlap
+resource "ome_identity_pool" "converged" {
+  name = "converged-pool"
+
+  ethernet_settings = {
+    starting_mac_address = "02:00:00:01:00:00"
+    identity_count       = 200
+  }
+
+  iscsi_settings = {
+    starting_mac_address = "02:00:00:02:00:00"
+    identity_count       = 100
+    iqn_prefix           = "iqn.2009-05.com.example"
+    initiator_ip_pool = {
+      ip_range           = "10.33.0.1-10.33.0.100"
+      subnet_mask        = "255.255.255.0"
+      gateway            = "10.33.0.254"
+      primary_dns_server = "10.33.0.250"
+    }
+  }
+
+  fcoe_settings = {
+    starting_mac_address = "02:00:00:03:00:00"
+    identity_count       = 100
+  }
+
+  # the WWNNs start at 20:00:02:00:00:04:00:00 and the WWPNs at 20:01:02:00:00:04:00:00
+  fc_settings = {
+    starting_address = "02:00:00:04:00:00"
+    identity_count   = 100
+  }
+}
+
+# Alert when less than 10% of the ethernet MAC addresses are free
+check "ethernet_pool_usage" {
+  assert {
+    condition = alltrue([
+      for usage in ome_identity_pool.ethernet.usage : usage.free * 10 >= usage.total
+    ])
+    error_message = "The identity pool ethernet-pool is running out of identities."
+  }
+}
+
+# The pool can be attached to a template
+resource "ome_template" "template" {
+  name                 = "template-with-pool"
+  refdevice_servicetag = "MXL1234"
+  identity_pool_name   = ome_identity_pool.converged.name
+}
@@ -0,0 +1,46 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Register an OpenID Connect provider with a client id and secret
+# The secret is sensitive and is never read back from OME
+resource "ome_oidc_provider" "oidc" {
+  name          = "corporate-sso"
+  discovery_uri = "https://idp.example.com/.well-known/openid-configuration"
+  client_id     = "ome-console"
+  client_secret = var.oidc_client_secret
+
+  # validate the certificate of the provider with a custom CA
+  verify_certificate = true
+  certificate        = file("idp-ca.pem")
+
+  enabled = true
+}
+
+# Register an OpenID Connect provider with an initial access token
+# The client id generated by the provider is returned in client_id
+resource "ome_oidc_provider" "oidc_token" {
+  name                 = "corporate-sso-token"
+  discovery_uri        = "https://idp.example.com/.well-known/openid-configuration"
+  registration_method  = "initial_access_token"
+  initial_access_token = var.oidc_initial_access_token
+}
+
+variable "oidc_client_secret" {
+  type      = string
+  sensitive = true
+}
+
+variable "oidc_initial_access_token" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,72 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Get the id of the "Query Groups" group, parent of the query groups
+data "ome_groupdevices_info" "query_groups" {
+  device_group_names = ["Query Groups"]
+}
+
+# Query group of the PowerEdge R650 devices
+resource "ome_query_group" "r650" {
+  name        = "PowerEdge R650"
+  description = "All the PowerEdge R650 servers"
+  parent_id   = data.ome_groupdevices_info.query_groups.device_groups["Query Groups"].id
+  criteria = {
+    conditions = [
+      {
+        field    = "Device Model"
+        operator = "="
+        value    = "PowerEdge R650"
+      }
+    ]
+  }
+}
+
+# Query group with nested conditions
+# Device Model = "PowerEdge R750" or (Device Model contains "PowerEdge" and Device Name startswith "lab-")
+resource "ome_query_group" "lab" {
+  name      = "Lab servers"
+  parent_id = data.ome_groupdevices_info.query_groups.device_groups["Query Groups"].id
+  criteria = {
+    operator = "or"
+    conditions = [
+      {
+        field    = "Device Model"
+        operator = "="
+        value    = "PowerEdge R750"
+      }
+    ]
+    groups = [
+      {
+        operator = "and"
+        conditions = [
+          {
+            field    = "Device Model"
+            operator = "contains"
+            value    = "PowerEdge"
+          },
+          {
+            field    = "Device Name"
+            operator = "startswith"
+            value    = "lab-"
+          }
+        ]
+      }
+    ]
+  }
+}
+
+# The devices which match the criteria of the query group
+output "r650_device_ids" {
+  value = ome_query_group.r650.device_ids
+}
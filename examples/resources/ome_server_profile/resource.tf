@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Create an unassigned server profile from a deployment template
+resource "ome_server_profile" "unassigned" {
+  name          = "profile-spare"
+  template_name = "deployment-template"
+}
+
+# Create a server profile with attribute overrides and assign it to a device
+# The attributes are keyed by the path of their display names, the leading group names can be omitted
+# when the path matches a single attribute
+# Changing the values redeploys the server profile to the device
+resource "ome_server_profile" "web" {
+  name        = "profile-web-01"
+  description = "Server profile of the first web server"
+  template_id = 10
+
+  attribute_overrides = {
+    "iDRAC,NIC Information,DNS Domain Name" = "web.example.com"
+    "Time 1 Time Zone String"               = "UTC"
+  }
+
+  # Changing the device migrates the server profile with its virtual identities to the new device
+  # Use force_migrate when the previous device cannot be reached, like after a hardware failure
+  device_servicetag = "SVCTAG1"
+  forced_shutdown   = true
+}
+
+# Assign a server profile to the sled in a slot of an MX chassis
+resource "ome_server_profile" "sled" {
+  name               = "profile-sled-03"
+  template_name      = "deployment-template"
+  chassis_servicetag = "MXSVCTAG"
+  slot_number        = 3
+  job_retry_count    = 30
+  sleep_interval     = 20
+}
@@ -14,8 +14,8 @@
 # get all devices in the CIDR "10.10.10.10/26" with model PowerEdge MX840c
 data "ome_device" "devs" {
   filters = {
-    ip_expressions    = ["10.10.10.10/26"]
-    filter_expression = "Model eq 'PowerEdge MX840c'"
+    management_ip_filter = ["10.10.10.10/26"]
+    filter_expression    = "Model eq 'PowerEdge MX840c'"
   }
 }
 
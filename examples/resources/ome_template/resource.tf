@@ -151,6 +151,43 @@ resource "ome_template" "template_5" {
   attributes           = local.template_attributes
 }
 
+# create a template and set its attributes by the path of their display names.
+# attribute_overrides can be set during create and the same configuration can be reused across templates, as the attribute ids are resolved by the provider.
+# the leading group names of a path can be omitted as long as the path matches a single attribute of the template.
+resource "ome_template" "template_overrides" {
+  name                 = "template_overrides"
+  refdevice_servicetag = "MXL1234"
+  fqdds                = "iDRAC"
+  attribute_overrides = {
+    "iDRAC,NIC Information,DNS Domain Name" = "example.com"
+    "Time 1 Time Zone String"               = "IST"
+  }
+}
+
+# create a template from several components of a reference device and ignore its host specific attributes.
+# sanitize ignores the IP addresses, host names, service tags, serial numbers, MAC addresses and WWNs captured from the device,
+# and the attributes matching the patterns of sanitize_deny_list. The ignored attributes are listed in sanitized_attributes.
+resource "ome_template" "template_sanitized" {
+  name                 = "template_sanitized"
+  refdevice_servicetag = "MXL1234"
+  fqdds                = "iDRAC,BIOS,RAID,NIC,EventFilters"
+  sanitize             = true
+  sanitize_deny_list   = ["iDRAC,IPv4 Static Information,*", "*Location*"]
+}
+
+# create a chassis template from a MX7000 or FX2 chassis, and an IO module template from an IOM.
+# device_type must match the type of the reference device, identity_pool_name and vlan are only supported by the templates of servers.
+resource "ome_template" "template_chassis" {
+  name                 = "template_chassis"
+  refdevice_servicetag = "MXC1234"
+  device_type          = "Chassis"
+}
+
+resource "ome_template" "template_iom" {
+  name                 = "template_iom"
+  refdevice_servicetag = "MXI1234"
+  device_type          = "IO Module"
+}
 
 # create multiple templates with template names and reference devices.
 resource "ome_template" "templates" {
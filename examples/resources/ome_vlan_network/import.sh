@@ -0,0 +1,22 @@
+# Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+# Licensed under the Mozilla Public License Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+
+#     http://mozilla.org/MPL/2.0/
+
+
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# The command is
+# terraform import ome_vlan_network.vlan <id or name>
+# Example:
+terraform import ome_vlan_network.vlan 10133
+# or
+terraform import ome_vlan_network.vlan "VLAN 100"
+# after running this command, populate the name, vlan_minimum and vlan_maximum fields in the config file to start managing this resource
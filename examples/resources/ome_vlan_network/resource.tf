@@ -0,0 +1,55 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Create a VLAN network of a single VLAN
+resource "ome_vlan_network" "vlan" {
+  name         = "VLAN 100"
+  description  = "Production network"
+  vlan_minimum = 100
+  vlan_maximum = 100
+}
+
+# Create a VLAN network of a range of VLANs for the iSCSI storage
+# The range cannot overlap the range of another network of OME
+resource "ome_vlan_network" "storage" {
+  name         = "iSCSI"
+  vlan_minimum = 200
+  vlan_maximum = 210
+  network_type = "Storage - iSCSI"
+}
+
+# Bulk import of the existing VLAN networks of OME with an import block, requires Terraform 1.7 or later
+data "ome_vlannetworks_info" "existing" {
+}
+
+locals {
+  existing_networks = {
+    for network in data.ome_vlannetworks_info.existing.vlan_networks : network.name => network
+    if network.name != "VLAN 100" && network.name != "iSCSI"
+  }
+}
+
+import {
+  for_each = local.existing_networks
+  to       = ome_vlan_network.existing[each.key]
+  id       = each.value.vlan_id
+}
+
+# set network_type when the imported networks are not of the default type
+resource "ome_vlan_network" "existing" {
+  for_each     = local.existing_networks
+  name         = each.value.name
+  description  = each.value.description
+  vlan_minimum = each.value.vlan_minimum
+  vlan_maximum = each.value.vlan_maximum
+}
@@ -0,0 +1,360 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"fmt"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	snmpV3            = "SNMPV3"
+	protocolNone      = "NONE"
+	snmpDestination   = "SNMP"
+	syslogDestination = "Syslog"
+)
+
+// ValidateAlertDestinations validates the alert destinations configuration
+func ValidateAlertDestinations(plan models.OmeAlertDestinations) error {
+	snmpIDs := map[int64]bool{}
+	for _, dest := range plan.SnmpDestinations {
+		if !dest.ID.IsUnknown() && !dest.ID.IsNull() {
+			if snmpIDs[dest.ID.ValueInt64()] {
+				return fmt.Errorf("snmp_destinations: id %d is configured more than once", dest.ID.ValueInt64())
+			}
+			snmpIDs[dest.ID.ValueInt64()] = true
+		}
+		if err := validateSNMPDestination(dest); err != nil {
+			return err
+		}
+	}
+	syslogIDs := map[int64]bool{}
+	for _, dest := range plan.SyslogDestinations {
+		if !dest.ID.IsUnknown() && !dest.ID.IsNull() {
+			if syslogIDs[dest.ID.ValueInt64()] {
+				return fmt.Errorf("syslog_destinations: id %d is configured more than once", dest.ID.ValueInt64())
+			}
+			syslogIDs[dest.ID.ValueInt64()] = true
+		}
+	}
+	return nil
+}
+
+func validateSNMPDestination(dest models.OmeSNMPDestination) error {
+	if dest.SnmpVersion.IsUnknown() || dest.SnmpVersion.IsNull() {
+		return nil
+	}
+	id := dest.ID.ValueInt64()
+	if dest.SnmpVersion.ValueString() != snmpV3 {
+		if dest.Community.IsNull() {
+			return fmt.Errorf("snmp_destinations: community is required for destination %d with snmp_version %s", id, dest.SnmpVersion.ValueString())
+		}
+		if !dest.Username.IsNull() || !dest.AuthenticationProtocol.IsNull() || !dest.PrivacyProtocol.IsNull() {
+			return fmt.Errorf("snmp_destinations: username, authentication_protocol and privacy_protocol are supported only with snmp_version SNMPV3 for destination %d", id)
+		}
+		return nil
+	}
+	if !dest.Community.IsNull() {
+		return fmt.Errorf("snmp_destinations: community is not supported with snmp_version SNMPV3 for destination %d", id)
+	}
+	if dest.Username.IsNull() {
+		return fmt.Errorf("snmp_destinations: username is required for destination %d with snmp_version SNMPV3", id)
+	}
+	authProtocol := dest.AuthenticationProtocol.ValueString()
+	if !dest.AuthenticationProtocol.IsNull() && authProtocol != protocolNone && dest.AuthenticationPassphrase.IsNull() {
+		return fmt.Errorf("snmp_destinations: authentication_passphrase is required for destination %d when authentication_protocol is %s", id, authProtocol)
+	}
+	privProtocol := dest.PrivacyProtocol.ValueString()
+	if !dest.PrivacyProtocol.IsNull() && privProtocol != protocolNone {
+		if dest.PrivacyPassphrase.IsNull() {
+			return fmt.Errorf("snmp_destinations: privacy_passphrase is required for destination %d when privacy_protocol is %s", id, privProtocol)
+		}
+		if dest.AuthenticationProtocol.IsNull() || authProtocol == protocolNone {
+			return fmt.Errorf("snmp_destinations: privacy_protocol requires an authentication_protocol for destination %d", id)
+		}
+	}
+	return nil
+}
+
+// MakeSNMPDestinationPayload creates the api payload of a SNMP destination
+func MakeSNMPDestinationPayload(dest models.OmeSNMPDestination) models.SNMPDestination {
+	payload := models.SNMPDestination{
+		ID:                 dest.ID.ValueInt64(),
+		Enabled:            dest.Enabled.ValueBool(),
+		DestinationAddress: dest.DestinationAddress.ValueString(),
+		PortNumber:         dest.PortNumber.ValueInt64(),
+		SnmpVersion:        dest.SnmpVersion.ValueString(),
+	}
+	if payload.SnmpVersion == snmpV3 {
+		payload.SnmpV3Credential = &models.SNMPV3Credential{
+			Username:                 dest.Username.ValueString(),
+			AuthenticationProtocol:   valueOrNone(dest.AuthenticationProtocol),
+			AuthenticationPassphrase: dest.AuthenticationPassphrase.ValueString(),
+			PrivacyProtocol:          valueOrNone(dest.PrivacyProtocol),
+			PrivacyPassphrase:        dest.PrivacyPassphrase.ValueString(),
+		}
+	} else {
+		payload.SnmpV1V2Credential = &models.SNMPV1V2Credential{
+			Community: dest.Community.ValueString(),
+		}
+	}
+	return payload
+}
+
+// MakeSyslogDestinationPayload creates the api payload of a syslog destination
+func MakeSyslogDestinationPayload(dest models.OmeSyslogDestination) models.SyslogDestination {
+	return models.SyslogDestination{
+		ID:                 dest.ID.ValueInt64(),
+		Enabled:            dest.Enabled.ValueBool(),
+		DestinationAddress: dest.DestinationAddress.ValueString(),
+		PortNumber:         dest.PortNumber.ValueInt64(),
+	}
+}
+
+// ApplyAlertDestinations updates the destinations of the plan and disables the destinations which are only present in the state
+func ApplyAlertDestinations(client *clients.Client, plan, state models.OmeAlertDestinations) error {
+	snmpPayload := []models.SNMPDestination{}
+	snmpInPlan := map[int64]bool{}
+	for _, dest := range plan.SnmpDestinations {
+		snmpPayload = append(snmpPayload, MakeSNMPDestinationPayload(dest))
+		snmpInPlan[dest.ID.ValueInt64()] = true
+	}
+	syslogPayload := []models.SyslogDestination{}
+	syslogInPlan := map[int64]bool{}
+	for _, dest := range plan.SyslogDestinations {
+		syslogPayload = append(syslogPayload, MakeSyslogDestinationPayload(dest))
+		syslogInPlan[dest.ID.ValueInt64()] = true
+	}
+
+	snmpRemoved, syslogRemoved := []int64{}, []int64{}
+	for _, dest := range state.SnmpDestinations {
+		if !snmpInPlan[dest.ID.ValueInt64()] {
+			snmpRemoved = append(snmpRemoved, dest.ID.ValueInt64())
+		}
+	}
+	for _, dest := range state.SyslogDestinations {
+		if !syslogInPlan[dest.ID.ValueInt64()] {
+			syslogRemoved = append(syslogRemoved, dest.ID.ValueInt64())
+		}
+	}
+	disabledSNMP, disabledSyslog, err := getDisabledDestinations(client, snmpRemoved, syslogRemoved)
+	if err != nil {
+		return err
+	}
+
+	if err := client.ApplySNMPDestinations(append(snmpPayload, disabledSNMP...)); err != nil {
+		return err
+	}
+	return client.ApplySyslogDestinations(append(syslogPayload, disabledSyslog...))
+}
+
+// DisableAlertDestinations disables all the destinations managed by the state
+func DisableAlertDestinations(client *clients.Client, state models.OmeAlertDestinations) error {
+	return ApplyAlertDestinations(client, models.OmeAlertDestinations{}, state)
+}
+
+// getDisabledDestinations returns the current configuration of the given destinations with the destination disabled
+func getDisabledDestinations(client *clients.Client, snmpIDs, syslogIDs []int64) ([]models.SNMPDestination, []models.SyslogDestination, error) {
+	disabledSNMP := []models.SNMPDestination{}
+	disabledSyslog := []models.SyslogDestination{}
+	if len(snmpIDs) > 0 {
+		current, err := client.GetSNMPDestinations()
+		if err != nil {
+			return disabledSNMP, disabledSyslog, err
+		}
+		for _, id := range snmpIDs {
+			dest, ok := findSNMPDestination(current, id)
+			if !ok {
+				continue
+			}
+			dest.Enabled = false
+			disabledSNMP = append(disabledSNMP, dest)
+		}
+	}
+	if len(syslogIDs) > 0 {
+		current, err := client.GetSyslogDestinations()
+		if err != nil {
+			return disabledSNMP, disabledSyslog, err
+		}
+		for _, id := range syslogIDs {
+			dest, ok := findSyslogDestination(current, id)
+			if !ok {
+				continue
+			}
+			dest.Enabled = false
+			disabledSyslog = append(disabledSyslog, dest)
+		}
+	}
+	return disabledSNMP, disabledSyslog, nil
+}
+
+// SendTestAlertDestinations sends a test trap or message to every enabled destination of the plan
+func SendTestAlertDestinations(client *clients.Client, plan models.OmeAlertDestinations) error {
+	for _, dest := range plan.SnmpDestinations {
+		if !dest.Enabled.ValueBool() {
+			continue
+		}
+		if err := client.SendTestTrap(MakeSNMPDestinationPayload(dest)); err != nil {
+			return fmt.Errorf("unable to send test trap to %s: %w", dest.DestinationAddress.ValueString(), err)
+		}
+	}
+	for _, dest := range plan.SyslogDestinations {
+		if !dest.Enabled.ValueBool() {
+			continue
+		}
+		if err := client.SendTestSyslog(MakeSyslogDestinationPayload(dest)); err != nil {
+			return fmt.Errorf("unable to send test message to %s: %w", dest.DestinationAddress.ValueString(), err)
+		}
+	}
+	return nil
+}
+
+// SetStateAlertDestinations refreshes the destinations of the prior state with the appliance configuration
+func SetStateAlertDestinations(snmp []models.SNMPDestination, syslog []models.SyslogDestination, prior models.OmeAlertDestinations) (models.OmeAlertDestinations, error) {
+	state := models.OmeAlertDestinations{
+		ID:       types.StringValue("placeholder"),
+		SendTest: prior.SendTest,
+	}
+	if state.SendTest.IsNull() || state.SendTest.IsUnknown() {
+		state.SendTest = types.BoolValue(false)
+	}
+	// keep an unset list unset
+	if prior.SnmpDestinations != nil {
+		state.SnmpDestinations = []models.OmeSNMPDestination{}
+	}
+	if prior.SyslogDestinations != nil {
+		state.SyslogDestinations = []models.OmeSyslogDestination{}
+	}
+	for _, priorDest := range prior.SnmpDestinations {
+		dest, ok := findSNMPDestination(snmp, priorDest.ID.ValueInt64())
+		if !ok {
+			return state, fmt.Errorf(clients.ErrAlertDestinationNotFound, snmpDestination, priorDest.ID.ValueInt64())
+		}
+		state.SnmpDestinations = append(state.SnmpDestinations, newSNMPDestinationState(dest, priorDest))
+	}
+	for _, priorDest := range prior.SyslogDestinations {
+		dest, ok := findSyslogDestination(syslog, priorDest.ID.ValueInt64())
+		if !ok {
+			return state, fmt.Errorf(clients.ErrAlertDestinationNotFound, syslogDestination, priorDest.ID.ValueInt64())
+		}
+		state.SyslogDestinations = append(state.SyslogDestinations, newSyslogDestinationState(dest))
+	}
+	return state, nil
+}
+
+// ImportStateAlertDestinations creates the state from all the configured destinations of the appliance
+func ImportStateAlertDestinations(snmp []models.SNMPDestination, syslog []models.SyslogDestination) models.OmeAlertDestinations {
+	state := models.OmeAlertDestinations{
+		ID:       types.StringValue("placeholder"),
+		SendTest: types.BoolValue(false),
+	}
+	for _, dest := range snmp {
+		if dest.DestinationAddress == "" {
+			continue
+		}
+		prior := models.OmeSNMPDestination{
+			Community:                types.StringNull(),
+			Username:                 types.StringNull(),
+			AuthenticationProtocol:   types.StringNull(),
+			AuthenticationPassphrase: types.StringNull(),
+			PrivacyProtocol:          types.StringNull(),
+			PrivacyPassphrase:        types.StringNull(),
+		}
+		state.SnmpDestinations = append(state.SnmpDestinations, newSNMPDestinationState(dest, prior))
+	}
+	for _, dest := range syslog {
+		if dest.DestinationAddress == "" {
+			continue
+		}
+		state.SyslogDestinations = append(state.SyslogDestinations, newSyslogDestinationState(dest))
+	}
+	return state
+}
+
+func newSNMPDestinationState(dest models.SNMPDestination, prior models.OmeSNMPDestination) models.OmeSNMPDestination {
+	state := models.OmeSNMPDestination{
+		ID:                       types.Int64Value(dest.ID),
+		Enabled:                  types.BoolValue(dest.Enabled),
+		DestinationAddress:       types.StringValue(dest.DestinationAddress),
+		PortNumber:               types.Int64Value(dest.PortNumber),
+		SnmpVersion:              types.StringValue(dest.SnmpVersion),
+		Community:                types.StringNull(),
+		Username:                 types.StringNull(),
+		AuthenticationProtocol:   types.StringNull(),
+		AuthenticationPassphrase: types.StringNull(),
+		PrivacyProtocol:          types.StringNull(),
+		PrivacyPassphrase:        types.StringNull(),
+	}
+	if dest.SnmpVersion == snmpV3 {
+		if dest.SnmpV3Credential != nil {
+			state.Username = types.StringValue(dest.SnmpV3Credential.Username)
+			state.AuthenticationProtocol = protocolOrPrior(dest.SnmpV3Credential.AuthenticationProtocol, prior.AuthenticationProtocol)
+			state.PrivacyProtocol = protocolOrPrior(dest.SnmpV3Credential.PrivacyProtocol, prior.PrivacyProtocol)
+		}
+		// passphrases are never returned by the appliance
+		state.AuthenticationPassphrase = prior.AuthenticationPassphrase
+		state.PrivacyPassphrase = prior.PrivacyPassphrase
+		return state
+	}
+	state.Community = prior.Community
+	if dest.SnmpV1V2Credential != nil && dest.SnmpV1V2Credential.Community != "" {
+		state.Community = types.StringValue(dest.SnmpV1V2Credential.Community)
+	}
+	return state
+}
+
+func newSyslogDestinationState(dest models.SyslogDestination) models.OmeSyslogDestination {
+	return models.OmeSyslogDestination{
+		ID:                 types.Int64Value(dest.ID),
+		Enabled:            types.BoolValue(dest.Enabled),
+		DestinationAddress: types.StringValue(dest.DestinationAddress),
+		PortNumber:         types.Int64Value(dest.PortNumber),
+	}
+}
+
+// protocolOrPrior keeps an unset protocol unset when the appliance reports the default protocol
+func protocolOrPrior(protocol string, prior types.String) types.String {
+	if prior.IsNull() && (protocol == "" || protocol == protocolNone) {
+		return prior
+	}
+	return types.StringValue(protocol)
+}
+
+func valueOrNone(val types.String) string {
+	if val.IsNull() || val.IsUnknown() || val.ValueString() == "" {
+		return protocolNone
+	}
+	return val.ValueString()
+}
+
+func findSNMPDestination(destinations []models.SNMPDestination, id int64) (models.SNMPDestination, bool) {
+	for _, dest := range destinations {
+		if dest.ID == id {
+			return dest, true
+		}
+	}
+	return models.SNMPDestination{}, false
+}
+
+func findSyslogDestination(destinations []models.SyslogDestination, id int64) (models.SyslogDestination, bool) {
+	for _, dest := range destinations {
+		if dest.ID == id {
+			return dest, true
+		}
+	}
+	return models.SyslogDestination{}, false
+}
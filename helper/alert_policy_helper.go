@@ -0,0 +1,604 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// alertPolicyTimeFormat is the time format of the alert policy schedule
+	alertPolicyTimeFormat = "2006-01-02 15:04:05.000"
+	// alertPolicyDefaultCron matches every day of the week
+	alertPolicyDefaultCron = "* * * ? * * *"
+	alertActionIgnore      = "Ignore"
+)
+
+// AlertSeverities maps the alert severities to the appliance severity values
+var AlertSeverities = map[string]int64{
+	"Unknown":  1,
+	"Info":     2,
+	"Normal":   4,
+	"Warning":  8,
+	"Critical": 16,
+}
+
+// GetAlertPolicy get an alert policy by id
+func GetAlertPolicy(client *clients.Client, id int64) (models.AlertPolicy, error) {
+	return client.GetAlertPolicyByID(id)
+}
+
+// CreateAlertPolicy create an alert policy
+func CreateAlertPolicy(client *clients.Client, payload models.AlertPolicy) (models.AlertPolicy, error) {
+	return client.CreateAlertPolicy(payload)
+}
+
+// UpdateAlertPolicy update an alert policy
+func UpdateAlertPolicy(client *clients.Client, payload models.AlertPolicy) (models.AlertPolicy, error) {
+	return client.UpdateAlertPolicy(payload)
+}
+
+// DeleteAlertPolicy delete an alert policy
+func DeleteAlertPolicy(client *clients.Client, id int64) error {
+	return client.DeleteAlertPolicies([]int64{id})
+}
+
+// GetAlertCategories get the alert categories of all the message catalogs
+func GetAlertCategories(client *clients.Client) ([]models.AlertCategoryCatalog, error) {
+	return client.GetAlertCategories()
+}
+
+// SetAlertPolicyEnabled enables or disables the alert policy when it differs from the expected value
+func SetAlertPolicyEnabled(client *clients.Client, policy models.AlertPolicy, enabled bool) (models.AlertPolicy, error) {
+	if policy.Enabled == enabled {
+		return policy, nil
+	}
+	if err := client.EnableAlertPolicies([]int64{policy.ID}, enabled); err != nil {
+		return policy, err
+	}
+	return client.GetAlertPolicyByID(policy.ID)
+}
+
+// ValidateAlertPolicy validates the alert policy configuration
+func ValidateAlertPolicy(plan models.OmeAlertPolicy) error {
+	if plan.DeviceIDs.IsNull() && plan.DeviceServicetags.IsNull() && plan.GroupIDs.IsNull() {
+		return fmt.Errorf("at least one of device_ids, device_servicetags or group_ids is required")
+	}
+	names := map[string]bool{}
+	for _, action := range plan.Actions {
+		if action.Name.IsUnknown() {
+			continue
+		}
+		name := strings.ToLower(action.Name.ValueString())
+		if names[name] {
+			return fmt.Errorf("action %s is configured more than once", action.Name.ValueString())
+		}
+		names[name] = true
+	}
+	if names[strings.ToLower(alertActionIgnore)] && len(plan.Actions) > 1 {
+		return fmt.Errorf("action %s cannot be combined with other actions", alertActionIgnore)
+	}
+	return nil
+}
+
+// MakeAlertPolicyPayload creates the api payload of an alert policy from the plan, current is the policy on the appliance during update
+func MakeAlertPolicyPayload(ctx context.Context, client *clients.Client, plan models.OmeAlertPolicy, current *models.AlertPolicy) (models.AlertPolicy, error) {
+	payload := models.AlertPolicy{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		Enabled:     plan.Enabled.ValueBool(),
+		Editable:    true,
+		Visible:     true,
+		State:       true,
+		PolicyData: models.AlertPolicyData{
+			MessageIds:          []string{},
+			DeviceTypes:         []int64{},
+			UndiscoveredTargets: []string{},
+		},
+	}
+	if current != nil {
+		payload.ID = current.ID
+		payload.PolicyData.MessageIds = current.PolicyData.MessageIds
+		payload.PolicyData.DeviceTypes = current.PolicyData.DeviceTypes
+		payload.PolicyData.UndiscoveredTargets = current.PolicyData.UndiscoveredTargets
+	}
+
+	devices, err := resolveAlertPolicyDevices(ctx, client, plan)
+	if err != nil {
+		return payload, err
+	}
+	payload.PolicyData.Devices = devices
+
+	groups, err := resolveAlertPolicyGroups(ctx, client, plan)
+	if err != nil {
+		return payload, err
+	}
+	payload.PolicyData.Groups = groups
+
+	severities := []string{}
+	if diags := plan.Severities.ElementsAs(ctx, &severities, true); diags.HasError() {
+		return payload, fmt.Errorf("unable to read severities")
+	}
+	for _, severity := range severities {
+		payload.PolicyData.Severities = append(payload.PolicyData.Severities, AlertSeverities[severity])
+	}
+	sort.Slice(payload.PolicyData.Severities, func(i, j int) bool {
+		return payload.PolicyData.Severities[i] < payload.PolicyData.Severities[j]
+	})
+
+	allCatalogs, err := client.GetAlertCategories()
+	if err != nil {
+		return payload, err
+	}
+	catalogs, err := resolveAlertPolicyCatalogs(ctx, allCatalogs, plan.Categories)
+	if err != nil {
+		return payload, err
+	}
+	payload.PolicyData.Catalogs = catalogs
+
+	templates, err := client.GetAlertActionTemplates()
+	if err != nil {
+		return payload, err
+	}
+	actions, err := resolveAlertPolicyActions(ctx, templates, plan.Actions)
+	if err != nil {
+		return payload, err
+	}
+	payload.PolicyData.Actions = actions
+
+	switch {
+	case plan.Schedule != nil:
+		payload.PolicyData.Schedule = models.AlertPolicySchedule{
+			StartTime:  plan.Schedule.StartTime.ValueString(),
+			EndTime:    plan.Schedule.EndTime.ValueString(),
+			CronString: plan.Schedule.CronString.ValueString(),
+		}
+		if payload.PolicyData.Schedule.CronString == "" {
+			payload.PolicyData.Schedule.CronString = alertPolicyDefaultCron
+		}
+	case current != nil:
+		payload.PolicyData.Schedule = current.PolicyData.Schedule
+	default:
+		payload.PolicyData.Schedule = models.AlertPolicySchedule{
+			StartTime:  time.Now().Format(alertPolicyTimeFormat),
+			CronString: alertPolicyDefaultCron,
+		}
+	}
+	return payload, nil
+}
+
+// resolveAlertPolicyDevices returns the ids of the devices given by ids or service tags
+func resolveAlertPolicyDevices(ctx context.Context, client *clients.Client, plan models.OmeAlertPolicy) ([]int64, error) {
+	devIDs := []int64{}
+	serviceTags := []string{}
+	if diags := plan.DeviceIDs.ElementsAs(ctx, &devIDs, true); diags.HasError() {
+		return nil, fmt.Errorf("unable to read device_ids")
+	}
+	if diags := plan.DeviceServicetags.ElementsAs(ctx, &serviceTags, true); diags.HasError() {
+		return nil, fmt.Errorf("unable to read device_servicetags")
+	}
+	ret := []int64{}
+	if len(devIDs) == 0 && len(serviceTags) == 0 {
+		return ret, nil
+	}
+	devices, err := client.GetDevices(serviceTags, devIDs, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, device := range devices {
+		ret = append(ret, device.ID)
+	}
+	return ret, nil
+}
+
+// GetAlertPolicyDeviceServiceTags returns the service tags of the devices of an alert policy by device id,
+// the devices removed from the appliance are skipped
+func GetAlertPolicyDeviceServiceTags(client *clients.Client, policy models.AlertPolicy) (map[int64]string, error) {
+	ret := map[int64]string{}
+	for _, devID := range policy.PolicyData.Devices {
+		device, err := client.GetDevice("", devID)
+		if errors.Is(err, clients.ErrItemNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		ret[devID] = device.DeviceServiceTag
+	}
+	return ret, nil
+}
+
+// resolveAlertPolicyGroups validates that the given groups exist on the appliance
+func resolveAlertPolicyGroups(ctx context.Context, client *clients.Client, plan models.OmeAlertPolicy) ([]int64, error) {
+	groupIDs := []int64{}
+	if diags := plan.GroupIDs.ElementsAs(ctx, &groupIDs, true); diags.HasError() {
+		return nil, fmt.Errorf("unable to read group_ids")
+	}
+	invalidGroupIDs := []int64{}
+	for _, id := range groupIDs {
+		if _, err := client.GetGroupByID(id); err != nil {
+			invalidGroupIDs = append(invalidGroupIDs, id)
+		}
+	}
+	if len(invalidGroupIDs) > 0 {
+		return nil, fmt.Errorf("invalid group ids: %v", invalidGroupIDs)
+	}
+	return groupIDs, nil
+}
+
+// resolveAlertPolicyCatalogs converts the category names to the catalog filter of the appliance, no categories match all the catalogs
+func resolveAlertPolicyCatalogs(ctx context.Context, allCatalogs []models.AlertCategoryCatalog, categories []models.OmeAlertPolicyCategory) ([]models.AlertPolicyCatalog, error) {
+	ret := []models.AlertPolicyCatalog{}
+	if len(categories) == 0 {
+		for _, catalog := range allCatalogs {
+			ret = append(ret, models.AlertPolicyCatalog{CatalogName: catalog.Name, Categories: []int64{}, SubCategories: []int64{}})
+		}
+		return ret, nil
+	}
+	for _, category := range categories {
+		catalog, ok := findAlertCatalog(allCatalogs, category.CatalogName.ValueString())
+		if !ok {
+			return nil, fmt.Errorf("invalid catalog name: %s", category.CatalogName.ValueString())
+		}
+		categoryNames, subCategoryNames := []string{}, []string{}
+		if diags := category.Categories.ElementsAs(ctx, &categoryNames, true); diags.HasError() {
+			return nil, fmt.Errorf("unable to read categories of catalog %s", catalog.Name)
+		}
+		if diags := category.SubCategories.ElementsAs(ctx, &subCategoryNames, true); diags.HasError() {
+			return nil, fmt.Errorf("unable to read sub_categories of catalog %s", catalog.Name)
+		}
+
+		item := models.AlertPolicyCatalog{CatalogName: catalog.Name, Categories: []int64{}, SubCategories: []int64{}}
+		selected := []models.AlertCategory{}
+		invalid := []string{}
+		for _, name := range categoryNames {
+			found := false
+			for _, c := range catalog.CategoriesDetails {
+				if c.Name == name {
+					item.Categories = append(item.Categories, c.ID)
+					selected = append(selected, c)
+					found = true
+					break
+				}
+			}
+			if !found {
+				invalid = append(invalid, name)
+			}
+		}
+		if len(invalid) > 0 {
+			return nil, fmt.Errorf("invalid categories of catalog %s: %v", catalog.Name, invalid)
+		}
+		if len(selected) == 0 {
+			selected = catalog.CategoriesDetails
+		}
+		for _, name := range subCategoryNames {
+			id, found := findAlertSubCategoryID(selected, name)
+			if !found {
+				invalid = append(invalid, name)
+				continue
+			}
+			item.SubCategories = append(item.SubCategories, id)
+		}
+		if len(invalid) > 0 {
+			return nil, fmt.Errorf("invalid sub categories of catalog %s: %v", catalog.Name, invalid)
+		}
+		ret = append(ret, item)
+	}
+	return ret, nil
+}
+
+// resolveAlertPolicyActions creates the policy actions from the action templates of the appliance
+func resolveAlertPolicyActions(ctx context.Context, templates []models.AlertActionTemplate, actions []models.OmeAlertPolicyAction) ([]models.AlertPolicyAction, error) {
+	ret := []models.AlertPolicyAction{}
+	for _, action := range actions {
+		var template *models.AlertActionTemplate
+		for i := range templates {
+			if strings.EqualFold(templates[i].Name, action.Name.ValueString()) {
+				template = &templates[i]
+				break
+			}
+		}
+		if template == nil || template.Disabled {
+			validNames := []string{}
+			for _, t := range templates {
+				if !t.Disabled {
+					validNames = append(validNames, t.Name)
+				}
+			}
+			return nil, fmt.Errorf("invalid action %s, valid actions are: %v", action.Name.ValueString(), validNames)
+		}
+
+		params := map[string]string{}
+		if diags := action.Parameters.ElementsAs(ctx, &params, true); diags.HasError() {
+			return nil, fmt.Errorf("unable to read parameters of action %s", template.Name)
+		}
+		details := []models.AlertActionParameter{}
+		for _, param := range template.ParameterDetails {
+			if val, ok := params[param.Name]; ok {
+				param.Value = val
+				delete(params, param.Name)
+			}
+			details = append(details, param)
+		}
+		if len(params) > 0 {
+			validNames := []string{}
+			for _, param := range template.ParameterDetails {
+				validNames = append(validNames, param.Name)
+			}
+			return nil, fmt.Errorf("invalid parameters for action %s, valid parameters are: %v", template.Name, validNames)
+		}
+		ret = append(ret, models.AlertPolicyAction{
+			Name:             template.Name,
+			TemplateID:       template.ID,
+			ParameterDetails: details,
+		})
+	}
+	return ret, nil
+}
+
+// SetStateAlertPolicy creates the state of an alert policy, the attributes given by name are refreshed based on the prior state,
+// the devices given by service tags are mapped back with deviceServiceTags
+func SetStateAlertPolicy(ctx context.Context, policy models.AlertPolicy, allCatalogs []models.AlertCategoryCatalog, prior models.OmeAlertPolicy,
+	deviceServiceTags map[int64]string) (models.OmeAlertPolicy, diag.Diagnostics) {
+	var diags, d diag.Diagnostics
+	state := models.OmeAlertPolicy{
+		ID:                types.Int64Value(policy.ID),
+		Name:              types.StringValue(policy.Name),
+		Description:       types.StringValue(policy.Description),
+		Enabled:           types.BoolValue(policy.Enabled),
+		DeviceIDs:         prior.DeviceIDs,
+		DeviceServicetags: prior.DeviceServicetags,
+		GroupIDs:          prior.GroupIDs,
+	}
+	if state.DeviceIDs.IsUnknown() {
+		state.DeviceIDs = types.SetNull(types.Int64Type)
+	}
+	if state.DeviceServicetags.IsUnknown() {
+		state.DeviceServicetags = types.SetNull(types.StringType)
+	}
+	if state.GroupIDs.IsUnknown() {
+		state.GroupIDs = types.SetNull(types.Int64Type)
+	}
+
+	if state.DeviceServicetags.IsNull() && (!state.DeviceIDs.IsNull() || len(policy.PolicyData.Devices) > 0) {
+		state.DeviceIDs, d = types.SetValueFrom(ctx, types.Int64Type, policy.PolicyData.Devices)
+		diags.Append(d...)
+	} else if !state.DeviceServicetags.IsNull() {
+		state.DeviceIDs, state.DeviceServicetags = newAlertPolicyDevicesState(ctx, policy.PolicyData.Devices, deviceServiceTags, prior, &diags)
+	}
+	if !state.GroupIDs.IsNull() || len(policy.PolicyData.Groups) > 0 {
+		state.GroupIDs, d = types.SetValueFrom(ctx, types.Int64Type, policy.PolicyData.Groups)
+		diags.Append(d...)
+	}
+
+	severities := []string{}
+	for name, val := range AlertSeverities {
+		for _, severity := range policy.PolicyData.Severities {
+			if severity == val {
+				severities = append(severities, name)
+			}
+		}
+	}
+	state.Severities, d = types.SetValueFrom(ctx, types.StringType, severities)
+	diags.Append(d...)
+
+	state.Categories = newAlertPolicyCategoriesState(ctx, policy.PolicyData.Catalogs, allCatalogs, prior.Categories, &diags)
+
+	if prior.Schedule != nil {
+		state.Schedule = &models.OmeAlertPolicySchedule{
+			StartTime:  types.StringValue(policy.PolicyData.Schedule.StartTime),
+			EndTime:    types.StringValue(policy.PolicyData.Schedule.EndTime),
+			CronString: types.StringValue(policy.PolicyData.Schedule.CronString),
+		}
+	}
+
+	for _, priorAction := range prior.Actions {
+		for _, action := range policy.PolicyData.Actions {
+			if !strings.EqualFold(action.Name, priorAction.Name.ValueString()) {
+				continue
+			}
+			state.Actions = append(state.Actions, newAlertPolicyActionState(action, priorAction, &diags))
+			break
+		}
+	}
+	if prior.Actions != nil && state.Actions == nil {
+		state.Actions = []models.OmeAlertPolicyAction{}
+	}
+	return state, diags
+}
+
+// ImportStateAlertPolicy creates the prior state used to import an alert policy
+func ImportStateAlertPolicy(policy models.AlertPolicy) models.OmeAlertPolicy {
+	prior := models.OmeAlertPolicy{
+		DeviceIDs:         types.SetNull(types.Int64Type),
+		DeviceServicetags: types.SetNull(types.StringType),
+		GroupIDs:          types.SetNull(types.Int64Type),
+		Schedule:          &models.OmeAlertPolicySchedule{},
+	}
+	for _, catalog := range policy.PolicyData.Catalogs {
+		prior.Categories = append(prior.Categories, models.OmeAlertPolicyCategory{
+			CatalogName:   types.StringValue(catalog.CatalogName),
+			Categories:    types.SetNull(types.StringType),
+			SubCategories: types.SetNull(types.StringType),
+		})
+		if len(catalog.Categories) > 0 {
+			prior.Categories[len(prior.Categories)-1].Categories = types.SetValueMust(types.StringType, []attr.Value{})
+		}
+		if len(catalog.SubCategories) > 0 {
+			prior.Categories[len(prior.Categories)-1].SubCategories = types.SetValueMust(types.StringType, []attr.Value{})
+		}
+	}
+	for _, action := range policy.PolicyData.Actions {
+		params := map[string]attr.Value{}
+		for _, param := range action.ParameterDetails {
+			params[param.Name] = types.StringValue(param.Value)
+		}
+		prior.Actions = append(prior.Actions, models.OmeAlertPolicyAction{
+			Name:       types.StringValue(action.Name),
+			Parameters: types.MapValueMust(types.StringType, params),
+		})
+	}
+	return prior
+}
+
+// newAlertPolicyDevicesState splits the devices of an alert policy between the ids and the service tags of the prior state,
+// the devices added on the appliance are reported by service tag
+func newAlertPolicyDevicesState(ctx context.Context, devIDs []int64, deviceServiceTags map[int64]string, prior models.OmeAlertPolicy,
+	diags *diag.Diagnostics) (types.Set, types.Set) {
+	priorIDs := []int64{}
+	priorServiceTags := []string{}
+	diags.Append(prior.DeviceIDs.ElementsAs(ctx, &priorIDs, true)...)
+	diags.Append(prior.DeviceServicetags.ElementsAs(ctx, &priorServiceTags, true)...)
+
+	ids := []int64{}
+	serviceTags := []string{}
+	for _, devID := range devIDs {
+		serviceTag, found := deviceServiceTags[devID]
+		byID := slices.Contains(priorIDs, devID)
+		idx := slices.IndexFunc(priorServiceTags, func(tag string) bool { return found && strings.EqualFold(tag, serviceTag) })
+		// the devices removed from the appliance can only be reported by id
+		if byID || !found {
+			ids = append(ids, devID)
+		}
+		if idx >= 0 {
+			serviceTags = append(serviceTags, priorServiceTags[idx])
+		} else if found && !byID {
+			serviceTags = append(serviceTags, serviceTag)
+		}
+	}
+
+	stateIDs := types.SetNull(types.Int64Type)
+	if !prior.DeviceIDs.IsNull() || len(ids) > 0 {
+		var d diag.Diagnostics
+		stateIDs, d = types.SetValueFrom(ctx, types.Int64Type, ids)
+		diags.Append(d...)
+	}
+	stateServiceTags, d := types.SetValueFrom(ctx, types.StringType, serviceTags)
+	diags.Append(d...)
+	return stateIDs, stateServiceTags
+}
+
+func newAlertPolicyCategoriesState(ctx context.Context, policyCatalogs []models.AlertPolicyCatalog, allCatalogs []models.AlertCategoryCatalog, prior []models.OmeAlertPolicyCategory, diags *diag.Diagnostics) []models.OmeAlertPolicyCategory {
+	if prior == nil {
+		return nil
+	}
+	ret := []models.OmeAlertPolicyCategory{}
+	for _, priorCategory := range prior {
+		for _, policyCatalog := range policyCatalogs {
+			if !strings.EqualFold(policyCatalog.CatalogName, priorCategory.CatalogName.ValueString()) {
+				continue
+			}
+			catalog, _ := findAlertCatalog(allCatalogs, policyCatalog.CatalogName)
+			item := models.OmeAlertPolicyCategory{
+				CatalogName:   priorCategory.CatalogName,
+				Categories:    priorCategory.Categories,
+				SubCategories: priorCategory.SubCategories,
+			}
+			if !item.Categories.IsNull() || len(policyCatalog.Categories) > 0 {
+				names := []string{}
+				for _, id := range policyCatalog.Categories {
+					for _, c := range catalog.CategoriesDetails {
+						if c.ID == id {
+							names = append(names, c.Name)
+						}
+					}
+				}
+				var d diag.Diagnostics
+				item.Categories, d = types.SetValueFrom(ctx, types.StringType, names)
+				diags.Append(d...)
+			}
+			if !item.SubCategories.IsNull() || len(policyCatalog.SubCategories) > 0 {
+				names := []string{}
+				for _, id := range policyCatalog.SubCategories {
+					if name, ok := findAlertSubCategoryName(catalog.CategoriesDetails, id); ok {
+						names = append(names, name)
+					}
+				}
+				var d diag.Diagnostics
+				item.SubCategories, d = types.SetValueFrom(ctx, types.StringType, names)
+				diags.Append(d...)
+			}
+			ret = append(ret, item)
+			break
+		}
+	}
+	return ret
+}
+
+func newAlertPolicyActionState(action models.AlertPolicyAction, prior models.OmeAlertPolicyAction, diags *diag.Diagnostics) models.OmeAlertPolicyAction {
+	ret := models.OmeAlertPolicyAction{
+		Name:       prior.Name,
+		Parameters: prior.Parameters,
+	}
+	if prior.Parameters.IsNull() || prior.Parameters.IsUnknown() {
+		ret.Parameters = types.MapNull(types.StringType)
+		return ret
+	}
+	// only the parameters given in the configuration are refreshed
+	params := map[string]attr.Value{}
+	for name := range prior.Parameters.Elements() {
+		for _, param := range action.ParameterDetails {
+			if param.Name == name {
+				params[name] = types.StringValue(param.Value)
+			}
+		}
+	}
+	var d diag.Diagnostics
+	ret.Parameters, d = types.MapValue(types.StringType, params)
+	diags.Append(d...)
+	return ret
+}
+
+func findAlertCatalog(catalogs []models.AlertCategoryCatalog, name string) (models.AlertCategoryCatalog, bool) {
+	for _, catalog := range catalogs {
+		if strings.EqualFold(catalog.Name, name) {
+			return catalog, true
+		}
+	}
+	return models.AlertCategoryCatalog{}, false
+}
+
+func findAlertSubCategoryID(categories []models.AlertCategory, name string) (int64, bool) {
+	for _, c := range categories {
+		for _, sc := range c.SubCategoryDetails {
+			if sc.Name == name {
+				return sc.ID, true
+			}
+		}
+	}
+	return 0, false
+}
+
+func findAlertSubCategoryName(categories []models.AlertCategory, id int64) (string, bool) {
+	for _, c := range categories {
+		for _, sc := range c.SubCategoryDetails {
+			if sc.ID == id {
+				return sc.Name, true
+			}
+		}
+	}
+	return "", false
+}
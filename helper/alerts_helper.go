@@ -0,0 +1,256 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+	"terraform-provider-ome/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// alertStatusAcknowledged is the status type of an acknowledged alert
+	alertStatusAcknowledged int64 = 1000
+	// alertStatusNotAcknowledged is the status type of an alert which is not acknowledged
+	alertStatusNotAcknowledged int64 = 2000
+
+	// AlertActionAcknowledge acknowledges the alerts
+	AlertActionAcknowledge = "acknowledge"
+	// AlertActionUnacknowledge unacknowledges the alerts
+	AlertActionUnacknowledge = "unacknowledge"
+	// AlertActionClear removes the alerts from the appliance
+	AlertActionClear = "clear"
+)
+
+// alertFilterSet holds the resolved filters of the alerts data source
+type alertFilterSet struct {
+	severities    map[int64]bool
+	categories    map[string]bool
+	deviceIDs     map[int64]bool
+	servicetags   map[string]bool
+	acknowledged  *bool
+	startTime     string
+	endTime       string
+	filterDevices bool
+}
+
+// GetAlerts get the alerts matching the filters
+func GetAlerts(ctx context.Context, client *clients.Client, filters *models.OmeAlertsFilters) ([]models.Alert, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	fs, d := newAlertFilterSet(ctx, client, filters)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if fs.filterDevices && len(fs.deviceIDs) == 0 && len(fs.servicetags) == 0 {
+		// the device filters resolved to no device, so no alert can match
+		return []models.Alert{}, diags
+	}
+
+	alerts, err := client.GetAlerts(fs.queryParams())
+	if err != nil {
+		diags.AddError(clients.ErrGnrReadAlerts, err.Error())
+		return nil, diags
+	}
+
+	// the appliance filter only covers part of the filters, so every alert is checked again
+	ret := make([]models.Alert, 0, len(alerts))
+	for _, alert := range alerts {
+		if fs.match(alert) {
+			ret = append(ret, alert)
+		}
+	}
+	return ret, diags
+}
+
+func newAlertFilterSet(ctx context.Context, client *clients.Client, filters *models.OmeAlertsFilters) (alertFilterSet, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	fs := alertFilterSet{
+		severities:  map[int64]bool{},
+		categories:  map[string]bool{},
+		deviceIDs:   map[int64]bool{},
+		servicetags: map[string]bool{},
+	}
+	if filters == nil {
+		return fs, diags
+	}
+
+	var severities, categories, servicetags []string
+	var deviceIDs, groupIDs []int64
+	diags.Append(filters.Severities.ElementsAs(ctx, &severities, true)...)
+	diags.Append(filters.CategoryNames.ElementsAs(ctx, &categories, true)...)
+	diags.Append(filters.DeviceServicetags.ElementsAs(ctx, &servicetags, true)...)
+	diags.Append(filters.DeviceIDs.ElementsAs(ctx, &deviceIDs, true)...)
+	diags.Append(filters.GroupIDs.ElementsAs(ctx, &groupIDs, true)...)
+	if diags.HasError() {
+		return fs, diags
+	}
+
+	for _, severity := range severities {
+		fs.severities[AlertSeverities[severity]] = true
+	}
+	for _, category := range categories {
+		fs.categories[category] = true
+	}
+	for _, id := range deviceIDs {
+		fs.deviceIDs[id] = true
+	}
+	for _, servicetag := range servicetags {
+		fs.servicetags[servicetag] = true
+	}
+	for _, groupID := range groupIDs {
+		devices, err := client.GetDevicesByGroupID(groupID)
+		if err != nil {
+			diags.AddError(clients.ErrGnrReadAlerts, fmt.Sprintf("unable to get the devices of the group %d: %s", groupID, err.Error()))
+			return fs, diags
+		}
+		for _, device := range devices.Value {
+			fs.deviceIDs[device.ID] = true
+		}
+	}
+	fs.filterDevices = len(deviceIDs)+len(servicetags)+len(groupIDs) > 0
+
+	if !filters.Acknowledged.IsNull() && !filters.Acknowledged.IsUnknown() {
+		acknowledged := filters.Acknowledged.ValueBool()
+		fs.acknowledged = &acknowledged
+	}
+	fs.startTime = filters.StartTime.ValueString()
+	fs.endTime = filters.EndTime.ValueString()
+	return fs, diags
+}
+
+// queryParams returns the OData filter of the appliance for the filters that can be expressed as a single condition
+func (fs alertFilterSet) queryParams() map[string]string {
+	conditions := []string{}
+	if fs.startTime != "" {
+		conditions = append(conditions, fmt.Sprintf("TimeStamp ge '%s'", fs.startTime))
+	}
+	if fs.endTime != "" {
+		conditions = append(conditions, fmt.Sprintf("TimeStamp le '%s'", fs.endTime))
+	}
+	if fs.acknowledged != nil {
+		conditions = append(conditions, fmt.Sprintf("StatusType eq %d", alertStatusType(*fs.acknowledged)))
+	}
+	if len(fs.severities) == 1 {
+		for severity := range fs.severities {
+			conditions = append(conditions, fmt.Sprintf("SeverityType eq %d", severity))
+		}
+	}
+	if len(fs.categories) == 1 {
+		for category := range fs.categories {
+			conditions = append(conditions, fmt.Sprintf("CategoryName eq '%s'", utils.EscapeODataString(category)))
+		}
+	}
+	if len(fs.deviceIDs) == 1 && len(fs.servicetags) == 0 {
+		for id := range fs.deviceIDs {
+			conditions = append(conditions, fmt.Sprintf("AlertDeviceId eq %d", id))
+		}
+	}
+	if len(fs.servicetags) == 1 && len(fs.deviceIDs) == 0 {
+		for servicetag := range fs.servicetags {
+			conditions = append(conditions, fmt.Sprintf("AlertDeviceIdentifier eq '%s'", utils.EscapeODataString(servicetag)))
+		}
+	}
+	if len(conditions) == 0 {
+		return nil
+	}
+	return map[string]string{"$filter": strings.Join(conditions, " and ")}
+}
+
+// match checks an alert against all the filters
+func (fs alertFilterSet) match(alert models.Alert) bool {
+	if len(fs.severities) > 0 && !fs.severities[alert.SeverityType] {
+		return false
+	}
+	if len(fs.categories) > 0 && !fs.categories[alert.CategoryName] {
+		return false
+	}
+	if fs.filterDevices && !fs.deviceIDs[alert.AlertDeviceID] && !fs.servicetags[alert.AlertDeviceIdentifier] {
+		return false
+	}
+	if fs.acknowledged != nil && alert.StatusType != alertStatusType(*fs.acknowledged) {
+		return false
+	}
+	// both sides use the format yyyy-MM-dd HH:mm:ss.SSS, so they compare as strings
+	if fs.startTime != "" && alert.TimeStamp < fs.startTime {
+		return false
+	}
+	if fs.endTime != "" && alert.TimeStamp > fs.endTime {
+		return false
+	}
+	return true
+}
+
+func alertStatusType(acknowledged bool) int64 {
+	if acknowledged {
+		return alertStatusAcknowledged
+	}
+	return alertStatusNotAcknowledged
+}
+
+// NewOmeAlertList maps the alerts of the appliance into the data source state
+func NewOmeAlertList(alerts []models.Alert) []models.OmeAlert {
+	ret := make([]models.OmeAlert, 0, len(alerts))
+	for _, alert := range alerts {
+		ret = append(ret, models.OmeAlert{
+			ID:                types.Int64Value(alert.ID),
+			Severity:          types.StringValue(alertSeverityName(alert)),
+			DeviceID:          types.Int64Value(alert.AlertDeviceID),
+			DeviceName:        types.StringValue(alert.AlertDeviceName),
+			DeviceIdentifier:  types.StringValue(alert.AlertDeviceIdentifier),
+			DeviceIP:          types.StringValue(alert.AlertDeviceIPAddress),
+			CatalogName:       types.StringValue(alert.CatalogName),
+			CategoryName:      types.StringValue(alert.CategoryName),
+			SubCategoryName:   types.StringValue(alert.SubCategoryName),
+			Acknowledged:      types.BoolValue(alert.StatusType == alertStatusAcknowledged),
+			Status:            types.StringValue(alert.StatusName),
+			TimeStamp:         types.StringValue(alert.TimeStamp),
+			Message:           types.StringValue(alert.Message),
+			MessageID:         types.StringValue(alert.AlertMessageID),
+			RecommendedAction: types.StringValue(alert.RecommendedAction),
+		})
+	}
+	return ret
+}
+
+// alertSeverityName returns the severity of the alert with the same names as the filters
+func alertSeverityName(alert models.Alert) string {
+	for name, value := range AlertSeverities {
+		if value == alert.SeverityType {
+			return name
+		}
+	}
+	return alert.SeverityName
+}
+
+// ApplyAlertAction acknowledges, unacknowledges or clears the given alerts
+func ApplyAlertAction(client *clients.Client, action string, ids []int64) error {
+	switch action {
+	case AlertActionAcknowledge:
+		return client.AcknowledgeAlerts(ids)
+	case AlertActionUnacknowledge:
+		return client.UnacknowledgeAlerts(ids)
+	case AlertActionClear:
+		return client.ClearAlerts(ids)
+	}
+	return fmt.Errorf("invalid alert action %s", action)
+}
@@ -0,0 +1,97 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"fmt"
+	"strings"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+	"terraform-provider-ome/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AuditLogSeverities maps the audit log severities to the appliance severity values
+var AuditLogSeverities = map[string]string{
+	"Info":     "1000",
+	"Warning":  "2000",
+	"Critical": "3000",
+}
+
+// GetAuditLogs get the audit logs matching the filters
+func GetAuditLogs(client *clients.Client, filters *models.OmeAuditLogsFilters) ([]models.AuditLog, error) {
+	return client.GetAuditLogs(MakeAuditLogsQuery(filters))
+}
+
+// MakeAuditLogsQuery builds the OData filter of the appliance from the data source filters
+func MakeAuditLogsQuery(filters *models.OmeAuditLogsFilters) map[string]string {
+	if filters == nil {
+		return nil
+	}
+	conditions := []string{}
+	if v := filters.UserName.ValueString(); v != "" {
+		conditions = append(conditions, fmt.Sprintf("UserName eq '%s'", utils.EscapeODataString(v)))
+	}
+	if v := filters.SourceIP.ValueString(); v != "" {
+		conditions = append(conditions, fmt.Sprintf("IpAddress eq '%s'", utils.EscapeODataString(v)))
+	}
+	if v := filters.Category.ValueString(); v != "" {
+		conditions = append(conditions, fmt.Sprintf("Category eq '%s'", utils.EscapeODataString(v)))
+	}
+	if v := filters.Severity.ValueString(); v != "" {
+		conditions = append(conditions, fmt.Sprintf("Severity eq '%s'", AuditLogSeverities[v]))
+	}
+	if v := filters.StartTime.ValueString(); v != "" {
+		conditions = append(conditions, fmt.Sprintf("CreatedDate ge '%s'", utils.EscapeODataString(v)))
+	}
+	if v := filters.EndTime.ValueString(); v != "" {
+		conditions = append(conditions, fmt.Sprintf("CreatedDate le '%s'", utils.EscapeODataString(v)))
+	}
+	if len(conditions) == 0 {
+		return nil
+	}
+	return map[string]string{"$filter": strings.Join(conditions, " and ")}
+}
+
+// NewOmeAuditLogList maps the audit logs of the appliance into the data source state
+func NewOmeAuditLogList(logs []models.AuditLog) []models.OmeAuditLog {
+	ret := make([]models.OmeAuditLog, 0, len(logs))
+	for _, log := range logs {
+		ret = append(ret, models.OmeAuditLog{
+			ID:          types.Int64Value(log.ID),
+			Severity:    types.StringValue(auditLogSeverityName(log.Severity)),
+			Message:     types.StringValue(log.Message),
+			MessageID:   types.StringValue(log.MessageID),
+			MessageArgs: types.StringValue(log.MessageArgs),
+			Category:    types.StringValue(log.Category),
+			UserName:    types.StringValue(log.UserName),
+			SourceIP:    types.StringValue(log.IPAddress),
+			CreatedDate: types.StringValue(log.CreatedDate),
+		})
+	}
+	return ret
+}
+
+// auditLogSeverityName returns the severity with the same names as the filters
+func auditLogSeverityName(severity string) string {
+	for name, value := range AuditLogSeverities {
+		if value == severity {
+			return name
+		}
+	}
+	return severity
+}
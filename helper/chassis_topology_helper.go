@@ -0,0 +1,167 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// chassisDeviceType is the device type of the chassis
+const chassisDeviceType int64 = 2000
+
+// chassisSlotTypes maps the device types of the sleds and IOMs to the names of the slot types
+var chassisSlotTypes = map[int64]string{
+	1000: "compute",
+	3000: "storage",
+	4000: "network_iom",
+	8000: "storage_iom",
+}
+
+// mcmRoles maps the domain roles of the multi-chassis management group to their names
+var mcmRoles = map[string]string{
+	"LEAD":       "lead",
+	"BACKUPLEAD": "backup_lead",
+	"MEMBER":     "member",
+	"STANDALONE": "standalone",
+}
+
+// GetChassisTopology returns the chassis selected by service tags, all the chassis when none is set,
+// with all the devices and the chassis of the multi-chassis management group
+func GetChassisTopology(client *clients.Client, serviceTags []string) ([]models.Device, []models.Device, []models.ManagementDomain, error) {
+	// all the devices are read at once, the sleds and IOMs reference their chassis in their slot configuration
+	devices, err := client.GetAllDevices(nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	chassis := []models.Device{}
+	for _, device := range devices.Value {
+		if device.Type != chassisDeviceType {
+			continue
+		}
+		if len(serviceTags) == 0 || slices.Contains(serviceTags, device.DeviceServiceTag) {
+			chassis = append(chassis, device)
+		}
+	}
+	if len(serviceTags) > 0 && len(chassis) != len(serviceTags) {
+		invalid := []string{}
+		for _, serviceTag := range serviceTags {
+			if !slices.ContainsFunc(chassis, func(c models.Device) bool { return c.DeviceServiceTag == serviceTag }) {
+				invalid = append(invalid, serviceTag)
+			}
+		}
+		return nil, nil, nil, fmt.Errorf("invalid chassis service tags: %v", invalid)
+	}
+	domains, err := client.GetManagementDomains()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("unable to get the multi-chassis management group: %s", err.Error())
+	}
+	return chassis, devices.Value, domains, nil
+}
+
+// GetChassisSlotDevice returns the sled or IOM in the slot of the chassis
+func GetChassisSlotDevice(client *clients.Client, serviceTag string, slotNumber int64) (models.Device, error) {
+	chassis, devices, _, err := GetChassisTopology(client, []string{serviceTag})
+	if err != nil {
+		return models.Device{}, err
+	}
+	for _, device := range devices {
+		if isInChassis(device, chassis[0]) && device.SlotConfiguration.SlotNumber == strconv.FormatInt(slotNumber, 10) {
+			return device, nil
+		}
+	}
+	return models.Device{}, fmt.Errorf("slot %d of chassis %s is empty", slotNumber, serviceTag)
+}
+
+// ChassisSlotTypeName returns the name of the slot type of a device
+func ChassisSlotTypeName(deviceType int64) string {
+	if name, ok := chassisSlotTypes[deviceType]; ok {
+		return name
+	}
+	return "other"
+}
+
+// isInChassis check if a device is in a slot of a chassis
+func isInChassis(device models.Device, chassis models.Device) bool {
+	if device.ID == chassis.ID || device.Type == chassisDeviceType {
+		return false
+	}
+	slot := device.SlotConfiguration
+	if slot.ChassisID != "" {
+		return slot.ChassisID == strconv.FormatInt(chassis.ID, 10)
+	}
+	return slot.ChassisServiceTag != "" && slot.ChassisServiceTag == chassis.DeviceServiceTag
+}
+
+// NewChassisTopologyState maps the chassis, their sleds and IOMs, and the multi-chassis management group into the data source state
+func NewChassisTopologyState(chassis []models.Device, devices []models.Device, domains []models.ManagementDomain,
+	plan models.OmeChassisTopologyData) models.OmeChassisTopologyData {
+	plan.MCMLeadServiceTag = types.StringNull()
+	roles := map[int64]string{}
+	for _, domain := range domains {
+		role := strings.ToUpper(domain.DomainRoleTypeValue)
+		roles[domain.DeviceID] = mcmRoles[role]
+		if role == "LEAD" {
+			plan.MCMLeadServiceTag = types.StringValue(domain.Identifier)
+		}
+	}
+
+	plan.Chassis = make([]models.OmeChassisTopology, 0, len(chassis))
+	for _, c := range chassis {
+		item := models.OmeChassisTopology{
+			ID:         types.Int64Value(c.ID),
+			ServiceTag: types.StringValue(c.DeviceServiceTag),
+			Name:       types.StringValue(c.DeviceName),
+			Model:      types.StringValue(c.Model),
+			MCMRole:    types.StringValue("standalone"),
+			Slots:      []models.OmeChassisSlot{},
+		}
+		if role, ok := roles[c.ID]; ok && role != "" {
+			item.MCMRole = types.StringValue(role)
+		}
+		for _, device := range devices {
+			if !isInChassis(device, c) {
+				continue
+			}
+			number, _ := strconv.ParseInt(device.SlotConfiguration.SlotNumber, 10, 64)
+			item.Slots = append(item.Slots, models.OmeChassisSlot{
+				Number:     types.Int64Value(number),
+				Name:       types.StringValue(device.SlotConfiguration.SlotName),
+				Type:       types.StringValue(ChassisSlotTypeName(device.Type)),
+				DeviceID:   types.Int64Value(device.ID),
+				ServiceTag: types.StringValue(device.DeviceServiceTag),
+				DeviceName: types.StringValue(device.DeviceName),
+				Model:      types.StringValue(device.Model),
+			})
+		}
+		// the slots are ordered by slot type, then by slot number
+		sort.SliceStable(item.Slots, func(i, j int) bool {
+			if item.Slots[i].Type.ValueString() != item.Slots[j].Type.ValueString() {
+				return item.Slots[i].Type.ValueString() < item.Slots[j].Type.ValueString()
+			}
+			return item.Slots[i].Number.ValueInt64() < item.Slots[j].Number.ValueInt64()
+		})
+		plan.Chassis = append(plan.Chassis, item)
+	}
+	return plan
+}
@@ -0,0 +1,438 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+	"time"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// ValidateTemplateAttributeValue checks the value of a template attribute against the constraints returned by OME
+func ValidateTemplateAttributeValue(editInfo models.OMEAttributeEditInfo, value string) error {
+	if len(editInfo.PossibleValues) > 0 {
+		possibleValues := []string{}
+		for _, possibleValue := range editInfo.PossibleValues {
+			if possibleValue.Value == value {
+				return nil
+			}
+			possibleValues = append(possibleValues, possibleValue.Value)
+		}
+		return fmt.Errorf("value %q is not one of the possible values: %s", value, strings.Join(possibleValues, ", "))
+	}
+	if strings.EqualFold(editInfo.DataType, "Integer") {
+		number, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			return fmt.Errorf("value %q is not an integer", value)
+		}
+		if editInfo.MinValue != nil && number < *editInfo.MinValue {
+			return fmt.Errorf("value %d is less than the minimum value %d", number, *editInfo.MinValue)
+		}
+		if editInfo.MaxValue != nil && number > *editInfo.MaxValue {
+			return fmt.Errorf("value %d is greater than the maximum value %d", number, *editInfo.MaxValue)
+		}
+		return nil
+	}
+	length := int64(utf8.RuneCountInString(value))
+	if editInfo.MinLength != nil && length < *editInfo.MinLength {
+		return fmt.Errorf("value %q is shorter than the minimum length %d", value, *editInfo.MinLength)
+	}
+	if editInfo.MaxLength != nil && length > *editInfo.MaxLength {
+		return fmt.Errorf("value %q is longer than the maximum length %d", value, *editInfo.MaxLength)
+	}
+	return nil
+}
+
+// ResolveDeviceAttributeOverrides resolves the attribute paths of the device attribute overrides against the attributes of the template
+// and checks their values. The resolved attributes are keyed by service tag, every invalid entry is reported at its own path
+func ResolveDeviceAttributeOverrides(ctx context.Context, client *clients.Client, templateID int64, overrides types.List) (map[string][]models.OMEAttribute, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	entries := []models.DeviceAttributeOverrides{}
+	diags.Append(overrides.ElementsAs(ctx, &entries, true)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	attributes, err := client.GetTemplateAttributes(templateID, []models.Attribute{}, true)
+	if err != nil {
+		diags.AddError(clients.ErrDeviceAttributeOverrides, fmt.Sprintf("unable to read the attributes of template %d: %s", templateID, err.Error()))
+		return nil, diags
+	}
+
+	editInfos := map[int64]models.OMEAttributeEditInfo{}
+	resolved := map[string][]models.OMEAttribute{}
+	for i, entry := range entries {
+		entryPath := path.Root("device_attribute_overrides").AtListIndex(i)
+		serviceTags := []string{}
+		values := map[string]string{}
+		diags.Append(entry.DeviceServiceTags.ElementsAs(ctx, &serviceTags, true)...)
+		diags.Append(entry.Attributes.ElementsAs(ctx, &values, true)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		entryAttributes := resolveTemplateAttributeValues(client, attributes, editInfos, values, entryPath.AtName("attributes"), &diags)
+
+		for _, serviceTag := range serviceTags {
+			for _, entryAttribute := range entryAttributes {
+				if slices.ContainsFunc(resolved[serviceTag], func(existing models.OMEAttribute) bool { return existing.ID == entryAttribute.ID }) {
+					diags.AddAttributeError(entryPath.AtName("device_servicetags"), clients.ErrDeviceAttributeOverrides,
+						fmt.Sprintf("attribute %d of device %s is overridden by several entries", entryAttribute.ID, serviceTag))
+					continue
+				}
+				resolved[serviceTag] = append(resolved[serviceTag], entryAttribute)
+			}
+		}
+	}
+	return resolved, diags
+}
+
+// resolveTemplateAttributeValues resolves the attribute paths of the values keyed by path against the attributes of the template
+// and checks their values, every invalid value is reported at its own key of the map path
+func resolveTemplateAttributeValues(client *clients.Client, attributes []models.OmeAttribute, editInfos map[int64]models.OMEAttributeEditInfo,
+	values map[string]string, valuesPath path.Path, diags *diag.Diagnostics) []models.OMEAttribute {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	resolved := []models.OMEAttribute{}
+	resolvedKeys := map[int]string{}
+	for _, key := range keys {
+		keyPath := valuesPath.AtMapKey(key)
+		index, err := FindTemplateAttribute(attributes, key)
+		if err != nil {
+			diags.AddAttributeError(keyPath, clients.ErrDeviceAttributeOverrides, err.Error())
+			continue
+		}
+		// a short and a full path can refer to the same attribute
+		if other, ok := resolvedKeys[index]; ok {
+			diags.AddAttributeError(keyPath, clients.ErrDeviceAttributeOverrides,
+				fmt.Sprintf("attributes %s and %s refer to the same attribute %s", other, key, attributes[index].DisplayName))
+			continue
+		}
+		resolvedKeys[index] = key
+		attribute := attributes[index]
+		if attribute.IsReadOnly {
+			diags.AddAttributeError(keyPath, clients.ErrDeviceAttributeOverrides, fmt.Sprintf("attribute %s is read only", attribute.DisplayName))
+			continue
+		}
+		if attribute.AttributeEditInfoID != 0 {
+			editInfo, ok := editInfos[attribute.AttributeEditInfoID]
+			if !ok {
+				editInfo, err = client.GetTemplateEditInfo(attribute.AttributeEditInfoID)
+				if err != nil {
+					diags.AddAttributeError(keyPath, clients.ErrDeviceAttributeOverrides,
+						fmt.Sprintf("unable to read the constraints of attribute %s: %s", attribute.DisplayName, err.Error()))
+					continue
+				}
+				editInfos[attribute.AttributeEditInfoID] = editInfo
+			}
+			if err := ValidateTemplateAttributeValue(editInfo, values[key]); err != nil {
+				diags.AddAttributeError(keyPath, clients.ErrDeviceAttributeOverrides, fmt.Sprintf("invalid value of attribute %s: %s", attribute.DisplayName, err.Error()))
+				continue
+			}
+		}
+		resolved = append(resolved, models.OMEAttribute{ID: attribute.AttributeID, Value: values[key], IsIgnored: false})
+	}
+	return resolved
+}
+
+// ResolveChassisSettings resolves the power and network settings of the lead chassis against the attributes of the chassis template
+// and checks their values, every invalid setting is reported at its own path
+func ResolveChassisSettings(ctx context.Context, client *clients.Client, templateID int64, chassisOptions types.Object) ([]models.OMEAttribute, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	options := models.ChassisDeployOptions{}
+	diags.Append(chassisOptions.As(ctx, &options, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true})...)
+	powerSettings := map[string]string{}
+	networkSettings := map[string]string{}
+	if !options.PowerSettings.IsNull() {
+		diags.Append(options.PowerSettings.ElementsAs(ctx, &powerSettings, true)...)
+	}
+	if !options.NetworkSettings.IsNull() {
+		diags.Append(options.NetworkSettings.ElementsAs(ctx, &networkSettings, true)...)
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+	if len(powerSettings) == 0 && len(networkSettings) == 0 {
+		diags.AddAttributeError(path.Root("chassis_options"), clients.ErrDeviceAttributeOverrides, "at least one of power_settings and network_settings must be set")
+		return nil, diags
+	}
+	attributes, err := client.GetTemplateAttributes(templateID, []models.Attribute{}, true)
+	if err != nil {
+		diags.AddError(clients.ErrDeviceAttributeOverrides, fmt.Sprintf("unable to read the attributes of template %d: %s", templateID, err.Error()))
+		return nil, diags
+	}
+
+	editInfos := map[int64]models.OMEAttributeEditInfo{}
+	optionsPath := path.Root("chassis_options")
+	settings := resolveTemplateAttributeValues(client, attributes, editInfos, powerSettings, optionsPath.AtName("power_settings"), &diags)
+	for _, networkSetting := range resolveTemplateAttributeValues(client, attributes, editInfos, networkSettings, optionsPath.AtName("network_settings"), &diags) {
+		if slices.ContainsFunc(settings, func(setting models.OMEAttribute) bool { return setting.ID == networkSetting.ID }) {
+			diags.AddAttributeError(optionsPath.AtName("network_settings"), clients.ErrDeviceAttributeOverrides,
+				fmt.Sprintf("attribute %d is set by both power_settings and network_settings", networkSetting.ID))
+			continue
+		}
+		settings = append(settings, networkSetting)
+	}
+	return settings, diags
+}
+
+// GetLeadChassis returns the target chassis the chassis settings apply to, which is the lead of the multi-chassis management group
+// among the targets, or the single target chassis
+func GetLeadChassis(client *clients.Client, devices []models.Device) (models.Device, error) {
+	chassis := []models.Device{}
+	for _, device := range devices {
+		if device.Type == chassisDeviceType {
+			chassis = append(chassis, device)
+		}
+	}
+	if len(chassis) == 0 {
+		return models.Device{}, fmt.Errorf("chassis_options require a chassis among the target devices")
+	}
+	domains, err := client.GetManagementDomains()
+	if err != nil {
+		return models.Device{}, fmt.Errorf("unable to get the multi-chassis management group: %s", err.Error())
+	}
+	for _, domain := range domains {
+		if !strings.EqualFold(domain.DomainRoleTypeValue, "LEAD") {
+			continue
+		}
+		for _, c := range chassis {
+			if c.ID == domain.DeviceID || c.DeviceServiceTag == domain.Identifier {
+				return c, nil
+			}
+		}
+	}
+	if len(chassis) == 1 {
+		return chassis[0], nil
+	}
+	return models.Device{}, fmt.Errorf("none of the target chassis is the lead of the multi-chassis management group, the chassis_options cannot be applied")
+}
+
+// AddLeadChassisSettings adds the chassis settings to the resolved attribute overrides of the lead chassis
+func AddLeadChassisSettings(resolved map[string][]models.OMEAttribute, leadServiceTag string, settings []models.OMEAttribute) error {
+	for _, setting := range settings {
+		for _, existing := range resolved[leadServiceTag] {
+			if existing.ID == setting.ID {
+				return fmt.Errorf("attribute %d of the lead chassis %s is set by both chassis_options and device_attribute_overrides", setting.ID, leadServiceTag)
+			}
+		}
+	}
+	resolved[leadServiceTag] = append(resolved[leadServiceTag], settings...)
+	return nil
+}
+
+// GetDeviceAttributeOverrides returns the resolved attribute overrides of the devices of the deployment, ordered by device id
+func GetDeviceAttributeOverrides(devices []models.Device, resolved map[string][]models.OMEAttribute) ([]models.OMEDeviceAttributes, error) {
+	deviceIDs := map[string]int64{}
+	for _, device := range devices {
+		deviceIDs[device.DeviceServiceTag] = device.ID
+	}
+	deviceAttributes := []models.OMEDeviceAttributes{}
+	for serviceTag, attributes := range resolved {
+		deviceID, ok := deviceIDs[serviceTag]
+		if !ok {
+			return nil, fmt.Errorf("device %s of the device attribute overrides is not a target of the deployment", serviceTag)
+		}
+		deviceAttributes = append(deviceAttributes, models.OMEDeviceAttributes{DeviceID: deviceID, Attributes: attributes})
+	}
+	sort.Slice(deviceAttributes, func(i, j int) bool {
+		return deviceAttributes[i].DeviceID < deviceAttributes[j].DeviceID
+	})
+	return deviceAttributes, nil
+}
+
+const (
+	// DeviceDeploymentDeployed is the status of a device the template is deployed to
+	DeviceDeploymentDeployed = "deployed"
+	// DeviceDeploymentFailed is the status of a device the deployment failed on
+	DeviceDeploymentFailed = "failed"
+	// DeviceDeploymentPending is the status of a device the template is not deployed to yet
+	DeviceDeploymentPending = "pending"
+	// DeviceDeploymentScheduled is the status of a device the deployment is scheduled for
+	DeviceDeploymentScheduled = "scheduled"
+)
+
+// DeploymentBatchOptions holds the options of a rolling deployment
+type DeploymentBatchOptions struct {
+	// number of devices deployed by each job, all the devices are deployed by a single job when not positive
+	BatchSize int64
+	// number of failed devices after which the deployment stops, there is no limit when negative
+	MaxFailures int64
+	// seconds to wait between two batches
+	PauseBetweenBatches int64
+	// the scheduled jobs are not tracked
+	RunLater      bool
+	JobRetryCount int64
+	SleepInterval int64
+}
+
+// GetDeploymentDeviceResults returns the outcome of a failed deployment job for each of its target devices
+// The devices missing from the execution details of the job get the message of the job
+func GetDeploymentDeviceResults(ctx context.Context, client *clients.Client, jobID int64, targetIDs []int64, message string) []models.DeviceDeploymentResult {
+	details := getJobDeviceExecutionDetails(ctx, client, jobID)
+	results := []models.DeviceDeploymentResult{}
+	for _, targetID := range targetIDs {
+		result := models.DeviceDeploymentResult{DeviceID: targetID, JobID: jobID, Status: DeviceDeploymentFailed, Message: message}
+		if detail, ok := details[targetID]; ok {
+			if detail.JobStatus.ID == CompletedWithSuccess {
+				result.Status = DeviceDeploymentDeployed
+			}
+			result.Message = detail.Value
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// GetDeploymentJobResults reads the outcome of a deployment job again for its target devices
+// The outcome is not known while the job is scheduled or running, or when the job cannot be read
+func GetDeploymentJobResults(ctx context.Context, client *clients.Client, jobID int64, targetIDs []int64) ([]models.DeviceDeploymentResult, bool) {
+	job, err := client.GetJob(jobID)
+	if err != nil {
+		tflog.Debug(ctx, "unable to read the deployment job", map[string]interface{}{"jobID": jobID, "error": err.Error()})
+		return nil, false
+	}
+	return getDeploymentJobResults(ctx, client, jobID, job, targetIDs)
+}
+
+// getDeploymentJobResults returns the outcome of a deployment job which ended for its target devices
+func getDeploymentJobResults(ctx context.Context, client *clients.Client, jobID int64, job clients.JobResp, targetIDs []int64) ([]models.DeviceDeploymentResult, bool) {
+	switch job.LastRunStatus.ID {
+	case CompletedWithSuccess:
+		results := []models.DeviceDeploymentResult{}
+		for _, targetID := range targetIDs {
+			results = append(results, models.DeviceDeploymentResult{DeviceID: targetID, JobID: jobID, Status: DeviceDeploymentDeployed, Message: clients.SuccessMsg})
+		}
+		return results, true
+	case Failed, CompletedWithError, Aborted, Stopped, Cancelled:
+		return GetDeploymentDeviceResults(ctx, client, jobID, targetIDs, fmt.Sprintf("the deployment job %d ended with status %s", jobID, job.LastRunStatus.Name)), true
+	}
+	return nil, false
+}
+
+// GetDeploymentJobDeviceResults returns the outcome of a deployment job for each of its target devices,
+// the targets of a job which is scheduled or running are scheduled
+func GetDeploymentJobDeviceResults(ctx context.Context, client *clients.Client, jobID int64) ([]models.DeviceDeploymentResult, error) {
+	job, err := client.GetJob(jobID)
+	if err != nil {
+		return nil, err
+	}
+	targetIDs := []int64{}
+	for _, target := range job.Targets {
+		targetIDs = append(targetIDs, target.ID)
+	}
+	if results, ok := getDeploymentJobResults(ctx, client, jobID, job, targetIDs); ok {
+		return results, nil
+	}
+	results := []models.DeviceDeploymentResult{}
+	for _, targetID := range targetIDs {
+		results = append(results, models.DeviceDeploymentResult{DeviceID: targetID, JobID: jobID, Status: DeviceDeploymentScheduled})
+	}
+	return results, nil
+}
+
+// getJobDeviceExecutionDetails returns the details of the last execution of a job keyed by the id of their device
+// The details are empty when they cannot be read
+func getJobDeviceExecutionDetails(ctx context.Context, client *clients.Client, jobID int64) map[int64]clients.LastExecutionDetail {
+	details := map[int64]clients.LastExecutionDetail{}
+	jobRunner := JobRunner{client: client, jobID: jobID}
+	led, err := jobRunner.GetLastJobExecution(ctx)
+	if err == nil {
+		var ehd clients.ExecutionHistories
+		ehd, err = jobRunner.GetExecutionDetails(ctx, int64(led.ExecutionHistoryID))
+		for _, detail := range ehd.ExecutionDetails {
+			details[detail.IDBaseEntity] = detail
+		}
+	}
+	if err != nil {
+		tflog.Debug(ctx, "unable to read the execution details of the job", map[string]interface{}{"jobID": jobID, "error": err.Error()})
+	}
+	return details
+}
+
+// DeployTemplateInBatches deploys the template to the target devices of the request in batches and tracks the job of each batch
+// The deployment stops with an error when the number of failed devices exceeds the failure budget, the results of the deployed batches are returned
+func DeployTemplateInBatches(ctx context.Context, client *clients.Client, request models.OMETemplateDeployRequest, opts DeploymentBatchOptions) ([]models.DeviceDeploymentResult, error) {
+	results := []models.DeviceDeploymentResult{}
+	targetIDs := request.TargetIDS
+	batchSize := int(opts.BatchSize)
+	if batchSize <= 0 || batchSize > len(targetIDs) {
+		batchSize = len(targetIDs)
+	}
+	failures := int64(0)
+	for start := 0; start < len(targetIDs); start += batchSize {
+		if start > 0 && opts.PauseBetweenBatches > 0 {
+			tflog.Info(ctx, "pausing between deployment batches", map[string]interface{}{"seconds": opts.PauseBetweenBatches})
+			time.Sleep(time.Second * time.Duration(opts.PauseBetweenBatches))
+		}
+		batch := targetIDs[start:min(start+batchSize, len(targetIDs))]
+		batchRequest := request
+		batchRequest.TargetIDS = batch
+		if len(request.Attributes) > 0 {
+			batchRequest.Attributes = []models.OMEDeviceAttributes{}
+			for _, deviceAttributes := range request.Attributes {
+				if slices.Contains(batch, deviceAttributes.DeviceID) {
+					batchRequest.Attributes = append(batchRequest.Attributes, deviceAttributes)
+				}
+			}
+		}
+
+		tflog.Info(ctx, "deploying the template to a batch of devices", map[string]interface{}{"targets": batch})
+		jobID, err := client.CreateDeployment(batchRequest)
+		if err != nil {
+			return results, err
+		}
+		if opts.RunLater {
+			for _, targetID := range batch {
+				results = append(results, models.DeviceDeploymentResult{DeviceID: targetID, JobID: jobID, Status: DeviceDeploymentScheduled})
+			}
+			continue
+		}
+
+		isSuccess, message := client.TrackJob(jobID, opts.JobRetryCount, opts.SleepInterval)
+		if isSuccess {
+			for _, targetID := range batch {
+				results = append(results, models.DeviceDeploymentResult{DeviceID: targetID, JobID: jobID, Status: DeviceDeploymentDeployed, Message: message})
+			}
+			continue
+		}
+		for _, result := range GetDeploymentDeviceResults(ctx, client, jobID, batch, message) {
+			if result.Status == DeviceDeploymentFailed {
+				failures++
+			}
+			results = append(results, result)
+		}
+		if opts.MaxFailures >= 0 && failures > opts.MaxFailures {
+			return results, fmt.Errorf("%d devices failed to deploy, which exceeds the failure budget of %d, the deployment to the remaining devices is stopped", failures, opts.MaxFailures)
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,213 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// PrecheckMissingVlan - the networks of the template are not available on the device or its fabric
+	PrecheckMissingVlan = "missing_vlan"
+	// PrecheckIdentityPoolExhausted - the identity pool cannot reserve the identities of the device
+	PrecheckIdentityPoolExhausted = "identity_pool_exhausted"
+	// PrecheckUnsupportedAttribute - an attribute of the template is not supported by the device
+	PrecheckUnsupportedAttribute = "unsupported_attribute"
+	// PrecheckAttributeChange - an attribute of the device is changed by the remediation
+	PrecheckAttributeChange = "attribute_change"
+	// PrecheckOther - any other message of the precheck
+	PrecheckOther = "other"
+)
+
+// ClassifyPrecheckMessage returns the category of a message reported by a precheck
+// OME reports the findings of a precheck as free text without message ids, the category is a best effort guess from the keywords of the message
+func ClassifyPrecheckMessage(message string) string {
+	lower := strings.ToLower(message)
+	switch {
+	case strings.Contains(lower, "vlan"):
+		return PrecheckMissingVlan
+	case strings.Contains(lower, "identity pool"), strings.Contains(lower, "identitypool"), strings.Contains(lower, "identities"):
+		return PrecheckIdentityPoolExhausted
+	case strings.Contains(lower, "not supported"), strings.Contains(lower, "unsupported"), strings.Contains(lower, "not applicable"):
+		return PrecheckUnsupportedAttribute
+	}
+	return PrecheckOther
+}
+
+// getPrecheckFindings splits the message of a precheck into its findings, one for each line
+// Unless all is set, only the lines of a known category are kept
+func getPrecheckFindings(message string, all bool) []models.DevicePrecheckFinding {
+	findings := []models.DevicePrecheckFinding{}
+	for _, line := range strings.Split(message, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		category := ClassifyPrecheckMessage(line)
+		if category == PrecheckOther && !all {
+			continue
+		}
+		findings = append(findings, models.DevicePrecheckFinding{Category: category, Message: line})
+	}
+	return findings
+}
+
+// RunDeploymentPrecheck runs the precheck only job of a deployment request and returns its findings for each device
+// The findings of a device which passed are its warnings, for a device which failed all the lines of its message are returned
+func RunDeploymentPrecheck(ctx context.Context, client *clients.Client, request models.OMETemplateDeployRequest, devices []models.Device,
+	jobRetryCount, sleepInterval int64) (int64, []models.DevicePrecheckResult, error) {
+	request.Options.PrecheckOnly = true
+	request.Schedule = models.OMESchedule{RunNow: true}
+	jobID, err := client.CreateDeployment(request)
+	if err != nil {
+		return 0, nil, err
+	}
+	tflog.Info(ctx, "tracking the precheck job of the deployment", map[string]interface{}{"jobID": jobID})
+	isSuccess, message := client.TrackJob(jobID, jobRetryCount, sleepInterval)
+	details := getJobDeviceExecutionDetails(ctx, client, jobID)
+
+	results := []models.DevicePrecheckResult{}
+	for _, device := range devices {
+		result := models.DevicePrecheckResult{DeviceID: device.ID, DeviceServiceTag: device.DeviceServiceTag, Passed: isSuccess}
+		deviceMessage := message
+		if detail, ok := details[device.ID]; ok {
+			result.Passed = detail.JobStatus.ID == CompletedWithSuccess
+			deviceMessage = detail.Value
+		}
+		result.Message = deviceMessage
+		result.Findings = getPrecheckFindings(deviceMessage, !result.Passed)
+		results = append(results, result)
+	}
+	return jobID, results, nil
+}
+
+// RunRemediationPrecheck returns the findings of the remediation of a configuration baseline for each device
+// OME has no precheck job for a remediation, the findings are the non compliant attributes of the last compliance check of the baseline,
+// which is not run again
+// When no device is given, the non compliant devices of the baseline are checked
+func RunRemediationPrecheck(ctx context.Context, client *clients.Client, baseline models.OmeBaseline, deviceIDs []int64) ([]models.DevicePrecheckResult, error) {
+	reports, err := client.GetAllConfiBaselineDeviceReport(baseline.ID)
+	if err != nil {
+		return nil, err
+	}
+	reportByID := map[int64]models.OMEDeviceComplianceReport{}
+	for _, report := range reports {
+		reportByID[report.ID] = report
+	}
+	if len(deviceIDs) == 0 {
+		for _, report := range reports {
+			if report.ComplianceStatus != complianceStatusCompliant {
+				deviceIDs = append(deviceIDs, report.ID)
+			}
+		}
+	}
+
+	results := []models.DevicePrecheckResult{}
+	for _, deviceID := range deviceIDs {
+		report, ok := reportByID[deviceID]
+		if !ok {
+			return nil, fmt.Errorf("device %d is not a target of configuration baseline %s", deviceID, baseline.Name)
+		}
+		result := models.DevicePrecheckResult{DeviceID: deviceID, DeviceServiceTag: report.ServiceTag, Passed: true, Findings: []models.DevicePrecheckFinding{}}
+		if report.ComplianceStatus != complianceStatusCompliant {
+			tflog.Debug(ctx, "reading the compliance of a device for the remediation precheck", map[string]interface{}{"deviceID": deviceID})
+			detail, err := client.GetBaselineDeviceComplianceDetail(baseline.ID, deviceID)
+			if err != nil {
+				return nil, err
+			}
+			for _, group := range detail.ComplianceAttributeGroups {
+				result.Findings = append(result.Findings, getRemediationFindings(group, "")...)
+			}
+		}
+		for _, finding := range result.Findings {
+			if finding.Category != PrecheckAttributeChange {
+				result.Passed = false
+			}
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func getRemediationFindings(group models.OMEComplianceAttributeGroup, parent string) []models.DevicePrecheckFinding {
+	path := group.DisplayName
+	if parent != "" {
+		path = parent + templateAttributePathSeparator + group.DisplayName
+	}
+	findings := []models.DevicePrecheckFinding{}
+	for _, attribute := range group.Attributes {
+		if attribute.ComplianceStatus == complianceStatusCompliant {
+			continue
+		}
+		attributePath := path + templateAttributePathSeparator + attribute.DisplayName
+		category := PrecheckAttributeChange
+		if attribute.ComplianceReason != "" {
+			if reasonCategory := ClassifyPrecheckMessage(attribute.ComplianceReason); reasonCategory != PrecheckOther {
+				category = reasonCategory
+			}
+		}
+		message := fmt.Sprintf("%s: %s -> %s", attributePath, precheckValue(attribute.Value), precheckValue(attribute.ExpectedValue))
+		if attribute.ComplianceReason != "" {
+			message = fmt.Sprintf("%s: %s", message, attribute.ComplianceReason)
+		}
+		findings = append(findings, models.DevicePrecheckFinding{Category: category, Message: message})
+	}
+	for _, subGroup := range group.ComplianceSubAttributeGroups {
+		findings = append(findings, getRemediationFindings(subGroup, path)...)
+	}
+	return findings
+}
+
+func precheckValue(value *string) string {
+	if value == nil {
+		return "<unset>"
+	}
+	return *value
+}
+
+// SetStateDeploymentPrecheck maps the results of a precheck into the data source state
+func SetStateDeploymentPrecheck(id string, jobID int64, results []models.DevicePrecheckResult, state models.OmeDeploymentPrecheck) models.OmeDeploymentPrecheck {
+	state.ID = types.StringValue(id)
+	state.JobID = types.Int64Value(jobID)
+	passed := true
+	state.Devices = make([]models.OmeDevicePrecheckResult, 0, len(results))
+	for _, result := range results {
+		passed = passed && result.Passed
+		device := models.OmeDevicePrecheckResult{
+			DeviceID:         types.Int64Value(result.DeviceID),
+			DeviceServiceTag: types.StringValue(result.DeviceServiceTag),
+			Passed:           types.BoolValue(result.Passed),
+			Message:          types.StringValue(result.Message),
+			Findings:         make([]models.OmeDevicePrecheckFinding, 0, len(result.Findings)),
+		}
+		for _, finding := range result.Findings {
+			device.Findings = append(device.Findings, models.OmeDevicePrecheckFinding{
+				Category: types.StringValue(finding.Category),
+				Message:  types.StringValue(finding.Message),
+			})
+		}
+		state.Devices = append(state.Devices, device)
+	}
+	state.Passed = types.BoolValue(passed)
+	return state
+}
@@ -0,0 +1,126 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"fmt"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// subsystemRollupInventoryType is the inventory type of the rollup status of the subsystems of a device
+const subsystemRollupInventoryType = "subsystemRollupStatus"
+
+// DeviceHealthStatuses are the health statuses of the devices and their subsystems
+var DeviceHealthStatuses = []string{"normal", "warning", "critical", "unknown"}
+
+// deviceHealthStatusNames maps the health statuses of the appliance to their names
+var deviceHealthStatusNames = map[int64]string{
+	1000: "normal",
+	2000: "unknown",
+	3000: "warning",
+	4000: "critical",
+	5000: "unknown",
+}
+
+// devicePowerStateNames maps the power states of the appliance to their names
+var devicePowerStateNames = map[int64]string{
+	17: "on",
+	18: "off",
+	20: "powering_on",
+	21: "powering_off",
+}
+
+// GetHealthDevices returns the devices selected by ids, service tags, group names or filter expression,
+// all the devices when none is set
+func GetHealthDevices(client *clients.Client, ids []int64, serviceTags []string, groupNames []string, filter string) ([]models.Device, error) {
+	if len(ids) > 0 || len(serviceTags) > 0 || len(groupNames) > 0 {
+		return client.GetDevices(serviceTags, ids, groupNames)
+	}
+	var queries map[string]string
+	if filter != "" {
+		queries = map[string]string{"$filter": filter}
+	}
+	devices, err := client.GetAllDevices(queries)
+	return devices.Value, err
+}
+
+// GetSubsystemHealth returns the rollup status of the subsystems of a device without fetching its full inventory
+func GetSubsystemHealth(client *clients.Client, deviceID int64) ([]models.SubSystemRollupStatus, error) {
+	inv, err := client.GetDeviceInventoryByType(deviceID, subsystemRollupInventoryType)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the subsystem health of device %d: %s", deviceID, err.Error())
+	}
+	return inv.SubSystemRollupStatus, nil
+}
+
+// DeviceHealthStatusName returns the name of a health status
+func DeviceHealthStatusName(status int64) string {
+	if name, ok := deviceHealthStatusNames[status]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// DevicePowerStateName returns the name of a power state
+func DevicePowerStateName(state int64) string {
+	if name, ok := devicePowerStateNames[state]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// NewDeviceHealth maps a device and the rollup status of its subsystems into the terraform state
+func NewDeviceHealth(device models.Device, subsystems []models.SubSystemRollupStatus) models.OmeDeviceHealth {
+	ret := models.OmeDeviceHealth{
+		ID:              types.Int64Value(device.ID),
+		ServiceTag:      types.StringValue(device.DeviceServiceTag),
+		Name:            types.StringValue(device.DeviceName),
+		Model:           types.StringValue(device.Model),
+		GlobalStatus:    types.StringValue(DeviceHealthStatusName(device.Status)),
+		ConnectionState: types.BoolValue(device.ConnectionState),
+		PowerState:      types.StringValue(DevicePowerStateName(device.PowerState)),
+		LastStatusTime:  types.StringValue(device.LastStatusTime),
+		Subsystems:      []models.OmeSubsystemHealth{},
+	}
+	for _, subsystem := range subsystems {
+		ret.Subsystems = append(ret.Subsystems, models.OmeSubsystemHealth{
+			Name:   types.StringValue(subsystem.SubsystemName),
+			Status: types.StringValue(DeviceHealthStatusName(subsystem.Status)),
+		})
+	}
+	return ret
+}
+
+// NewDeviceHealthStatusCounts counts the devices by global status, every status is present in the counts
+func NewDeviceHealthStatusCounts(devices []models.OmeDeviceHealth) (types.Map, diag.Diagnostics) {
+	counts := map[string]int64{}
+	for _, status := range DeviceHealthStatuses {
+		counts[status] = 0
+	}
+	for _, device := range devices {
+		counts[device.GlobalStatus.ValueString()]++
+	}
+	elements := map[string]attr.Value{}
+	for status, count := range counts {
+		elements[status] = types.Int64Value(count)
+	}
+	return types.MapValue(types.Int64Type, elements)
+}
@@ -0,0 +1,213 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// onboardingPollInterval is the interval in seconds between two polls of the onboarding jobs and inventory
+const onboardingPollInterval int64 = 10
+
+// DeviceManagedStates maps the managed states of the devices to the appliance values
+var DeviceManagedStates = map[string]int64{
+	"managed":   3000,
+	"monitored": 5000,
+}
+
+// CreateOnboardingDiscovery creates the discovery job of the device to onboard
+func CreateOnboardingDiscovery(client *clients.Client, payload models.DiscoveryJob) (models.DiscoveryJob, error) {
+	return client.CreateDiscoveryJob(payload)
+}
+
+// DeleteOnboardingDiscovery deletes the discovery job of an onboarded device
+func DeleteOnboardingDiscovery(client *clients.Client, discoveryJobID int64) error {
+	_, err := client.DeleteDiscoveryJob(models.DiscoveryJobDeletePayload{
+		DiscoveryGroupIds: []int{int(discoveryJobID)},
+	})
+	return err
+}
+
+// DiscoverOnboardingDevice waits for the discovery job to complete and returns the device discovered with the management ip
+func DiscoverOnboardingDevice(ctx context.Context, client *clients.Client, jobID int64, managementIP string, timeout int64) (models.Device, error) {
+	results, err := DiscoverJobRunner(ctx, client, jobID, timeout, false)
+	if err != nil {
+		return models.Device{}, fmt.Errorf("discovery job %d of %s did not complete in %d minutes: %s", jobID, managementIP, timeout, err.Error())
+	}
+	device, err := GetOnboardedDevice(client, managementIP)
+	if err != nil {
+		return device, fmt.Errorf("%s, discovery results: %s", err.Error(), strings.Join(results, "; "))
+	}
+	return device, nil
+}
+
+// GetOnboardedDevice returns the device managed with the management ip
+func GetOnboardedDevice(client *clients.Client, managementIP string) (models.Device, error) {
+	devices, err := client.GetDeviceByIps([]string{managementIP})
+	if err != nil {
+		return models.Device{}, err
+	}
+	if len(devices) == 0 {
+		return models.Device{}, fmt.Errorf("no device is discovered with the management ip %s", managementIP)
+	}
+	return devices[0], nil
+}
+
+// GetDeviceByID returns a device by its id
+func GetDeviceByID(client *clients.Client, deviceID int64) (models.Device, error) {
+	return client.GetDevice("", deviceID)
+}
+
+// SetDeviceManagedState runs the onboarding job of the device with the connection profile and waits for its completion
+func SetDeviceManagedState(client *clients.Client, deviceID int64, managedState string, connectionProfile string, timeout int64) error {
+	job, err := client.OnboardDevices([]int64{deviceID}, DeviceManagedStates[managedState], connectionProfile, clients.JobOpts{
+		Name:        fmt.Sprintf("Onboarding_%d_%d", deviceID, time.Now().Unix()),
+		Description: fmt.Sprintf("Onboard device %d as %s", deviceID, managedState),
+		RunNow:      true,
+	})
+	if err != nil {
+		return err
+	}
+	if ok, message := client.TrackJob(job.ID, timeout*60/onboardingPollInterval, onboardingPollInterval); !ok {
+		return fmt.Errorf("onboarding job %d of device %d failed: %s", job.ID, deviceID, message)
+	}
+	return nil
+}
+
+// UpdateDeviceGroups adds the device to the groups of the plan and removes it from the groups which are only in the state
+func UpdateDeviceGroups(client *clients.Client, deviceID int64, plan, state []int64) error {
+	for _, groupID := range plan {
+		if slices.Contains(state, groupID) {
+			continue
+		}
+		payload := models.GroupMemberPayload{GroupID: groupID, DeviceIds: []int64{deviceID}}
+		if err := client.AddGroupMembers(payload); err != nil {
+			return fmt.Errorf("unable to add device %d to group %d: %s", deviceID, groupID, err.Error())
+		}
+	}
+	for _, groupID := range state {
+		if slices.Contains(plan, groupID) {
+			continue
+		}
+		payload := models.GroupMemberPayload{GroupID: groupID, DeviceIds: []int64{deviceID}}
+		if err := client.RemoveGroupMembers(payload); err != nil {
+			return fmt.Errorf("unable to remove device %d from group %d: %s", deviceID, groupID, err.Error())
+		}
+	}
+	return nil
+}
+
+// GetDeviceGroupIDs returns the groups among the given groups which have the device as member
+func GetDeviceGroupIDs(client *clients.Client, deviceID int64, groupIDs []int64) ([]int64, error) {
+	ret := []int64{}
+	for _, groupID := range groupIDs {
+		devices, err := client.GetDevicesByGroupID(groupID)
+		if err != nil {
+			return ret, fmt.Errorf("unable to get the devices of group %d: %s", groupID, err.Error())
+		}
+		for _, device := range devices.Value {
+			if device.ID == deviceID {
+				ret = append(ret, groupID)
+				break
+			}
+		}
+	}
+	return ret, nil
+}
+
+// WaitForDeviceInventory polls the inventory of the device until at least one inventory type is collected
+func WaitForDeviceInventory(client *clients.Client, deviceID int64, timeout int64) error {
+	for retries := timeout * 60 / onboardingPollInterval; retries > 0; retries-- {
+		inventoryTypes, err := client.GetDeviceInventoryTypes(deviceID)
+		if err != nil {
+			return err
+		}
+		if len(inventoryTypes) > 0 {
+			return nil
+		}
+		time.Sleep(time.Second * time.Duration(onboardingPollInterval))
+	}
+	return fmt.Errorf("inventory of device %d is not populated after %d minutes", deviceID, timeout)
+}
+
+// DeviceManagedStateName returns the managed state of the device as a string
+func DeviceManagedStateName(device models.Device) string {
+	for name, value := range DeviceManagedStates {
+		if value == device.ManagedState {
+			return name
+		}
+	}
+	return "other"
+}
+
+// NewDeviceOnboardingState maps the onboarded device into the terraform state
+func NewDeviceOnboardingState(device models.Device, groupIDs []int64, plan models.OmeDeviceOnboarding) (models.OmeDeviceOnboarding, diag.Diagnostics) {
+	var d diag.Diagnostics
+	state := plan
+	state.ID = types.Int64Value(device.ID)
+	state.ServiceTag = types.StringValue(device.DeviceServiceTag)
+	state.DeviceName = types.StringValue(device.DeviceName)
+	state.ManagedState = types.StringValue(DeviceManagedStateName(device))
+	if !plan.GroupIDs.IsNull() {
+		ids := []attr.Value{}
+		for _, id := range groupIDs {
+			ids = append(ids, types.Int64Value(id))
+		}
+		state.GroupIDs, d = types.SetValue(types.Int64Type, ids)
+	}
+	return state, d
+}
+
+// GetManagedDevice returns the device with the id or, when the id is 0, with the service tag
+func GetManagedDevice(client *clients.Client, deviceID int64, serviceTag string) (models.Device, error) {
+	return client.GetDevice(serviceTag, deviceID)
+}
+
+// ApplyDeviceManagement runs the onboarding job of the device with the managed state and the connection profile,
+// then validates that the device reached the managed state
+func ApplyDeviceManagement(client *clients.Client, deviceID int64, managedState string, connectionProfile string, timeout int64) (models.Device, error) {
+	if err := SetDeviceManagedState(client, deviceID, managedState, connectionProfile, timeout); err != nil {
+		return models.Device{}, err
+	}
+	device, err := GetDeviceByID(client, deviceID)
+	if err != nil {
+		return device, err
+	}
+	if current := DeviceManagedStateName(device); current != managedState {
+		return device, fmt.Errorf("device %d is %s after the onboarding job instead of %s", deviceID, current, managedState)
+	}
+	return device, nil
+}
+
+// NewDeviceManagementState maps the managed device into the terraform state
+func NewDeviceManagementState(device models.Device, plan models.OmeDeviceManagement) models.OmeDeviceManagement {
+	state := plan
+	state.ID = types.Int64Value(device.ID)
+	state.DeviceID = types.Int64Value(device.ID)
+	state.ServiceTag = types.StringValue(device.DeviceServiceTag)
+	state.ManagedState = types.StringValue(DeviceManagedStateName(device))
+	return state
+}
@@ -0,0 +1,117 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"fmt"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// GetDeviceIDByServiceTag returns the id of the device with the service tag
+func GetDeviceIDByServiceTag(client *clients.Client, serviceTag string) (int64, error) {
+	device, err := client.GetDevice(serviceTag, 0)
+	return device.ID, err
+}
+
+// ReadDeviceProperties returns the name, the asset tag and the location of a device
+func ReadDeviceProperties(client *clients.Client, deviceID int64) (models.DeviceProperties, models.DeviceLocationSetting, error) {
+	props, err := client.GetDeviceProperties(deviceID)
+	if err != nil {
+		return props, models.DeviceLocationSetting{}, fmt.Errorf("unable to get the properties of device %d: %s", deviceID, err.Error())
+	}
+	location, err := client.GetDeviceLocation(deviceID)
+	if err != nil {
+		return props, location, fmt.Errorf("unable to get the location of device %d: %s", deviceID, err.Error())
+	}
+	return props, location, nil
+}
+
+// UpdateDeviceProperties sets the properties of the plan which differ from the current properties of the device,
+// the properties which are not in the plan are left untouched
+func UpdateDeviceProperties(client *clients.Client, deviceID int64, plan models.OmeDeviceProperties) error {
+	props, location, err := ReadDeviceProperties(client, deviceID)
+	if err != nil {
+		return err
+	}
+
+	newProps := props
+	if !plan.Name.IsNull() && !plan.Name.IsUnknown() {
+		newProps.DeviceName = plan.Name.ValueString()
+	}
+	if !plan.AssetTag.IsNull() && !plan.AssetTag.IsUnknown() {
+		newProps.AssetTag = plan.AssetTag.ValueString()
+	}
+	if newProps != props {
+		newProps.ID = deviceID
+		if err := client.UpdateDeviceProperties(newProps); err != nil {
+			return fmt.Errorf("unable to update the properties of device %d: %s", deviceID, err.Error())
+		}
+	}
+
+	if plan.Location == nil {
+		return nil
+	}
+	newLocation := models.DeviceLocationSetting{
+		SettingType: location.SettingType,
+		DataCenter:  plan.Location.Datacenter.ValueString(),
+		Room:        plan.Location.Room.ValueString(),
+		Aisle:       plan.Location.Aisle.ValueString(),
+		RackName:    plan.Location.Rack.ValueString(),
+		RackSlot:    plan.Location.RackSlot.ValueInt64(),
+	}
+	if newLocation != location {
+		if err := client.UpdateDeviceLocation(deviceID, newLocation); err != nil {
+			return fmt.Errorf("unable to update the location of device %d: %s", deviceID, err.Error())
+		}
+	}
+	return nil
+}
+
+// NewDevicePropertiesState maps the properties of the device into the terraform state,
+// only the properties managed in the prior state are set
+func NewDevicePropertiesState(deviceID int64, props models.DeviceProperties, location models.DeviceLocationSetting, prior models.OmeDeviceProperties) models.OmeDeviceProperties {
+	state := prior
+	state.ID = types.Int64Value(deviceID)
+	if !prior.Name.IsNull() {
+		state.Name = types.StringValue(props.DeviceName)
+	}
+	if !prior.AssetTag.IsNull() {
+		state.AssetTag = types.StringValue(props.AssetTag)
+	}
+	if prior.Location != nil {
+		state.Location = &models.OmeDevicePropertiesLocation{
+			Datacenter: types.StringValue(location.DataCenter),
+			Room:       types.StringValue(location.Room),
+			Aisle:      types.StringValue(location.Aisle),
+			Rack:       types.StringValue(location.RackName),
+			RackSlot:   types.Int64Value(location.RackSlot),
+		}
+	}
+	return state
+}
+
+// ImportStateDeviceProperties returns the prior state of an imported device, all the properties are managed
+func ImportStateDeviceProperties(serviceTag string) models.OmeDeviceProperties {
+	return models.OmeDeviceProperties{
+		ServiceTag: types.StringValue(serviceTag),
+		Name:       types.StringValue(""),
+		AssetTag:   types.StringValue(""),
+		Location:   &models.OmeDevicePropertiesLocation{},
+	}
+}
@@ -0,0 +1,382 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// StaticGroupMembershipTypeID is the membership type of the static groups
+const StaticGroupMembershipTypeID int64 = 12
+
+// GroupHierarchyNode - group of a group hierarchy with its level, its path from the root group and its members
+type GroupHierarchyNode struct {
+	Group       models.Group
+	Level       int64
+	Path        []string
+	SubGroupIDs []int64
+	DeviceIDs   []int64
+}
+
+// groupHierarchyWalker walks a group hierarchy depth first
+type groupHierarchyWalker struct {
+	client   *clients.Client
+	maxDepth int64
+	visited  map[int64]bool
+	nodes    []GroupHierarchyNode
+}
+
+// GetGroupHierarchy walks the group tree from the root group and returns its groups depth first.
+// The sub groups below maxDepth are not read, a negative maxDepth reads the whole tree.
+func GetGroupHierarchy(client *clients.Client, rootGroupName string, maxDepth int64) ([]GroupHierarchyNode, error) {
+	root, err := client.GetExpandedGroupByName(rootGroupName, "")
+	if err != nil {
+		return nil, err
+	}
+	walker := groupHierarchyWalker{
+		client:   client,
+		maxDepth: maxDepth,
+		visited:  map[int64]bool{},
+	}
+	if err := walker.walk(root, 0, nil, nil); err != nil {
+		return nil, err
+	}
+	return walker.nodes, nil
+}
+
+func (w *groupHierarchyWalker) walk(group models.Group, level int64, path []string, pathIDs []int64) error {
+	for i, id := range pathIDs {
+		if id == group.ID {
+			cycle := append(append([]string{}, path[i:]...), group.Name)
+			return fmt.Errorf("cycle detected in the group hierarchy: %s", strings.Join(cycle, " > "))
+		}
+	}
+	if w.visited[group.ID] {
+		return nil
+	}
+	w.visited[group.ID] = true
+
+	devices, err := w.client.GetDevicesByGroupID(group.ID)
+	if err != nil {
+		return fmt.Errorf("unable to get the devices of group %s: %s", group.Name, err.Error())
+	}
+	path = append(append([]string{}, path...), group.Name)
+	pathIDs = append(append([]int64{}, pathIDs...), group.ID)
+	node := GroupHierarchyNode{
+		Group:       group,
+		Level:       level,
+		Path:        path,
+		SubGroupIDs: []int64{},
+		DeviceIDs:   []int64{},
+	}
+	for _, device := range devices.Value {
+		node.DeviceIDs = append(node.DeviceIDs, device.ID)
+	}
+	index := len(w.nodes)
+	w.nodes = append(w.nodes, node)
+
+	if w.maxDepth >= 0 && level >= w.maxDepth {
+		return nil
+	}
+	subGroups, err := w.client.GetSubGroupsByGroupID(group.ID)
+	if err != nil {
+		return fmt.Errorf("unable to get the sub groups of group %s: %s", group.Name, err.Error())
+	}
+	sort.Slice(subGroups, func(i, j int) bool {
+		return subGroups[i].Name < subGroups[j].Name
+	})
+	for _, subGroup := range subGroups {
+		w.nodes[index].SubGroupIDs = append(w.nodes[index].SubGroupIDs, subGroup.ID)
+		if err := w.walk(subGroup, level+1, path, pathIDs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewGroupHierarchyState maps the groups of the hierarchy into the state of the group hierarchy data source
+func NewGroupHierarchyState(nodes []GroupHierarchyNode, state models.OmeGroupHierarchyData) (models.OmeGroupHierarchyData, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// the nodes are depth first, so the sub groups are resolved before their parent when walking backwards
+	recursive := map[int64][]int64{}
+	for i := len(nodes) - 1; i >= 0; i-- {
+		ids := append([]int64{}, nodes[i].DeviceIDs...)
+		for _, subGroupID := range nodes[i].SubGroupIDs {
+			ids = append(ids, recursive[subGroupID]...)
+		}
+		recursive[nodes[i].Group.ID] = ids
+	}
+
+	allDeviceIDs := []int64{}
+	levelGroupIDs := map[int64][]int64{}
+	levelDeviceIDs := map[int64][]int64{}
+	state.Groups = []models.OmeGroupHierarchyNode{}
+	for _, node := range nodes {
+		allDeviceIDs = append(allDeviceIDs, node.DeviceIDs...)
+		levelGroupIDs[node.Level] = append(levelGroupIDs[node.Level], node.Group.ID)
+		levelDeviceIDs[node.Level] = append(levelDeviceIDs[node.Level], node.DeviceIDs...)
+
+		path, d := types.ListValueFrom(context.Background(), types.StringType, node.Path)
+		diags.Append(d...)
+		subGroupIDs, d := types.ListValueFrom(context.Background(), types.Int64Type, node.SubGroupIDs)
+		diags.Append(d...)
+		deviceIDs, d := newInt64Set(node.DeviceIDs)
+		diags.Append(d...)
+		recursiveDeviceIDs, d := newInt64Set(recursive[node.Group.ID])
+		diags.Append(d...)
+		state.Groups = append(state.Groups, models.OmeGroupHierarchyNode{
+			ID:                 types.Int64Value(node.Group.ID),
+			Name:               types.StringValue(node.Group.Name),
+			Description:        types.StringValue(node.Group.Description),
+			ParentID:           types.Int64Value(node.Group.ParentID),
+			MembershipTypeID:   types.Int64Value(node.Group.MembershipTypeID),
+			Level:              types.Int64Value(node.Level),
+			Path:               path,
+			SubGroupIDs:        subGroupIDs,
+			DeviceIDs:          deviceIDs,
+			RecursiveDeviceIDs: recursiveDeviceIDs,
+		})
+	}
+
+	state.Levels = []models.OmeGroupHierarchyLevel{}
+	for level := int64(0); level < int64(len(levelGroupIDs)); level++ {
+		groupIDs, d := types.ListValueFrom(context.Background(), types.Int64Type, levelGroupIDs[level])
+		diags.Append(d...)
+		deviceIDs, d := newInt64Set(levelDeviceIDs[level])
+		diags.Append(d...)
+		state.Levels = append(state.Levels, models.OmeGroupHierarchyLevel{
+			Level:     types.Int64Value(level),
+			GroupIDs:  groupIDs,
+			DeviceIDs: deviceIDs,
+		})
+	}
+
+	var d diag.Diagnostics
+	state.DeviceIDs, d = newInt64Set(allDeviceIDs)
+	diags.Append(d...)
+	return state, diags
+}
+
+// newInt64Set returns a set of the unique ids
+func newInt64Set(ids []int64) (types.Set, diag.Diagnostics) {
+	seen := map[int64]bool{}
+	values := []attr.Value{}
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			values = append(values, types.Int64Value(id))
+		}
+	}
+	return types.SetValue(types.Int64Type, values)
+}
+
+// SortGroupTree returns the names of the groups of the tree, each parent before its sub groups.
+// It fails when a parent is not a group of the tree or when the parents form a cycle.
+func SortGroupTree(groups map[string]models.OmeGroupTreeNode) ([]string, error) {
+	names := make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	ret := []string{}
+	done := map[string]bool{}
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		if done[name] {
+			return nil
+		}
+		for i, p := range path {
+			if p == name {
+				return fmt.Errorf("cycle detected in the parents of the groups: %s", strings.Join(append(path[i:], name), " > "))
+			}
+		}
+		parent := groups[name].Parent
+		if !parent.IsUnknown() && parent.ValueString() != "" {
+			if _, ok := groups[parent.ValueString()]; !ok {
+				return fmt.Errorf("parent %s of group %s is not defined in groups", parent.ValueString(), name)
+			}
+			if err := visit(parent.ValueString(), append(path, name)); err != nil {
+				return err
+			}
+		}
+		done[name] = true
+		ret = append(ret, name)
+		return nil
+	}
+	for _, name := range names {
+		if err := visit(name, nil); err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+// UpdateGroupTree creates, updates and moves the groups of the plan, parents first, then deletes the groups
+// which are not in the plan anymore, sub groups first. It returns the groups of the tree existing on OME.
+func UpdateGroupTree(ctx context.Context, client *clients.Client, plan, state models.OmeGroupTree) (models.OmeGroupTree, error) {
+	ret := models.OmeGroupTree{
+		ID:       types.StringValue("group_tree"),
+		ParentID: plan.ParentID,
+		Groups:   map[string]models.OmeGroupTreeNode{},
+	}
+	for name, node := range state.Groups {
+		ret.Groups[name] = node
+	}
+	planOrder, err := SortGroupTree(plan.Groups)
+	if err != nil {
+		return ret, err
+	}
+
+	for _, name := range planOrder {
+		node := plan.Groups[name]
+		parentID := plan.ParentID.ValueInt64()
+		if parent := node.Parent.ValueString(); parent != "" {
+			parentID = ret.Groups[parent].ID.ValueInt64()
+		}
+		group := models.Group{
+			Name:             name,
+			Description:      node.Description.ValueString(),
+			MembershipTypeID: StaticGroupMembershipTypeID,
+			ParentID:         parentID,
+		}
+
+		current, exists := state.Groups[name]
+		if !exists {
+			id, err := client.CreateGroup(group)
+			if err != nil {
+				return ret, fmt.Errorf("unable to create group %s: %s", name, err.Error())
+			}
+			current = models.OmeGroupTreeNode{
+				ID:          types.Int64Value(id),
+				Parent:      node.Parent,
+				Description: node.Description,
+				DeviceIDs:   types.SetValueMust(types.Int64Type, []attr.Value{}),
+			}
+		} else if !current.Parent.Equal(node.Parent) || !current.Description.Equal(node.Description) {
+			group.ID = current.ID.ValueInt64()
+			if err := client.UpdateGroup(group); err != nil {
+				return ret, fmt.Errorf("unable to update group %s: %s", name, err.Error())
+			}
+			current.Parent = node.Parent
+			current.Description = node.Description
+		}
+		ret.Groups[name] = current
+
+		if node.DeviceIDs.IsUnknown() || node.DeviceIDs.IsNull() {
+			continue
+		}
+		planMembers := models.StaticGroup{ID: current.ID, DeviceIds: node.DeviceIDs}
+		toAdd, toRemove, d := planMembers.GetMemberPayload(ctx, models.StaticGroup{ID: current.ID, DeviceIds: current.DeviceIDs})
+		if d.HasError() {
+			return ret, fmt.Errorf("unable to read the devices of group %s", name)
+		}
+		if len(toAdd.DeviceIds) != 0 {
+			if err := client.AddGroupMembers(toAdd); err != nil {
+				return ret, fmt.Errorf("unable to add devices to group %s: %s", name, err.Error())
+			}
+		}
+		if len(toRemove.DeviceIds) != 0 {
+			if err := client.RemoveGroupMembers(toRemove); err != nil {
+				return ret, fmt.Errorf("unable to remove devices from group %s: %s", name, err.Error())
+			}
+		}
+		current.DeviceIDs = node.DeviceIDs
+		ret.Groups[name] = current
+	}
+
+	removed := models.OmeGroupTree{Groups: map[string]models.OmeGroupTreeNode{}}
+	for name, node := range state.Groups {
+		if _, ok := plan.Groups[name]; !ok {
+			removed.Groups[name] = node
+		}
+	}
+	err = DeleteGroupTree(client, removed)
+	for name := range removed.Groups {
+		delete(ret.Groups, name)
+	}
+	return ret, err
+}
+
+// DeleteGroupTree deletes the groups of the tree, sub groups first
+func DeleteGroupTree(client *clients.Client, state models.OmeGroupTree) error {
+	order, err := SortGroupTree(state.Groups)
+	if err != nil {
+		return err
+	}
+	for i := len(order) - 1; i >= 0; i-- {
+		if err := client.DeleteGroup(state.Groups[order[i]].ID.ValueInt64()); err != nil {
+			return fmt.Errorf("unable to delete group %s: %s", order[i], err.Error())
+		}
+	}
+	return nil
+}
+
+// ReadGroupTree reads the groups of the tree from OME
+func ReadGroupTree(client *clients.Client, state models.OmeGroupTree) (models.OmeGroupTree, error) {
+	ret := models.OmeGroupTree{
+		ID:       types.StringValue("group_tree"),
+		ParentID: state.ParentID,
+		Groups:   map[string]models.OmeGroupTreeNode{},
+	}
+	names := map[int64]string{}
+	for name, node := range state.Groups {
+		names[node.ID.ValueInt64()] = name
+	}
+	for name, node := range state.Groups {
+		group, err := client.GetGroupByID(node.ID.ValueInt64())
+		if err != nil {
+			return ret, fmt.Errorf("unable to get group %s: %s", name, err.Error())
+		}
+		devices, err := client.GetDevicesByGroupID(group.ID)
+		if err != nil {
+			return ret, fmt.Errorf("unable to get the devices of group %s: %s", name, err.Error())
+		}
+		deviceIDs := []int64{}
+		for _, device := range devices.Value {
+			deviceIDs = append(deviceIDs, device.ID)
+		}
+		deviceIDsSet, d := newInt64Set(deviceIDs)
+		if d.HasError() {
+			return ret, fmt.Errorf("unable to read the devices of group %s", name)
+		}
+
+		// a parent outside of the tree cannot be represented, the parent of the state is kept
+		parent := node.Parent
+		if group.ParentID == state.ParentID.ValueInt64() {
+			parent = types.StringValue("")
+		} else if parentName, ok := names[group.ParentID]; ok {
+			parent = types.StringValue(parentName)
+		}
+		ret.Groups[name] = models.OmeGroupTreeNode{
+			ID:          types.Int64Value(group.ID),
+			Parent:      parent,
+			Description: types.StringValue(group.Description),
+			DeviceIDs:   deviceIDsSet,
+		}
+	}
+	return ret, nil
+}
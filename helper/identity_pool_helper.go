@@ -0,0 +1,393 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"net"
+	"strings"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+	"terraform-provider-ome/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// IdentityTypeEthernet is the identity type of the ethernet mac addresses
+	IdentityTypeEthernet = "ethernet"
+	// IdentityTypeIscsi is the identity type of the iscsi mac addresses
+	IdentityTypeIscsi = "iscsi"
+	// IdentityTypeFcoe is the identity type of the fcoe mac addresses
+	IdentityTypeFcoe = "fcoe"
+	// IdentityTypeFc is the identity type of the fibre channel world wide names
+	IdentityTypeFc = "fc"
+	// maxMacAddress is the last 48 bits address
+	maxMacAddress = 1<<48 - 1
+)
+
+// wwnnPrefix and wwpnPrefix are prepended to the starting address of the fibre channel settings
+var (
+	wwnnPrefix = []byte{0x20, 0x00}
+	wwpnPrefix = []byte{0x20, 0x01}
+)
+
+// identityPoolUsageType is the object type of the usage of an identity type
+var identityPoolUsageType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"identity_type": types.StringType,
+	"total":         types.Int64Type,
+	"assigned":      types.Int64Type,
+	"reserved":      types.Int64Type,
+	"free":          types.Int64Type,
+}}
+
+// identityRange is a range of mac addresses of an identity type
+type identityRange struct {
+	identityType string
+	start        uint64
+	count        uint64
+}
+
+// GetIdentityPool get an identity pool by id
+func GetIdentityPool(client *clients.Client, id int64) (models.IdentityPool, error) {
+	return client.GetIdentityPoolByID(id)
+}
+
+// CreateIdentityPool create an identity pool
+func CreateIdentityPool(client *clients.Client, payload models.IdentityPool) (models.IdentityPool, error) {
+	return client.CreateIdentityPool(payload)
+}
+
+// UpdateIdentityPool update an identity pool
+func UpdateIdentityPool(client *clients.Client, payload models.IdentityPool) (models.IdentityPool, error) {
+	return client.UpdateIdentityPool(payload)
+}
+
+// DeleteIdentityPool delete an identity pool
+func DeleteIdentityPool(client *clients.Client, id int64) error {
+	return client.DeleteIdentityPool(id)
+}
+
+// GetIdentityPoolUsage get the number of assigned and reserved identities of an identity pool by identity type
+func GetIdentityPoolUsage(client *clients.Client, id int64) (map[string][2]int64, error) {
+	usage := map[string][2]int64{}
+	sets, err := client.GetIdentitySets(id)
+	if err != nil {
+		return usage, fmt.Errorf("unable to get the identity sets of the identity pool: %s", err.Error())
+	}
+	for _, set := range sets {
+		identities, err := client.GetIdentitySetUsage(id, set.ID)
+		if err != nil {
+			return usage, fmt.Errorf("unable to get the usage of the identity set %s: %s", set.Name, err.Error())
+		}
+		identityType := IdentitySetType(set.Name)
+		counts := usage[identityType]
+		for _, identity := range identities {
+			switch strings.ToLower(identity.Status) {
+			case "assigned":
+				counts[0]++
+			case "reserved":
+				counts[1]++
+			}
+		}
+		usage[identityType] = counts
+	}
+	return usage, nil
+}
+
+// IdentitySetType returns the identity type of an identity set of the appliance
+func IdentitySetType(name string) string {
+	name = strings.ToLower(name)
+	switch {
+	case strings.Contains(name, "fcoe"):
+		return IdentityTypeFcoe
+	case strings.Contains(name, "iscsi"):
+		return IdentityTypeIscsi
+	case strings.Contains(name, "fc"):
+		return IdentityTypeFc
+	default:
+		return IdentityTypeEthernet
+	}
+}
+
+// ValidateIdentityPool validates the addresses and the ranges of the identity pool
+func ValidateIdentityPool(plan models.OmeIdentityPool) error {
+	ranges := []identityRange{}
+	addRange := func(identityType string, address types.String, count types.Int64) error {
+		if address.IsUnknown() || count.IsUnknown() || address.IsNull() || count.IsNull() {
+			return nil
+		}
+		mac, err := parseMacAddress(address.ValueString())
+		if err != nil {
+			return fmt.Errorf("%s_settings: %s", identityType, err.Error())
+		}
+		start := new(big.Int).SetBytes(mac).Uint64()
+		if start+uint64(count.ValueInt64())-1 > maxMacAddress {
+			return fmt.Errorf("%s_settings: %d identities starting from %s exceed the last address FF:FF:FF:FF:FF:FF",
+				identityType, count.ValueInt64(), address.ValueString())
+		}
+		ranges = append(ranges, identityRange{identityType, start, uint64(count.ValueInt64())})
+		return nil
+	}
+	if plan.EthernetSettings != nil {
+		if err := addRange(IdentityTypeEthernet, plan.EthernetSettings.StartingMacAddress, plan.EthernetSettings.IdentityCount); err != nil {
+			return err
+		}
+	}
+	if plan.IscsiSettings != nil {
+		if err := addRange(IdentityTypeIscsi, plan.IscsiSettings.StartingMacAddress, plan.IscsiSettings.IdentityCount); err != nil {
+			return err
+		}
+		if err := validateInitiatorIPPool(plan.IscsiSettings.InitiatorIPPool); err != nil {
+			return err
+		}
+	}
+	if plan.FcoeSettings != nil {
+		if err := addRange(IdentityTypeFcoe, plan.FcoeSettings.StartingMacAddress, plan.FcoeSettings.IdentityCount); err != nil {
+			return err
+		}
+	}
+	// the mac addresses of the ethernet, iscsi and fcoe identities are allocated from the same address space
+	for i := range ranges {
+		for j := i + 1; j < len(ranges); j++ {
+			a, b := ranges[i], ranges[j]
+			if a.start < b.start+b.count && b.start < a.start+a.count {
+				return fmt.Errorf("the mac address ranges of %s_settings and %s_settings overlap", a.identityType, b.identityType)
+			}
+		}
+	}
+	if plan.FcSettings != nil {
+		if err := addRange(IdentityTypeFc, plan.FcSettings.StartingAddress, plan.FcSettings.IdentityCount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateInitiatorIPPool(pool *models.OmeIdentityPoolInitiatorIPPool) error {
+	if pool == nil {
+		return nil
+	}
+	if !pool.IPRange.IsUnknown() {
+		ipRange := pool.IPRange.ValueString()
+		if _, err := utils.ParseNetwork(ipRange); err != nil || strings.Contains(ipRange, "*") {
+			return fmt.Errorf("iscsi_settings.initiator_ip_pool.ip_range: %s is not a valid IP range or CIDR", ipRange)
+		}
+	}
+	addresses := map[string]types.String{
+		"subnet_mask":          pool.SubnetMask,
+		"gateway":              pool.Gateway,
+		"primary_dns_server":   pool.PrimaryDNSServer,
+		"secondary_dns_server": pool.SecondaryDNSServer,
+	}
+	for name, address := range addresses {
+		if address.IsNull() || address.IsUnknown() {
+			continue
+		}
+		if net.ParseIP(address.ValueString()).To4() == nil {
+			return fmt.Errorf("iscsi_settings.initiator_ip_pool.%s: %s is not a valid IPv4 address", name, address.ValueString())
+		}
+	}
+	return nil
+}
+
+// parseMacAddress parses a 48 bits mac address
+func parseMacAddress(address string) (net.HardwareAddr, error) {
+	mac, err := net.ParseMAC(address)
+	if err != nil {
+		return nil, err
+	}
+	if len(mac) != 6 {
+		return nil, fmt.Errorf("%s is not a 48 bits mac address", address)
+	}
+	return mac, nil
+}
+
+// encodeAddress encodes an address with an optional prefix into the base64 format of the appliance
+func encodeAddress(prefix []byte, address string) string {
+	mac, _ := parseMacAddress(address)
+	return base64.StdEncoding.EncodeToString(append(append([]byte{}, prefix...), mac...))
+}
+
+// decodeAddress decodes an address of the appliance and strips its prefix
+func decodeAddress(encoded string, prefixLen int) string {
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(data) < prefixLen {
+		return encoded
+	}
+	return strings.ToUpper(net.HardwareAddr(data[prefixLen:]).String())
+}
+
+// MakeIdentityPoolPayload builds the payload of the appliance from the plan
+func MakeIdentityPoolPayload(plan models.OmeIdentityPool, id int64) models.IdentityPool {
+	payload := models.IdentityPool{
+		ID:          id,
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+	}
+	if plan.EthernetSettings != nil {
+		payload.EthernetSettings = &models.IdentityPoolMacSettings{
+			Mac: makeMacRange(plan.EthernetSettings.StartingMacAddress, plan.EthernetSettings.IdentityCount),
+		}
+	}
+	if plan.IscsiSettings != nil {
+		payload.IscsiSettings = &models.IdentityPoolIscsiSettings{
+			Mac: makeMacRange(plan.IscsiSettings.StartingMacAddress, plan.IscsiSettings.IdentityCount),
+		}
+		if !plan.IscsiSettings.IqnPrefix.IsNull() {
+			payload.IscsiSettings.InitiatorConfig = &models.IdentityPoolInitiatorConfig{
+				IqnPrefix: plan.IscsiSettings.IqnPrefix.ValueString(),
+			}
+		}
+		if pool := plan.IscsiSettings.InitiatorIPPool; pool != nil {
+			payload.IscsiSettings.InitiatorIPPoolSettings = &models.IdentityPoolInitiatorIPPool{
+				IPRange:            pool.IPRange.ValueString(),
+				SubnetMask:         pool.SubnetMask.ValueString(),
+				Gateway:            pool.Gateway.ValueString(),
+				PrimaryDNSServer:   pool.PrimaryDNSServer.ValueString(),
+				SecondaryDNSServer: pool.SecondaryDNSServer.ValueString(),
+			}
+		}
+	}
+	if plan.FcoeSettings != nil {
+		payload.FcoeSettings = &models.IdentityPoolMacSettings{
+			Mac: makeMacRange(plan.FcoeSettings.StartingMacAddress, plan.FcoeSettings.IdentityCount),
+		}
+	}
+	if plan.FcSettings != nil {
+		address, count := plan.FcSettings.StartingAddress.ValueString(), plan.FcSettings.IdentityCount.ValueInt64()
+		payload.FcSettings = &models.IdentityPoolFcSettings{
+			Wwnn: &models.IdentityPoolFcRange{IdentityCount: count, StartingAddress: encodeAddress(wwnnPrefix, address)},
+			Wwpn: &models.IdentityPoolFcRange{IdentityCount: count, StartingAddress: encodeAddress(wwpnPrefix, address)},
+		}
+	}
+	return payload
+}
+
+func makeMacRange(address types.String, count types.Int64) *models.IdentityPoolMacRange {
+	return &models.IdentityPoolMacRange{
+		IdentityCount:      count.ValueInt64(),
+		StartingMacAddress: encodeAddress(nil, address.ValueString()),
+	}
+}
+
+// SetStateIdentityPool maps the identity pool of the appliance and its usage into the terraform state
+// The addresses are kept in the format of the prior state when they are equal to the addresses of the appliance
+func SetStateIdentityPool(pool models.IdentityPool, usage map[string][2]int64, prior models.OmeIdentityPool) (models.OmeIdentityPool, diag.Diagnostics) {
+	state := models.OmeIdentityPool{
+		ID:          types.Int64Value(pool.ID),
+		Name:        types.StringValue(pool.Name),
+		Description: types.StringValue(pool.Description),
+	}
+	usages := []models.OmeIdentityPoolUsage{}
+	addUsage := func(identityType string, total int64) {
+		counts := usage[identityType]
+		usages = append(usages, models.OmeIdentityPoolUsage{
+			IdentityType: types.StringValue(identityType),
+			Total:        types.Int64Value(total),
+			Assigned:     types.Int64Value(counts[0]),
+			Reserved:     types.Int64Value(counts[1]),
+			Free:         types.Int64Value(max(total-counts[0]-counts[1], 0)),
+		})
+	}
+	if settings := pool.EthernetSettings; settings != nil && settings.Mac != nil && settings.Mac.IdentityCount > 0 {
+		var priorAddress types.String
+		if prior.EthernetSettings != nil {
+			priorAddress = prior.EthernetSettings.StartingMacAddress
+		}
+		state.EthernetSettings = &models.OmeIdentityPoolMacSettings{
+			StartingMacAddress: stateAddress(priorAddress, decodeAddress(settings.Mac.StartingMacAddress, 0)),
+			IdentityCount:      types.Int64Value(settings.Mac.IdentityCount),
+		}
+		addUsage(IdentityTypeEthernet, settings.Mac.IdentityCount)
+	}
+	if settings := pool.IscsiSettings; settings != nil && settings.Mac != nil && settings.Mac.IdentityCount > 0 {
+		state.IscsiSettings = newIscsiSettingsState(*settings, prior.IscsiSettings)
+		addUsage(IdentityTypeIscsi, settings.Mac.IdentityCount)
+	}
+	if settings := pool.FcoeSettings; settings != nil && settings.Mac != nil && settings.Mac.IdentityCount > 0 {
+		var priorAddress types.String
+		if prior.FcoeSettings != nil {
+			priorAddress = prior.FcoeSettings.StartingMacAddress
+		}
+		state.FcoeSettings = &models.OmeIdentityPoolMacSettings{
+			StartingMacAddress: stateAddress(priorAddress, decodeAddress(settings.Mac.StartingMacAddress, 0)),
+			IdentityCount:      types.Int64Value(settings.Mac.IdentityCount),
+		}
+		addUsage(IdentityTypeFcoe, settings.Mac.IdentityCount)
+	}
+	if settings := pool.FcSettings; settings != nil && settings.Wwnn != nil && settings.Wwnn.IdentityCount > 0 {
+		var priorAddress types.String
+		if prior.FcSettings != nil {
+			priorAddress = prior.FcSettings.StartingAddress
+		}
+		state.FcSettings = &models.OmeIdentityPoolFcSettings{
+			StartingAddress: stateAddress(priorAddress, decodeAddress(settings.Wwnn.StartingAddress, len(wwnnPrefix))),
+			IdentityCount:   types.Int64Value(settings.Wwnn.IdentityCount),
+		}
+		addUsage(IdentityTypeFc, settings.Wwnn.IdentityCount)
+	}
+	var d diag.Diagnostics
+	state.Usage, d = types.ListValueFrom(context.Background(), identityPoolUsageType, usages)
+	return state, d
+}
+
+func newIscsiSettingsState(settings models.IdentityPoolIscsiSettings, prior *models.OmeIdentityPoolIscsiSettings) *models.OmeIdentityPoolIscsiSettings {
+	var priorAddress types.String
+	if prior != nil {
+		priorAddress = prior.StartingMacAddress
+	}
+	state := &models.OmeIdentityPoolIscsiSettings{
+		StartingMacAddress: stateAddress(priorAddress, decodeAddress(settings.Mac.StartingMacAddress, 0)),
+		IdentityCount:      types.Int64Value(settings.Mac.IdentityCount),
+		IqnPrefix:          types.StringNull(),
+	}
+	if settings.InitiatorConfig != nil && settings.InitiatorConfig.IqnPrefix != "" {
+		state.IqnPrefix = types.StringValue(settings.InitiatorConfig.IqnPrefix)
+	}
+	if pool := settings.InitiatorIPPoolSettings; pool != nil && pool.IPRange != "" {
+		state.InitiatorIPPool = &models.OmeIdentityPoolInitiatorIPPool{
+			IPRange:            types.StringValue(pool.IPRange),
+			SubnetMask:         types.StringValue(pool.SubnetMask),
+			Gateway:            optionalString(pool.Gateway),
+			PrimaryDNSServer:   optionalString(pool.PrimaryDNSServer),
+			SecondaryDNSServer: optionalString(pool.SecondaryDNSServer),
+		}
+	}
+	return state
+}
+
+// stateAddress keeps the prior address when it is the same address as the one of the appliance
+func stateAddress(prior types.String, address string) types.String {
+	if !prior.IsNull() && !prior.IsUnknown() {
+		if mac, err := parseMacAddress(prior.ValueString()); err == nil && strings.EqualFold(mac.String(), address) {
+			return prior
+		}
+	}
+	return types.StringValue(address)
+}
+
+func optionalString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
@@ -0,0 +1,135 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"fmt"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// OIDCRegistrationClientCredentials registers OME with a client id and secret
+	OIDCRegistrationClientCredentials = "client_credentials"
+	// OIDCRegistrationInitialAccessToken registers OME with an initial access token
+	OIDCRegistrationInitialAccessToken = "initial_access_token"
+)
+
+// oidcAuthTypes maps the registration methods to the appliance authentication types
+var oidcAuthTypes = map[string]string{
+	OIDCRegistrationClientCredentials:  "CLIENT_ID",
+	OIDCRegistrationInitialAccessToken: "INITIAL_ACCESS_TOKEN",
+}
+
+// GetOIDCProvider get an OpenID Connect provider by id
+func GetOIDCProvider(client *clients.Client, id int64) (models.OIDCProvider, error) {
+	return client.GetOIDCProviderByID(id)
+}
+
+// CreateOIDCProvider create an OpenID Connect provider
+func CreateOIDCProvider(client *clients.Client, payload models.OIDCProvider) (models.OIDCProvider, error) {
+	return client.CreateOIDCProvider(payload)
+}
+
+// UpdateOIDCProvider update an OpenID Connect provider
+func UpdateOIDCProvider(client *clients.Client, payload models.OIDCProvider) (models.OIDCProvider, error) {
+	return client.UpdateOIDCProvider(payload)
+}
+
+// DeleteOIDCProvider delete an OpenID Connect provider
+func DeleteOIDCProvider(client *clients.Client, id int64) error {
+	return client.DeleteOIDCProvider(id)
+}
+
+// ValidateOIDCProvider validates the credentials required by the registration method
+func ValidateOIDCProvider(plan models.OmeOIDCProvider) error {
+	switch plan.RegistrationMethod.ValueString() {
+	case OIDCRegistrationClientCredentials:
+		if plan.ClientID.IsNull() || plan.ClientSecret.IsNull() {
+			return fmt.Errorf("client_id and client_secret are required when registration_method is %s", OIDCRegistrationClientCredentials)
+		}
+	case OIDCRegistrationInitialAccessToken:
+		if plan.InitialAccessToken.IsNull() {
+			return fmt.Errorf("initial_access_token is required when registration_method is %s", OIDCRegistrationInitialAccessToken)
+		}
+	}
+	if !plan.VerifyCertificate.IsUnknown() && !plan.VerifyCertificate.ValueBool() && !plan.Certificate.IsNull() {
+		return fmt.Errorf("certificate cannot be set when verify_certificate is false")
+	}
+	return nil
+}
+
+// MakeOIDCProviderPayload builds the payload of the appliance from the plan
+func MakeOIDCProviderPayload(plan models.OmeOIDCProvider, id int64) models.OIDCProvider {
+	payload := models.OIDCProvider{
+		ID:                id,
+		Name:              plan.Name.ValueString(),
+		DiscoveryURI:      plan.DiscoveryURI.ValueString(),
+		AuthType:          oidcAuthTypes[plan.RegistrationMethod.ValueString()],
+		VerifyCertificate: plan.VerifyCertificate.ValueBool(),
+		CertificateData:   plan.Certificate.ValueString(),
+		Enabled:           plan.Enabled.ValueBool(),
+	}
+	if plan.RegistrationMethod.ValueString() == OIDCRegistrationClientCredentials {
+		payload.ClientID = plan.ClientID.ValueString()
+		payload.ClientSecret = plan.ClientSecret.ValueString()
+	} else {
+		payload.InitialAccessToken = plan.InitialAccessToken.ValueString()
+	}
+	return payload
+}
+
+// SetStateOIDCProvider maps the OpenID Connect provider of the appliance into the terraform state
+// The secrets and the certificate are never returned by the appliance, they are kept from the prior state
+func SetStateOIDCProvider(provider models.OIDCProvider, prior models.OmeOIDCProvider) models.OmeOIDCProvider {
+	state := models.OmeOIDCProvider{
+		ID:                 types.Int64Value(provider.ID),
+		Name:               types.StringValue(provider.Name),
+		DiscoveryURI:       types.StringValue(provider.DiscoveryURI),
+		RegistrationMethod: prior.RegistrationMethod,
+		ClientID:           prior.ClientID,
+		ClientSecret:       prior.ClientSecret,
+		InitialAccessToken: prior.InitialAccessToken,
+		VerifyCertificate:  types.BoolValue(provider.VerifyCertificate),
+		Certificate:        prior.Certificate,
+		Enabled:            types.BoolValue(provider.Enabled),
+	}
+	for method, authType := range oidcAuthTypes {
+		if authType == provider.AuthType {
+			state.RegistrationMethod = types.StringValue(method)
+		}
+	}
+	// the client id is generated by the identity provider when registering with an initial access token
+	if provider.ClientID != "" {
+		state.ClientID = types.StringValue(provider.ClientID)
+	} else if state.ClientID.IsUnknown() {
+		state.ClientID = types.StringNull()
+	}
+	return state
+}
+
+// ImportStateOIDCProvider returns the prior state of an imported OpenID Connect provider
+func ImportStateOIDCProvider() models.OmeOIDCProvider {
+	return models.OmeOIDCProvider{
+		RegistrationMethod: types.StringValue(OIDCRegistrationClientCredentials),
+		ClientID:           types.StringNull(),
+		ClientSecret:       types.StringNull(),
+		InitialAccessToken: types.StringNull(),
+		Certificate:        types.StringNull(),
+	}
+}
@@ -0,0 +1,194 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"fmt"
+	"strings"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// QueryGroupMembershipTypeID is the membership type of the query groups
+	QueryGroupMembershipTypeID int64 = 24
+	// deviceQueryContextID is the query context of the devices
+	deviceQueryContextID int64 = 2
+)
+
+// queryLogicalOperators maps the logical operators of the criteria to the appliance values
+var queryLogicalOperators = map[string]int64{
+	"and": 1,
+	"or":  2,
+}
+
+// queryFieldResolver resolves the fields and operators of the conditions with the query metadata of the appliance
+type queryFieldResolver struct {
+	fields    map[string][]int64
+	operators map[string]int64
+}
+
+// CreateQueryGroup create a query group and returns its id
+func CreateQueryGroup(client *clients.Client, group models.Group, query models.QueryGroupExtension) (int64, error) {
+	return client.CreateQueryGroup(group, query)
+}
+
+// GetQueryGroup get a query group and its member devices
+func GetQueryGroup(client *clients.Client, id int64) (models.Group, models.Devices, error) {
+	group, err := client.GetGroupByID(id)
+	if err != nil {
+		return group, models.Devices{}, err
+	}
+	devices, err := client.GetDevicesByGroupID(id)
+	return group, devices, err
+}
+
+// ValidateQueryGroup validates that the criteria of the query group has conditions
+func ValidateQueryGroup(plan models.OmeQueryGroup) error {
+	if plan.Criteria == nil {
+		return nil
+	}
+	if len(plan.Criteria.Conditions) == 0 && len(plan.Criteria.Groups) == 0 {
+		return fmt.Errorf("at least one of criteria.conditions or criteria.groups is required")
+	}
+	for i, group := range plan.Criteria.Groups {
+		if len(group.Conditions) == 0 {
+			return fmt.Errorf("criteria.groups[%d] requires at least one condition", i)
+		}
+	}
+	return nil
+}
+
+// MakeQueryGroupPayload builds the group and its query from the plan
+func MakeQueryGroupPayload(client *clients.Client, plan models.OmeQueryGroup) (models.Group, models.QueryGroupExtension, error) {
+	group := models.Group{
+		Name:             plan.Name.ValueString(),
+		Description:      plan.Description.ValueString(),
+		MembershipTypeID: QueryGroupMembershipTypeID,
+		ParentID:         plan.ParentID.ValueInt64(),
+	}
+	query := models.QueryGroupExtension{
+		ContextID: deviceQueryContextID,
+	}
+	resolver, err := newQueryFieldResolver(client)
+	if err != nil {
+		return group, query, err
+	}
+	query.Conditions, err = resolver.conditions(*plan.Criteria)
+	return group, query, err
+}
+
+func newQueryFieldResolver(client *clients.Client) (queryFieldResolver, error) {
+	resolver := queryFieldResolver{
+		fields:    map[string][]int64{},
+		operators: map[string]int64{},
+	}
+	queryContext, err := client.GetQueryContext(deviceQueryContextID)
+	if err != nil {
+		return resolver, fmt.Errorf("unable to get the query fields: %s", err.Error())
+	}
+	operators, err := client.GetQueryOperators()
+	if err != nil {
+		return resolver, fmt.Errorf("unable to get the query operators: %s", err.Error())
+	}
+	for _, table := range queryContext.Tables {
+		for _, field := range table.Fields {
+			// a field can be referenced by name alone or qualified by its table
+			name := strings.ToLower(field.Name)
+			resolver.fields[name] = append(resolver.fields[name], field.ID)
+			qualified := strings.ToLower(table.Name + "." + field.Name)
+			resolver.fields[qualified] = append(resolver.fields[qualified], field.ID)
+		}
+	}
+	for _, operator := range operators {
+		resolver.operators[strings.ToLower(operator.Name)] = operator.ID
+	}
+	return resolver, nil
+}
+
+// conditions flattens the criteria into the conditions of the appliance, the nested groups are put in parentheses
+func (r queryFieldResolver) conditions(criteria models.OmeQueryGroupCriteria) ([]models.QueryCondition, error) {
+	ret := []models.QueryCondition{}
+	logicalOperator := queryLogicalOperators[criteria.Operator.ValueString()]
+	for _, condition := range criteria.Conditions {
+		c, err := r.condition(condition, logicalOperator)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, c)
+	}
+	for _, group := range criteria.Groups {
+		groupOperator := queryLogicalOperators[group.Operator.ValueString()]
+		for i, condition := range group.Conditions {
+			// the first condition of the group is joined with the criteria operator
+			operator := groupOperator
+			if i == 0 {
+				operator = logicalOperator
+			}
+			c, err := r.condition(condition, operator)
+			if err != nil {
+				return nil, err
+			}
+			c.LeftParen = i == 0
+			c.RightParen = i == len(group.Conditions)-1
+			ret = append(ret, c)
+		}
+	}
+	return ret, nil
+}
+
+func (r queryFieldResolver) condition(condition models.OmeQueryCondition, logicalOperator int64) (models.QueryCondition, error) {
+	field := condition.Field.ValueString()
+	fieldIDs, ok := r.fields[strings.ToLower(field)]
+	if !ok {
+		return models.QueryCondition{}, fmt.Errorf("invalid query field %s", field)
+	}
+	if len(fieldIDs) > 1 {
+		return models.QueryCondition{}, fmt.Errorf("query field %s exists in several tables, use the format <table>.<field>", field)
+	}
+	operatorID, ok := r.operators[strings.ToLower(condition.Operator.ValueString())]
+	if !ok {
+		return models.QueryCondition{}, fmt.Errorf("invalid query operator %s", condition.Operator.ValueString())
+	}
+	return models.QueryCondition{
+		LogicalOperatorID: logicalOperator,
+		FieldID:           fieldIDs[0],
+		OperatorID:        operatorID,
+		Value:             condition.Value.ValueString(),
+	}, nil
+}
+
+// NewQueryGroupState maps the query group of the appliance into the terraform state, the criteria is kept from the prior state
+func NewQueryGroupState(group models.Group, devices models.Devices, prior models.OmeQueryGroup) (models.OmeQueryGroup, diag.Diagnostics) {
+	deviceIDs := []attr.Value{}
+	for _, device := range devices.Value {
+		deviceIDs = append(deviceIDs, types.Int64Value(device.ID))
+	}
+	deviceIDsSet, d := types.SetValue(types.Int64Type, deviceIDs)
+	return models.OmeQueryGroup{
+		ID:               types.Int64Value(group.ID),
+		Name:             types.StringValue(group.Name),
+		Description:      types.StringValue(group.Description),
+		ParentID:         types.Int64Value(group.ParentID),
+		MembershipTypeID: types.Int64Value(group.MembershipTypeID),
+		Criteria:         prior.Criteria,
+		DeviceIDs:        deviceIDsSet,
+	}, d
+}
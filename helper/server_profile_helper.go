@@ -0,0 +1,249 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// serverProfileStates maps the states of the server profiles to their names
+var serverProfileStates = map[int64]string{
+	0: "unassigned",
+	1: "assigned",
+	2: "pending",
+	4: "deployed",
+}
+
+// ServerProfileStateName returns the name of the state of a server profile
+func ServerProfileStateName(state int64) string {
+	if name, ok := serverProfileStates[state]; ok {
+		return name
+	}
+	return fmt.Sprintf("unknown(%d)", state)
+}
+
+// CreateServerProfile creates a server profile from a template and returns its id
+func CreateServerProfile(client *clients.Client, profile models.OMECreateProfile) (int64, error) {
+	return client.CreateProfile(profile)
+}
+
+// GetServerProfile returns the server profile by id
+func GetServerProfile(client *clients.Client, id int64) (models.OMEProfile, error) {
+	return client.GetProfileByID(id)
+}
+
+// GetServerProfileByName returns the server profile by name
+func GetServerProfileByName(client *clients.Client, name string) (models.OMEProfile, error) {
+	return client.GetProfileByName(name)
+}
+
+// GetServerProfileAttributes returns the attributes of the server profile
+func GetServerProfileAttributes(client *clients.Client, id int64) ([]models.OmeAttribute, error) {
+	return client.GetProfileAttributes(id)
+}
+
+// GetServerProfileTarget returns the id of the device the server profile is to be assigned to, 0 when it is to be unassigned
+// A slot of a chassis is resolved to the sled in the slot
+func GetServerProfileTarget(client *clients.Client, plan models.OmeServerProfile) (int64, error) {
+	switch {
+	case !plan.DeviceID.IsNull():
+		return plan.DeviceID.ValueInt64(), nil
+	case !plan.DeviceServiceTag.IsNull():
+		device, err := client.GetDevice(plan.DeviceServiceTag.ValueString(), 0)
+		if err != nil {
+			return 0, err
+		}
+		return device.ID, nil
+	case !plan.ChassisServiceTag.IsNull():
+		device, err := GetChassisSlotDevice(client, plan.ChassisServiceTag.ValueString(), plan.SlotNumber.ValueInt64())
+		if err != nil {
+			return 0, err
+		}
+		return device.ID, nil
+	}
+	return 0, nil
+}
+
+// getServerProfileOptions returns the options of the deployment of a server profile
+func getServerProfileOptions(plan models.OmeServerProfile) models.OMEOptions {
+	options := models.OMEOptions{
+		ShutdownType:             0,
+		TimeToWaitBeforeShutdown: 300,
+		EndHostPowerState:        1,
+	}
+	if plan.ForcedShutdown.ValueBool() {
+		options.ShutdownType = 1
+	}
+	return options
+}
+
+// trackServerProfileJob waits for the job of a server profile action to complete
+func trackServerProfileJob(client *clients.Client, jobID int64, plan models.OmeServerProfile) error {
+	if jobID == 0 {
+		return nil
+	}
+	if ok, message := client.TrackJob(jobID, plan.JobRetryCount.ValueInt64(), plan.SleepInterval.ValueInt64()); !ok {
+		return fmt.Errorf("%s", message)
+	}
+	return nil
+}
+
+// AssignServerProfile assigns the server profile to the device and waits for the deployment to complete
+func AssignServerProfile(client *clients.Client, id, targetID int64, plan models.OmeServerProfile) error {
+	jobID, err := client.AssignProfile(models.OMEAssignProfile{
+		ID:       id,
+		TargetID: targetID,
+		Options:  getServerProfileOptions(plan),
+		Schedule: models.OMESchedule{RunNow: true},
+	})
+	if err != nil {
+		return err
+	}
+	return trackServerProfileJob(client, jobID, plan)
+}
+
+// MigrateServerProfile migrates the server profile with its virtual identities to the device and waits for the migration to complete
+func MigrateServerProfile(client *clients.Client, id, targetID int64, plan models.OmeServerProfile) error {
+	jobID, err := client.MigrateProfile(models.OMEMigrateProfile{
+		SourceID:     id,
+		TargetID:     targetID,
+		ForceMigrate: plan.ForceMigrate.ValueBool(),
+	})
+	if err != nil {
+		return err
+	}
+	return trackServerProfileJob(client, jobID, plan)
+}
+
+// RedeployServerProfile redeploys the server profile to its device and waits for the deployment to complete
+func RedeployServerProfile(client *clients.Client, id int64, plan models.OmeServerProfile) error {
+	jobID, err := client.RedeployProfiles(models.OMERedeployProfiles{
+		SourceIDList: []int64{id},
+		Options:      getServerProfileOptions(plan),
+		Schedule:     models.OMESchedule{RunNow: true},
+	})
+	if err != nil {
+		return err
+	}
+	return trackServerProfileJob(client, jobID, plan)
+}
+
+// UnassignServerProfile unassigns the server profile from its device and waits for the job to complete
+func UnassignServerProfile(client *clients.Client, id int64, plan models.OmeServerProfile) error {
+	jobID, err := client.UnassignProfiles([]int64{id})
+	if err != nil {
+		return err
+	}
+	return trackServerProfileJob(client, jobID, plan)
+}
+
+// DeleteServerProfile unassigns the server profile when it is assigned, then deletes it
+func DeleteServerProfile(client *clients.Client, profile models.OMEProfile, state models.OmeServerProfile) error {
+	if profile.TargetID != 0 {
+		if err := UnassignServerProfile(client, profile.ID, state); err != nil {
+			return fmt.Errorf("unable to unassign the server profile: %s", err.Error())
+		}
+	}
+	return client.DeleteProfiles([]int64{profile.ID})
+}
+
+// UpdateServerProfile updates the name and the description of the server profile, and the values of the attribute overrides
+// It returns whether the values of attributes have changed
+func UpdateServerProfile(ctx context.Context, client *clients.Client, profile models.OMEProfile, plan models.OmeServerProfile) (bool, error) {
+	payload := models.OMEUpdateProfile{
+		ID:          profile.ID,
+		ProfileName: plan.Name.ValueString(),
+		TemplateID:  profile.TemplateID,
+		Description: plan.Description.ValueString(),
+	}
+	overrides := map[string]string{}
+	if !plan.AttributeOverrides.IsNull() {
+		values := map[string]types.String{}
+		plan.AttributeOverrides.ElementsAs(ctx, &values, false)
+		for key, value := range values {
+			overrides[key] = value.ValueString()
+		}
+	}
+	if len(overrides) > 0 {
+		attributes, err := client.GetProfileAttributes(profile.ID)
+		if err != nil {
+			return false, err
+		}
+		overridden, err := OverrideTemplateAttributes(attributes, overrides, nil)
+		if err != nil {
+			return false, err
+		}
+		for _, attribute := range GetTemplateAttributeUpdates(attributes, overridden) {
+			payload.Attributes = append(payload.Attributes, models.OMEAttribute{
+				ID:        attribute.ID,
+				Value:     attribute.Value,
+				IsIgnored: attribute.IsIgnored,
+			})
+		}
+	}
+	if payload.ProfileName == profile.ProfileName && payload.Description == profile.ProfileDescription && len(payload.Attributes) == 0 {
+		return false, nil
+	}
+	return len(payload.Attributes) > 0, client.UpdateProfile(payload)
+}
+
+// SetStateServerProfile maps the server profile into the terraform state
+func SetStateServerProfile(ctx context.Context, profile models.OMEProfile, attributes []models.OmeAttribute, prior models.OmeServerProfile) (models.OmeServerProfile, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	state := prior
+	state.ID = types.Int64Value(profile.ID)
+	state.Name = types.StringValue(profile.ProfileName)
+	state.Description = types.StringValue(profile.ProfileDescription)
+	state.TemplateID = types.Int64Value(profile.TemplateID)
+	state.TemplateName = types.StringValue(profile.TemplateName)
+	state.ProfileState = types.StringValue(ServerProfileStateName(profile.ProfileState))
+	state.TargetID = types.Int64Null()
+	state.TargetName = types.StringNull()
+	if profile.TargetID != 0 {
+		state.TargetID = types.Int64Value(profile.TargetID)
+		state.TargetName = types.StringValue(profile.TargetName)
+	}
+	// the configured target no longer matches the device of the profile, the target is replaced by the device to show the drift
+	if !prior.TargetID.IsUnknown() && prior.TargetID.ValueInt64() != profile.TargetID {
+		state.DeviceID = types.Int64Null()
+		state.DeviceServiceTag = types.StringNull()
+		state.ChassisServiceTag = types.StringNull()
+		state.SlotNumber = types.Int64Null()
+		if profile.TargetID != 0 {
+			state.DeviceID = types.Int64Value(profile.TargetID)
+		}
+	}
+	if state.AttributeOverrides.IsNull() || state.AttributeOverrides.IsUnknown() {
+		state.AttributeOverrides = types.MapNull(types.StringType)
+		return state, diags
+	}
+	values := map[string]types.String{}
+	diags.Append(state.AttributeOverrides.ElementsAs(ctx, &values, false)...)
+	overrides := map[string]string{}
+	for key, value := range values {
+		overrides[key] = value.ValueString()
+	}
+	var d diag.Diagnostics
+	state.AttributeOverrides, d = types.MapValueFrom(ctx, types.StringType, RefreshTemplateAttributeOverrides(attributes, overrides, nil))
+	diags.Append(d...)
+	return state, diags
+}
@@ -0,0 +1,544 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"fmt"
+	pathpkg "path"
+	"slices"
+	"sort"
+	"strings"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// templateAttributePathSeparator separates the group, sub groups and attribute display names of the path of a template attribute
+const templateAttributePathSeparator = ","
+
+// normalizeTemplateAttributePath trims the spaces around the names of the path and lowers its case
+func normalizeTemplateAttributePath(path string) []string {
+	names := strings.Split(path, templateAttributePathSeparator)
+	for i, name := range names {
+		names[i] = strings.ToLower(strings.TrimSpace(name))
+	}
+	return names
+}
+
+// FindTemplateAttribute returns the index of the attribute of the template matching the path
+// The path is the display names of the groups and of the attribute, like `iDRAC,NIC Information,DNS Domain Name`,
+// the leading groups can be omitted as long as the path matches a single attribute.
+// The DMTF attribute names are resolved to paths beforehand by ResolveDMTFAttributeNames
+func FindTemplateAttribute(attributes []models.OmeAttribute, path string) (int, error) {
+	keyNames := normalizeTemplateAttributePath(path)
+	matches := []int{}
+	for i, attribute := range attributes {
+		names := normalizeTemplateAttributePath(attribute.DisplayName)
+		if len(names) < len(keyNames) {
+			continue
+		}
+		if strings.Join(names[len(names)-len(keyNames):], templateAttributePathSeparator) != strings.Join(keyNames, templateAttributePathSeparator) {
+			continue
+		}
+		if len(names) == len(keyNames) {
+			// a full path is always unique
+			return i, nil
+		}
+		matches = append(matches, i)
+	}
+	switch len(matches) {
+	case 0:
+		return -1, fmt.Errorf("attribute %s does not exist in the template", path)
+	case 1:
+		return matches[0], nil
+	}
+	candidates := []string{}
+	for _, i := range matches {
+		candidates = append(candidates, attributes[i].DisplayName)
+	}
+	return -1, fmt.Errorf("attribute %s matches several attributes of the template, use one of the full paths: %s", path, strings.Join(candidates, "; "))
+}
+
+// dmtfAttributeNameSeparator separates the FQDD of the component from the name of the attribute, and the group from the attribute in the DMTF names
+const dmtfAttributeNameSeparator = "#"
+
+// IsDMTFAttributeName checks whether the key of an attribute override is a DMTF attribute name prefixed with the FQDD of its component,
+// like `iDRAC.Embedded.1#NIC.1#DNSDomainName` or `BIOS.Setup.1-1#BootMode`
+func IsDMTFAttributeName(key string) bool {
+	fqdd, name, found := strings.Cut(key, dmtfAttributeNameSeparator)
+	return found && name != "" && strings.Contains(fqdd, ".") && !strings.ContainsAny(key, " "+templateAttributePathSeparator)
+}
+
+// ResolveDMTFAttributeNames returns the paths of the template attributes keyed by the DMTF names used in the overrides
+// OME does not return the DMTF names with the template attributes, they are read from the template exported as a server configuration profile,
+// whose attributes are in the order of the template attributes. The names are only resolved when every attribute of the profile
+// has the value of the template attribute at the same position, so that an attribute is never resolved to another one.
+func ResolveDMTFAttributeNames(client *clients.Client, templateID int64, attributes []models.OmeAttribute, overrides map[string]string) (map[string]string, error) {
+	names := map[string]string{}
+	keys := []string{}
+	for key := range overrides {
+		if IsDMTFAttributeName(key) {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		return names, nil
+	}
+	sort.Strings(keys)
+
+	content, err := client.ExportTemplate(templateID, "json")
+	if err != nil {
+		return nil, fmt.Errorf("unable to export the template to resolve the DMTF attribute names: %s", err.Error())
+	}
+	profile := models.OMESystemConfiguration{}
+	if err := client.JSONUnMarshal([]byte(content), &profile); err != nil {
+		return nil, fmt.Errorf("unable to read the exported template to resolve the DMTF attribute names: %s", err.Error())
+	}
+	profileAttributes := getSystemConfigurationAttributes(profile.SystemConfiguration.Components)
+	if len(profileAttributes) != len(attributes) {
+		return nil, fmt.Errorf("the DMTF attribute names cannot be resolved, the exported template has %d attributes and the template %d,"+
+			" use the paths of the display names instead", len(profileAttributes), len(attributes))
+	}
+	indexes := map[string]int{}
+	for i, attribute := range profileAttributes {
+		if attribute.Value != attributes[i].Value {
+			return nil, fmt.Errorf("the DMTF attribute names cannot be resolved, the attribute %s of the exported template does not match the attribute %s,"+
+				" use the paths of the display names instead", attribute.Name, attributes[i].DisplayName)
+		}
+		indexes[strings.ToLower(attribute.Name)] = i
+	}
+
+	errs := []string{}
+	for _, key := range keys {
+		i, ok := indexes[strings.ToLower(key)]
+		if !ok {
+			errs = append(errs, fmt.Sprintf("attribute %s does not exist in the template", key))
+			continue
+		}
+		names[key] = attributes[i].DisplayName
+	}
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("%s", strings.Join(errs, "\n"))
+	}
+	return names, nil
+}
+
+// getSystemConfigurationAttributes returns the attributes of the components of a server configuration profile in their order,
+// named after the FQDD of their component and their DMTF name
+func getSystemConfigurationAttributes(components []models.OMESystemConfigurationComponent) []models.OMESystemConfigurationAttribute {
+	ret := []models.OMESystemConfigurationAttribute{}
+	for _, component := range components {
+		for _, attribute := range component.Attributes {
+			ret = append(ret, models.OMESystemConfigurationAttribute{
+				Name:  component.FQDD + dmtfAttributeNameSeparator + attribute.Name,
+				Value: attribute.Value,
+			})
+		}
+		ret = append(ret, getSystemConfigurationAttributes(component.Components)...)
+	}
+	return ret
+}
+
+// findOverriddenAttribute returns the index of the attribute of the template matching the key of an override,
+// which is either a path or a DMTF name resolved by ResolveDMTFAttributeNames
+func findOverriddenAttribute(attributes []models.OmeAttribute, names map[string]string, key string) (int, error) {
+	if path, ok := names[key]; ok {
+		return FindTemplateAttribute(attributes, path)
+	}
+	if IsDMTFAttributeName(key) {
+		return -1, fmt.Errorf("attribute %s does not exist in the template", key)
+	}
+	return FindTemplateAttribute(attributes, key)
+}
+
+// OverrideTemplateAttributes returns the attributes of the template with the values of the overrides
+// The overridden attributes are no longer ignored during the deployment, all the invalid paths are reported in the error.
+// The overrides keyed by DMTF names are resolved with the names returned by ResolveDMTFAttributeNames.
+func OverrideTemplateAttributes(attributes []models.OmeAttribute, overrides map[string]string, names map[string]string) ([]models.OmeAttribute, error) {
+	ret := make([]models.OmeAttribute, len(attributes))
+	copy(ret, attributes)
+	paths := make([]string, 0, len(overrides))
+	for path := range overrides {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	errs := []string{}
+	overridden := map[int]string{}
+	for _, path := range paths {
+		i, err := findOverriddenAttribute(attributes, names, path)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		if other, ok := overridden[i]; ok {
+			errs = append(errs, fmt.Sprintf("attribute overrides %s and %s refer to the same attribute", other, path))
+			continue
+		}
+		overridden[i] = path
+		ret[i].Value = overrides[path]
+		ret[i].IsIgnored = false
+	}
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("%s", strings.Join(errs, "\n"))
+	}
+	return ret, nil
+}
+
+// GetTemplateAttributeUpdates returns the attributes whose value or ignored flag differ between the current and the planned attributes
+func GetTemplateAttributeUpdates(current, planned []models.OmeAttribute) []models.UpdateAttribute {
+	updates := []models.UpdateAttribute{}
+	for i, attribute := range planned {
+		if i < len(current) && attribute.Value == current[i].Value && attribute.IsIgnored == current[i].IsIgnored {
+			continue
+		}
+		updates = append(updates, models.UpdateAttribute{
+			ID:        attribute.AttributeID,
+			Value:     attribute.Value,
+			IsIgnored: attribute.IsIgnored,
+		})
+	}
+	return updates
+}
+
+// RefreshTemplateAttributeOverrides returns the overrides with the current values of their attributes, so that drifts show in the plan
+func RefreshTemplateAttributeOverrides(attributes []models.OmeAttribute, overrides map[string]string, names map[string]string) map[string]string {
+	ret := map[string]string{}
+	for path, value := range overrides {
+		ret[path] = value
+		if i, err := findOverriddenAttribute(attributes, names, path); err == nil {
+			ret[path] = attributes[i].Value
+		}
+	}
+	return ret
+}
+
+const (
+	// ServerTemplateTypeID - type of the templates of servers
+	ServerTemplateTypeID int64 = 2
+	// IOMTemplateTypeID - type of the templates of IO modules
+	IOMTemplateTypeID int64 = 3
+	// ChassisTemplateTypeID - type of the templates of chassis
+	ChassisTemplateTypeID int64 = 4
+)
+
+// templateTypeNames maps the template types to their names in the device_type attribute
+var templateTypeNames = map[int64]string{
+	ServerTemplateTypeID:  "Server",
+	IOMTemplateTypeID:     "IO Module",
+	ChassisTemplateTypeID: "Chassis",
+}
+
+// templateTargetDeviceTypes maps the template types to the device types they are captured from and deployed to
+var templateTargetDeviceTypes = map[int64][]int64{
+	ServerTemplateTypeID:  {1000},
+	IOMTemplateTypeID:     {4000, 8000},
+	ChassisTemplateTypeID: {chassisDeviceType},
+}
+
+// TemplateTypeName returns the name of a template type, the templates of unknown types are server templates
+func TemplateTypeName(typeID int64) string {
+	if name, ok := templateTypeNames[typeID]; ok {
+		return name
+	}
+	return templateTypeNames[ServerTemplateTypeID]
+}
+
+// templateTypeOfDevice returns the template type matching the type of a device
+func templateTypeOfDevice(device models.Device) (int64, bool) {
+	for typeID, deviceTypes := range templateTargetDeviceTypes {
+		if slices.Contains(deviceTypes, device.Type) {
+			return typeID, true
+		}
+	}
+	return 0, false
+}
+
+// ValidateTemplateReferenceDevice checks that a template of the device type can be captured from the reference device
+func ValidateTemplateReferenceDevice(deviceType string, device models.Device) error {
+	typeID, ok := templateTypeOfDevice(device)
+	if !ok {
+		return fmt.Errorf("a template cannot be captured from device %s of type %d", device.DeviceServiceTag, device.Type)
+	}
+	if !strings.EqualFold(TemplateTypeName(typeID), deviceType) {
+		return fmt.Errorf("reference device %s is not a %s, set device_type to %s to capture its template",
+			device.DeviceServiceTag, deviceType, TemplateTypeName(typeID))
+	}
+	return nil
+}
+
+// ValidateTemplateTargets checks that the devices can be the targets of the deployment of the template
+func ValidateTemplateTargets(template models.OMETemplate, devices []models.Device) error {
+	deviceTypes, ok := templateTargetDeviceTypes[template.TypeID]
+	if !ok {
+		deviceTypes = templateTargetDeviceTypes[ServerTemplateTypeID]
+	}
+	invalid := []string{}
+	for _, device := range devices {
+		if !slices.Contains(deviceTypes, device.Type) {
+			invalid = append(invalid, device.DeviceServiceTag)
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("template %s is a %s template and cannot be deployed to devices %s",
+			template.Name, TemplateTypeName(template.TypeID), strings.Join(invalid, ", "))
+	}
+	return nil
+}
+
+// DefaultSanitizeDenyList - patterns of the attributes holding values specific to the reference device of a template,
+// which are ignored when the template is sanitized
+var DefaultSanitizeDenyList = []string{
+	"*IP Address*",
+	"*Host Name*",
+	"*HostName*",
+	"*DNS RAC Name*",
+	"*Asset Tag*",
+	"*Service Tag*",
+	"*Serial Number*",
+	"*MAC Address*",
+	"*WWN*",
+	"*WWPN*",
+	"*Initiator Name*",
+	"*UUID*",
+}
+
+// matchTemplateAttributePattern returns whether the path of an attribute matches a pattern of a deny list
+// The names of the pattern can hold shell wildcards and its leading groups can be omitted, like `iDRAC,IPv4 Information,*` or `*IP Address*`
+func matchTemplateAttributePattern(pattern, displayName string) (bool, error) {
+	keyNames := normalizeTemplateAttributePath(pattern)
+	names := normalizeTemplateAttributePath(displayName)
+	if len(names) < len(keyNames) {
+		return false, nil
+	}
+	names = names[len(names)-len(keyNames):]
+	for i, keyName := range keyNames {
+		matched, err := pathpkg.Match(keyName, names[i])
+		if err != nil {
+			return false, fmt.Errorf("invalid sanitize pattern %s: %s", pattern, err.Error())
+		}
+		if !matched {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// SanitizeTemplateAttributes returns the attributes of the template with the attributes matching the default or the given deny list ignored,
+// and the paths of the attributes which were ignored by the sanitization
+func SanitizeTemplateAttributes(attributes []models.OmeAttribute, denyList []string) ([]models.OmeAttribute, []string, error) {
+	patterns := append(slices.Clone(DefaultSanitizeDenyList), denyList...)
+	for _, pattern := range denyList {
+		for _, name := range normalizeTemplateAttributePath(pattern) {
+			if _, err := pathpkg.Match(name, ""); err != nil {
+				return nil, nil, fmt.Errorf("invalid sanitize pattern %s: %s", pattern, err.Error())
+			}
+		}
+	}
+	ret := make([]models.OmeAttribute, len(attributes))
+	copy(ret, attributes)
+	sanitized := []string{}
+	for i, attribute := range ret {
+		if attribute.IsIgnored {
+			continue
+		}
+		for _, pattern := range patterns {
+			matched, err := matchTemplateAttributePattern(pattern, attribute.DisplayName)
+			if err != nil {
+				return nil, nil, err
+			}
+			if matched {
+				ret[i].IsIgnored = true
+				sanitized = append(sanitized, attribute.DisplayName)
+				break
+			}
+		}
+	}
+	sort.Strings(sanitized)
+	return ret, sanitized, nil
+}
+
+const (
+	// TemplateAttributeAdded - status of an attribute only set in the template
+	TemplateAttributeAdded = "added"
+	// TemplateAttributeRemoved - status of an attribute only set in the reference
+	TemplateAttributeRemoved = "removed"
+	// TemplateAttributeChanged - status of an attribute set in both with different values
+	TemplateAttributeChanged = "changed"
+	// complianceStatusCompliant - compliance status of a device attribute that matches the template
+	complianceStatusCompliant = 1
+)
+
+// GetTemplate returns the template by id or by name
+func GetTemplate(client *clients.Client, id int64, name string) (models.OMETemplate, error) {
+	template, err := client.GetTemplateByIDOrName(id, name)
+	if err != nil {
+		return template, err
+	}
+	if template.ID == 0 {
+		return template, fmt.Errorf("template %s does not exist", name)
+	}
+	return template, nil
+}
+
+// ExportTemplate returns the content of the template in the given format
+func ExportTemplate(client *clients.Client, id int64, format string) (string, error) {
+	return client.ExportTemplate(id, format)
+}
+
+// GetTemplateAttributeValues returns the values of the attributes of the template which are not ignored, keyed by their path
+func GetTemplateAttributeValues(client *clients.Client, id int64) (map[string]string, error) {
+	attributes, err := client.GetTemplateAttributes(id, []models.Attribute{}, true)
+	if err != nil {
+		return nil, err
+	}
+	values := map[string]string{}
+	for _, attribute := range attributes {
+		if !attribute.IsIgnored {
+			values[attribute.DisplayName] = attribute.Value
+		}
+	}
+	return values, nil
+}
+
+// DiffTemplateAttributes returns the differences between the attribute values of a template and of its reference, ordered by path
+func DiffTemplateAttributes(values, reference map[string]string) []models.TemplateAttributeDiff {
+	diffs := []models.TemplateAttributeDiff{}
+	for path, value := range values {
+		value := value
+		referenceValue, ok := reference[path]
+		if !ok {
+			diffs = append(diffs, models.TemplateAttributeDiff{Path: path, Value: &value, Status: TemplateAttributeAdded})
+		} else if referenceValue != value {
+			diffs = append(diffs, models.TemplateAttributeDiff{Path: path, Value: &value, ReferenceValue: &referenceValue, Status: TemplateAttributeChanged})
+		}
+	}
+	for path, referenceValue := range reference {
+		referenceValue := referenceValue
+		if _, ok := values[path]; !ok {
+			diffs = append(diffs, models.TemplateAttributeDiff{Path: path, ReferenceValue: &referenceValue, Status: TemplateAttributeRemoved})
+		}
+	}
+	sortTemplateAttributeDiffs(diffs)
+	return diffs
+}
+
+// GetDeviceAttributeDiffs returns the differences between the attributes of the template of a baseline and the configuration of a device
+// The configuration of the device is the one of the last compliance check of the baseline
+func GetDeviceAttributeDiffs(client *clients.Client, baselineID, deviceID int64) ([]models.TemplateAttributeDiff, error) {
+	detail, err := client.GetBaselineDeviceComplianceDetail(baselineID, deviceID)
+	if err != nil {
+		return nil, err
+	}
+	diffs := []models.TemplateAttributeDiff{}
+	for _, group := range detail.ComplianceAttributeGroups {
+		diffs = append(diffs, getComplianceGroupDiffs(group, "")...)
+	}
+	sortTemplateAttributeDiffs(diffs)
+	return diffs, nil
+}
+
+func getComplianceGroupDiffs(group models.OMEComplianceAttributeGroup, parent string) []models.TemplateAttributeDiff {
+	path := group.DisplayName
+	if parent != "" {
+		path = parent + templateAttributePathSeparator + group.DisplayName
+	}
+	diffs := []models.TemplateAttributeDiff{}
+	for _, attribute := range group.Attributes {
+		if attribute.ComplianceStatus == complianceStatusCompliant {
+			continue
+		}
+		status := TemplateAttributeChanged
+		if attribute.Value == nil {
+			status = TemplateAttributeAdded
+		}
+		diffs = append(diffs, models.TemplateAttributeDiff{
+			Path:           path + templateAttributePathSeparator + attribute.DisplayName,
+			Value:          attribute.ExpectedValue,
+			ReferenceValue: attribute.Value,
+			Status:         status,
+		})
+	}
+	for _, subGroup := range group.ComplianceSubAttributeGroups {
+		diffs = append(diffs, getComplianceGroupDiffs(subGroup, path)...)
+	}
+	return diffs
+}
+
+func sortTemplateAttributeDiffs(diffs []models.TemplateAttributeDiff) {
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffs[i].Path < diffs[j].Path
+	})
+}
+
+// FindComplianceBaseline returns the configuration baseline of the template which targets the device
+// When the name is given, the baseline with this name is returned
+func FindComplianceBaseline(client *clients.Client, templateID, deviceID int64, name string) (models.OmeBaseline, error) {
+	baselines, err := client.GetBaselines()
+	if err != nil {
+		return models.OmeBaseline{}, err
+	}
+	for _, baseline := range baselines {
+		if name != "" && baseline.Name != name {
+			continue
+		}
+		if baseline.TemplateID != templateID {
+			if name != "" {
+				return models.OmeBaseline{}, fmt.Errorf("configuration baseline %s does not use template %d", name, templateID)
+			}
+			continue
+		}
+		for _, target := range baseline.BaselineTargets {
+			if target.ID == deviceID {
+				return baseline, nil
+			}
+		}
+		if name != "" {
+			return models.OmeBaseline{}, fmt.Errorf("configuration baseline %s does not target device %d", name, deviceID)
+		}
+	}
+	if name != "" {
+		return models.OmeBaseline{}, fmt.Errorf("configuration baseline %s does not exist", name)
+	}
+	return models.OmeBaseline{}, fmt.Errorf("no configuration baseline of template %d targets device %d,"+
+		" create one with the ome_configuration_baseline resource to compare the template with the device", templateID, deviceID)
+}
+
+// SetStateTemplateExport maps the exported template and its differences into the data source state
+func SetStateTemplateExport(ctx context.Context, template models.OMETemplate, content string, values map[string]string,
+	diffs []models.TemplateAttributeDiff, state models.OmeTemplateExport) (models.OmeTemplateExport, diag.Diagnostics) {
+	var d diag.Diagnostics
+	state.ID = types.StringValue(fmt.Sprintf("%d", template.ID))
+	state.TemplateID = types.Int64Value(template.ID)
+	state.TemplateName = types.StringValue(template.Name)
+	state.Content = types.StringValue(content)
+	state.Attributes, d = types.MapValueFrom(ctx, types.StringType, values)
+	state.Differences = nil
+	if diffs != nil {
+		state.Differences = make([]models.OmeTemplateAttributeDiff, 0, len(diffs))
+		for _, diff := range diffs {
+			state.Differences = append(state.Differences, models.OmeTemplateAttributeDiff{
+				Path:           types.StringValue(diff.Path),
+				Value:          types.StringPointerValue(diff.Value),
+				ReferenceValue: types.StringPointerValue(diff.ReferenceValue),
+				Status:         types.StringValue(diff.Status),
+			})
+		}
+	}
+	return state, d
+}
@@ -0,0 +1,138 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DefaultVlanNetworkType is the network type of the vlan networks when it is not configured
+const DefaultVlanNetworkType = "General Purpose (Bronze)"
+
+// GetVlanNetwork get a vlan network by id
+func GetVlanNetwork(client *clients.Client, id int64) (models.VLanNetworks, error) {
+	return client.GetVlanNetworkByID(id)
+}
+
+// GetVlanNetworkByName get a vlan network by name
+func GetVlanNetworkByName(client *clients.Client, name string) (models.VLanNetworks, error) {
+	return client.GetVlanNetworkByName(name)
+}
+
+// CreateVlanNetwork create a vlan network
+func CreateVlanNetwork(client *clients.Client, payload models.VLanNetworks) (models.VLanNetworks, error) {
+	return client.CreateVlanNetwork(payload)
+}
+
+// UpdateVlanNetwork update a vlan network
+func UpdateVlanNetwork(client *clients.Client, payload models.VLanNetworks) (models.VLanNetworks, error) {
+	return client.UpdateVlanNetwork(payload)
+}
+
+// DeleteVlanNetwork delete a vlan network
+func DeleteVlanNetwork(client *clients.Client, id int64) error {
+	return client.DeleteVlanNetwork(id)
+}
+
+// GetVlanNetworkTypes get the names of the network types by id
+func GetVlanNetworkTypes(client *clients.Client) (map[int64]string, error) {
+	networkTypes, err := client.GetNetworkTypes()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the network types: %s", err.Error())
+	}
+	ret := map[int64]string{}
+	for _, networkType := range networkTypes {
+		ret[networkType.ID] = networkType.Name
+	}
+	return ret, nil
+}
+
+// ValidateVlanNetwork validates the vlan range of the vlan network
+func ValidateVlanNetwork(plan models.OmeVlanNetwork) error {
+	if plan.VLANMinimum.IsUnknown() || plan.VLANMaximum.IsUnknown() {
+		return nil
+	}
+	if plan.VLANMinimum.ValueInt64() > plan.VLANMaximum.ValueInt64() {
+		return fmt.Errorf("vlan_minimum %d cannot be greater than vlan_maximum %d", plan.VLANMinimum.ValueInt64(), plan.VLANMaximum.ValueInt64())
+	}
+	return nil
+}
+
+// CheckVlanNetworkOverlap checks that the vlan range does not overlap the ranges of the other networks of the appliance
+func CheckVlanNetworkOverlap(client *clients.Client, id, minimum, maximum int64) error {
+	networks, err := client.GetAllVlanNetworks()
+	if err != nil {
+		return fmt.Errorf("unable to get the vlan networks: %s", err.Error())
+	}
+	for _, network := range networks {
+		if network.ID == id {
+			continue
+		}
+		if minimum <= network.VLANMaximum && network.VLANMinimum <= maximum {
+			return fmt.Errorf("vlan range %d-%d overlaps the range %d-%d of the network %s",
+				minimum, maximum, network.VLANMinimum, network.VLANMaximum, network.Name)
+		}
+	}
+	return nil
+}
+
+// MakeVlanNetworkPayload builds the payload of the appliance from the plan
+func MakeVlanNetworkPayload(plan models.OmeVlanNetwork, id int64, networkTypes map[int64]string) (models.VLanNetworks, error) {
+	payload := models.VLanNetworks{
+		ID:          id,
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		VLANMinimum: plan.VLANMinimum.ValueInt64(),
+		VLANMaximum: plan.VLANMaximum.ValueInt64(),
+	}
+	names := []string{}
+	for typeID, name := range networkTypes {
+		if strings.EqualFold(name, plan.NetworkType.ValueString()) {
+			payload.Type = typeID
+			return payload, nil
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return payload, fmt.Errorf("invalid network type %s, supported network types are: %s", plan.NetworkType.ValueString(), strings.Join(names, ", "))
+}
+
+// SetStateVlanNetwork maps the vlan network of the appliance into the terraform state
+// The network type is kept from the prior state when it only differs by case
+func SetStateVlanNetwork(network models.VLanNetworks, networkTypes map[int64]string, prior models.OmeVlanNetwork) models.OmeVlanNetwork {
+	state := models.OmeVlanNetwork{
+		ID:                types.Int64Value(network.ID),
+		Name:              types.StringValue(network.Name),
+		Description:       types.StringValue(network.Description),
+		VLANMinimum:       types.Int64Value(network.VLANMinimum),
+		VLANMaximum:       types.Int64Value(network.VLANMaximum),
+		NetworkType:       types.StringValue(networkTypes[network.Type]),
+		InternalRefNWUUID: types.StringValue(network.InternalRefNWUUID),
+	}
+	if _, ok := networkTypes[network.Type]; !ok {
+		state.NetworkType = types.StringValue(fmt.Sprintf("%d", network.Type))
+	}
+	if strings.EqualFold(prior.NetworkType.ValueString(), state.NetworkType.ValueString()) {
+		state.NetworkType = prior.NetworkType
+	}
+	return state
+}
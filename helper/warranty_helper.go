@@ -0,0 +1,99 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"slices"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// GetWarranties returns the warranty entitlements of the devices selected by ids, service tags or group names,
+// of all the devices when none is set
+func GetWarranties(client *clients.Client, ids []int64, serviceTags []string, groupNames []string) ([]models.Warranty, error) {
+	warranties, err := client.GetWarranties(nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 && len(serviceTags) == 0 && len(groupNames) == 0 {
+		return warranties, nil
+	}
+	// the devices are resolved first so that unknown ids, service tags and group names are reported
+	devices, err := client.GetDevices(serviceTags, ids, groupNames)
+	if err != nil {
+		return nil, err
+	}
+	deviceIDs := make([]int64, 0, len(devices))
+	for _, device := range devices {
+		deviceIDs = append(deviceIDs, device.ID)
+	}
+	ret := []models.Warranty{}
+	for _, warranty := range warranties {
+		if slices.Contains(deviceIDs, warranty.DeviceID) {
+			ret = append(ret, warranty)
+		}
+	}
+	return ret, nil
+}
+
+// FilterWarranties keeps the entitlements expiring within the given number of days, a negative value keeps all of them,
+// the expired entitlements are kept only when requested
+func FilterWarranties(warranties []models.Warranty, expiringWithinDays int64, includeExpired bool) []models.Warranty {
+	ret := []models.Warranty{}
+	for _, warranty := range warranties {
+		if isWarrantyExpired(warranty) {
+			if includeExpired {
+				ret = append(ret, warranty)
+			}
+			continue
+		}
+		if expiringWithinDays < 0 || warranty.DaysRemaining <= expiringWithinDays {
+			ret = append(ret, warranty)
+		}
+	}
+	return ret
+}
+
+func isWarrantyExpired(warranty models.Warranty) bool {
+	return warranty.DaysRemaining <= 0
+}
+
+// NewWarrantyState maps the warranty entitlements of the appliance into the data source state
+func NewWarrantyState(warranties []models.Warranty, plan models.OmeWarrantyData) models.OmeWarrantyData {
+	plan.Warranties = make([]models.OmeWarranty, 0, len(warranties))
+	plan.MinDaysRemaining = types.Int64Null()
+	for _, warranty := range warranties {
+		plan.Warranties = append(plan.Warranties, models.OmeWarranty{
+			ID:                      types.Int64Value(warranty.ID),
+			DeviceID:                types.Int64Value(warranty.DeviceID),
+			ServiceTag:              types.StringValue(warranty.DeviceIdentifier),
+			DeviceModel:             types.StringValue(warranty.DeviceModel),
+			ServiceLevelCode:        types.StringValue(warranty.ServiceLevelCode),
+			ServiceLevelDescription: types.StringValue(warranty.ServiceLevelDescription),
+			ServiceProvider:         types.StringValue(warranty.ServiceProvider),
+			StartDate:               types.StringValue(warranty.StartDate),
+			EndDate:                 types.StringValue(warranty.EndDate),
+			DaysRemaining:           types.Int64Value(warranty.DaysRemaining),
+			Expired:                 types.BoolValue(isWarrantyExpired(warranty)),
+		})
+		if plan.MinDaysRemaining.IsNull() || warranty.DaysRemaining < plan.MinDaysRemaining.ValueInt64() {
+			plan.MinDaysRemaining = types.Int64Value(warranty.DaysRemaining)
+		}
+	}
+	return plan
+}
@@ -0,0 +1,84 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// SNMPDestination - SNMP trap destination as returned by the AlertService
+type SNMPDestination struct {
+	ID                 int64               `json:"Id"`
+	Enabled            bool                `json:"Enabled"`
+	DestinationAddress string              `json:"DestinationAddress"`
+	PortNumber         int64               `json:"PortNumber"`
+	SnmpVersion        string              `json:"SnmpVersion"`
+	SnmpV1V2Credential *SNMPV1V2Credential `json:"SnmpV1V2Credential"`
+	SnmpV3Credential   *SNMPV3Credential   `json:"SnmpV3Credential"`
+}
+
+// SNMPV1V2Credential - community string of a SNMP v1/v2c destination
+type SNMPV1V2Credential struct {
+	Community string `json:"Community"`
+}
+
+// SNMPV3Credential - user based credential of a SNMP v3 destination
+type SNMPV3Credential struct {
+	Username                 string `json:"Username"`
+	AuthenticationProtocol   string `json:"AuthenticationProtocol"`
+	AuthenticationPassphrase string `json:"AuthenticationPassphrase,omitempty"`
+	PrivacyProtocol          string `json:"PrivacyProtocol"`
+	PrivacyPassphrase        string `json:"PrivacyPassphrase,omitempty"`
+}
+
+// SyslogDestination - syslog destination as returned by the AlertService
+type SyslogDestination struct {
+	ID                 int64  `json:"Id"`
+	Enabled            bool   `json:"Enabled"`
+	DestinationAddress string `json:"DestinationAddress"`
+	PortNumber         int64  `json:"PortNumber"`
+}
+
+// tfsdk struct definition
+
+// OmeAlertDestinations - schema for resource alert destinations
+type OmeAlertDestinations struct {
+	ID                 types.String           `tfsdk:"id"`
+	SendTest           types.Bool             `tfsdk:"send_test"`
+	SnmpDestinations   []OmeSNMPDestination   `tfsdk:"snmp_destinations"`
+	SyslogDestinations []OmeSyslogDestination `tfsdk:"syslog_destinations"`
+}
+
+// OmeSNMPDestination - schema for a SNMP trap destination
+type OmeSNMPDestination struct {
+	ID                       types.Int64  `tfsdk:"id"`
+	Enabled                  types.Bool   `tfsdk:"enabled"`
+	DestinationAddress       types.String `tfsdk:"destination_address"`
+	PortNumber               types.Int64  `tfsdk:"port_number"`
+	SnmpVersion              types.String `tfsdk:"snmp_version"`
+	Community                types.String `tfsdk:"community"`
+	Username                 types.String `tfsdk:"username"`
+	AuthenticationProtocol   types.String `tfsdk:"authentication_protocol"`
+	AuthenticationPassphrase types.String `tfsdk:"authentication_passphrase"`
+	PrivacyProtocol          types.String `tfsdk:"privacy_protocol"`
+	PrivacyPassphrase        types.String `tfsdk:"privacy_passphrase"`
+}
+
+// OmeSyslogDestination - schema for a syslog destination
+type OmeSyslogDestination struct {
+	ID                 types.Int64  `tfsdk:"id"`
+	Enabled            types.Bool   `tfsdk:"enabled"`
+	DestinationAddress types.String `tfsdk:"destination_address"`
+	PortNumber         types.Int64  `tfsdk:"port_number"`
+}
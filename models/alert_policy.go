@@ -0,0 +1,155 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// AlertPolicy - alert policy of the AlertService
+type AlertPolicy struct {
+	ID            int64           `json:"Id,omitempty"`
+	Name          string          `json:"Name"`
+	Description   string          `json:"Description"`
+	Enabled       bool            `json:"Enabled"`
+	DefaultPolicy bool            `json:"DefaultPolicy"`
+	Editable      bool            `json:"Editable"`
+	Visible       bool            `json:"Visible"`
+	State         bool            `json:"State"`
+	PolicyData    AlertPolicyData `json:"PolicyData"`
+}
+
+// AlertPolicyData - targets, filters and actions of an alert policy
+type AlertPolicyData struct {
+	Catalogs            []AlertPolicyCatalog `json:"Catalogs"`
+	Severities          []int64              `json:"Severities"`
+	MessageIds          []string             `json:"MessageIds"`
+	Devices             []int64              `json:"Devices"`
+	DeviceTypes         []int64              `json:"DeviceTypes"`
+	Groups              []int64              `json:"Groups"`
+	AllTargets          bool                 `json:"AllTargets"`
+	UndiscoveredTargets []string             `json:"UndiscoveredTargets"`
+	Schedule            AlertPolicySchedule  `json:"Schedule"`
+	Actions             []AlertPolicyAction  `json:"Actions"`
+}
+
+// AlertPolicyCatalog - message catalog filter of an alert policy, empty categories match the whole catalog
+type AlertPolicyCatalog struct {
+	CatalogName   string  `json:"CatalogName"`
+	Categories    []int64 `json:"Categories"`
+	SubCategories []int64 `json:"SubCategories"`
+}
+
+// AlertPolicySchedule - time window in which an alert policy is active
+type AlertPolicySchedule struct {
+	StartTime  string `json:"StartTime"`
+	EndTime    string `json:"EndTime"`
+	CronString string `json:"CronString"`
+	Interval   bool   `json:"Interval"`
+}
+
+// AlertPolicyAction - action of an alert policy created from an action template
+type AlertPolicyAction struct {
+	ID               int64                  `json:"Id,omitempty"`
+	Name             string                 `json:"Name"`
+	TemplateID       int64                  `json:"TemplateId"`
+	ParameterDetails []AlertActionParameter `json:"ParameterDetails"`
+}
+
+// AlertActionParameter - parameter of an alert action
+type AlertActionParameter struct {
+	ID                           int64                      `json:"Id"`
+	Name                         string                     `json:"Name"`
+	Value                        string                     `json:"Value"`
+	Type                         string                     `json:"Type"`
+	TemplateParameterTypeDetails []AlertActionParameterType `json:"TemplateParameterTypeDetails,omitempty"`
+}
+
+// AlertActionParameterType - type detail of an alert action parameter
+type AlertActionParameterType struct {
+	Name  string `json:"Name"`
+	Value string `json:"Value"`
+}
+
+// AlertActionTemplate - action template of the AlertService
+type AlertActionTemplate struct {
+	ID               int64                  `json:"Id"`
+	Name             string                 `json:"Name"`
+	Description      string                 `json:"Description"`
+	Disabled         bool                   `json:"Disabled"`
+	ParameterDetails []AlertActionParameter `json:"ParameterDetails"`
+}
+
+// AlertCategoryCatalog - message catalog with its alert categories
+type AlertCategoryCatalog struct {
+	Name              string          `json:"Name"`
+	CategoriesDetails []AlertCategory `json:"CategoriesDetails"`
+}
+
+// AlertCategory - alert category of a message catalog
+type AlertCategory struct {
+	ID                 int64              `json:"Id"`
+	Name               string             `json:"Name"`
+	CatalogName        string             `json:"CatalogName"`
+	SubCategoryDetails []AlertSubCategory `json:"SubCategoryDetails"`
+}
+
+// AlertSubCategory - sub category of an alert category
+type AlertSubCategory struct {
+	ID          int64  `json:"Id"`
+	Name        string `json:"Name"`
+	Description string `json:"Description"`
+}
+
+// AlertPolicyIDsPayload - payload to enable, disable or remove alert policies
+type AlertPolicyIDsPayload struct {
+	AlertPolicyIds []int64 `json:"AlertPolicyIds"`
+}
+
+// tfsdk struct definition
+
+// OmeAlertPolicy - schema for resource alert policy
+type OmeAlertPolicy struct {
+	ID                types.Int64              `tfsdk:"id"`
+	Name              types.String             `tfsdk:"name"`
+	Description       types.String             `tfsdk:"description"`
+	Enabled           types.Bool               `tfsdk:"enabled"`
+	DeviceIDs         types.Set                `tfsdk:"device_ids"`
+	DeviceServicetags types.Set                `tfsdk:"device_servicetags"`
+	GroupIDs          types.Set                `tfsdk:"group_ids"`
+	Severities        types.Set                `tfsdk:"severities"`
+	Categories        []OmeAlertPolicyCategory `tfsdk:"categories"`
+	Schedule          *OmeAlertPolicySchedule  `tfsdk:"schedule"`
+	Actions           []OmeAlertPolicyAction   `tfsdk:"actions"`
+}
+
+// OmeAlertPolicyCategory - schema for the message catalog filter of an alert policy
+type OmeAlertPolicyCategory struct {
+	CatalogName   types.String `tfsdk:"catalog_name"`
+	Categories    types.Set    `tfsdk:"categories"`
+	SubCategories types.Set    `tfsdk:"sub_categories"`
+}
+
+// OmeAlertPolicySchedule - schema for the schedule of an alert policy
+type OmeAlertPolicySchedule struct {
+	StartTime  types.String `tfsdk:"start_time"`
+	EndTime    types.String `tfsdk:"end_time"`
+	CronString types.String `tfsdk:"cron_string"`
+}
+
+// OmeAlertPolicyAction - schema for an action of an alert policy
+type OmeAlertPolicyAction struct {
+	Name       types.String `tfsdk:"name"`
+	Parameters types.Map    `tfsdk:"parameters"`
+}
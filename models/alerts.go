@@ -0,0 +1,91 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// Alert - alert of the AlertService
+type Alert struct {
+	ID                    int64  `json:"Id"`
+	SeverityType          int64  `json:"SeverityType"`
+	SeverityName          string `json:"SeverityName"`
+	AlertDeviceID         int64  `json:"AlertDeviceId"`
+	AlertDeviceName       string `json:"AlertDeviceName"`
+	AlertDeviceType       int64  `json:"AlertDeviceType"`
+	AlertDeviceIPAddress  string `json:"AlertDeviceIpAddress"`
+	AlertDeviceIdentifier string `json:"AlertDeviceIdentifier"`
+	CatalogName           string `json:"CatalogName"`
+	CategoryID            int64  `json:"CategoryId"`
+	CategoryName          string `json:"CategoryName"`
+	SubCategoryID         int64  `json:"SubCategoryId"`
+	SubCategoryName       string `json:"SubCategoryName"`
+	StatusType            int64  `json:"StatusType"`
+	StatusName            string `json:"StatusName"`
+	TimeStamp             string `json:"TimeStamp"`
+	Message               string `json:"Message"`
+	RecommendedAction     string `json:"RecommendedAction"`
+	AlertMessageID        string `json:"AlertMessageId"`
+}
+
+// AlertIDsPayload - payload of the alert actions
+type AlertIDsPayload struct {
+	AlertIds []int64 `json:"AlertIds"`
+}
+
+// OmeAlertsData - schema for the alerts data source
+type OmeAlertsData struct {
+	ID      types.Int64       `tfsdk:"id"`
+	Filters *OmeAlertsFilters `tfsdk:"filters"`
+	Alerts  []OmeAlert        `tfsdk:"alerts"`
+}
+
+// OmeAlertsFilters - schema for the alerts data source filters
+type OmeAlertsFilters struct {
+	Severities        types.Set    `tfsdk:"severities"`
+	Acknowledged      types.Bool   `tfsdk:"acknowledged"`
+	CategoryNames     types.Set    `tfsdk:"category_names"`
+	DeviceIDs         types.Set    `tfsdk:"device_ids"`
+	DeviceServicetags types.Set    `tfsdk:"device_servicetags"`
+	GroupIDs          types.Set    `tfsdk:"group_ids"`
+	StartTime         types.String `tfsdk:"start_time"`
+	EndTime           types.String `tfsdk:"end_time"`
+}
+
+// OmeAlert - schema for a single alert of the alerts data source
+type OmeAlert struct {
+	ID                types.Int64  `tfsdk:"id"`
+	Severity          types.String `tfsdk:"severity"`
+	DeviceID          types.Int64  `tfsdk:"device_id"`
+	DeviceName        types.String `tfsdk:"device_name"`
+	DeviceIdentifier  types.String `tfsdk:"device_identifier"`
+	DeviceIP          types.String `tfsdk:"device_ip"`
+	CatalogName       types.String `tfsdk:"catalog_name"`
+	CategoryName      types.String `tfsdk:"category_name"`
+	SubCategoryName   types.String `tfsdk:"sub_category_name"`
+	Acknowledged      types.Bool   `tfsdk:"acknowledged"`
+	Status            types.String `tfsdk:"status"`
+	TimeStamp         types.String `tfsdk:"time_stamp"`
+	Message           types.String `tfsdk:"message"`
+	MessageID         types.String `tfsdk:"message_id"`
+	RecommendedAction types.String `tfsdk:"recommended_action"`
+}
+
+// OmeAlertAction - schema for the alert action resource
+type OmeAlertAction struct {
+	ID       types.String `tfsdk:"id"`
+	Action   types.String `tfsdk:"action"`
+	AlertIDs types.Set    `tfsdk:"alert_ids"`
+}
@@ -0,0 +1,61 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// AuditLog - audit log entry of the ApplicationService
+type AuditLog struct {
+	ID          int64  `json:"Id"`
+	Severity    string `json:"Severity"`
+	Message     string `json:"Message"`
+	Category    string `json:"Category"`
+	UserName    string `json:"UserName"`
+	IPAddress   string `json:"IpAddress"`
+	MessageArgs string `json:"MessageArgs"`
+	MessageID   string `json:"MessageID"`
+	CreatedDate string `json:"CreatedDate"`
+}
+
+// OmeAuditLogsData - schema for the audit logs data source
+type OmeAuditLogsData struct {
+	ID        types.Int64          `tfsdk:"id"`
+	Filters   *OmeAuditLogsFilters `tfsdk:"filters"`
+	AuditLogs []OmeAuditLog        `tfsdk:"audit_logs"`
+}
+
+// OmeAuditLogsFilters - schema for the audit logs data source filters
+type OmeAuditLogsFilters struct {
+	UserName  types.String `tfsdk:"user_name"`
+	SourceIP  types.String `tfsdk:"source_ip"`
+	Category  types.String `tfsdk:"category"`
+	Severity  types.String `tfsdk:"severity"`
+	StartTime types.String `tfsdk:"start_time"`
+	EndTime   types.String `tfsdk:"end_time"`
+}
+
+// OmeAuditLog - schema for a single entry of the audit logs data source
+type OmeAuditLog struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Severity    types.String `tfsdk:"severity"`
+	Message     types.String `tfsdk:"message"`
+	MessageID   types.String `tfsdk:"message_id"`
+	MessageArgs types.String `tfsdk:"message_args"`
+	Category    types.String `tfsdk:"category"`
+	UserName    types.String `tfsdk:"user_name"`
+	SourceIP    types.String `tfsdk:"source_ip"`
+	CreatedDate types.String `tfsdk:"created_date"`
+}
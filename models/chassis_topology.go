@@ -0,0 +1,56 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// ManagementDomain - chassis of the multi-chassis management group of the ManagementDomainService
+type ManagementDomain struct {
+	ID                  int64  `json:"Id"`
+	Name                string `json:"Name"`
+	Identifier          string `json:"Identifier"`
+	DeviceID            int64  `json:"DeviceId"`
+	DomainRoleTypeValue string `json:"DomainRoleTypeValue"`
+}
+
+// OmeChassisTopologyData - schema for the chassis topology data source
+type OmeChassisTopologyData struct {
+	ID                 types.Int64          `tfsdk:"id"`
+	ChassisServiceTags types.List           `tfsdk:"chassis_service_tags"`
+	MCMLeadServiceTag  types.String         `tfsdk:"mcm_lead_service_tag"`
+	Chassis            []OmeChassisTopology `tfsdk:"chassis"`
+}
+
+// OmeChassisTopology - schema for a chassis of the chassis topology data source
+type OmeChassisTopology struct {
+	ID         types.Int64      `tfsdk:"id"`
+	ServiceTag types.String     `tfsdk:"service_tag"`
+	Name       types.String     `tfsdk:"name"`
+	Model      types.String     `tfsdk:"model"`
+	MCMRole    types.String     `tfsdk:"mcm_role"`
+	Slots      []OmeChassisSlot `tfsdk:"slots"`
+}
+
+// OmeChassisSlot - schema for an occupied slot of a chassis of the chassis topology data source
+type OmeChassisSlot struct {
+	Number     types.Int64  `tfsdk:"number"`
+	Name       types.String `tfsdk:"name"`
+	Type       types.String `tfsdk:"type"`
+	DeviceID   types.Int64  `tfsdk:"device_id"`
+	ServiceTag types.String `tfsdk:"service_tag"`
+	DeviceName types.String `tfsdk:"device_name"`
+	Model      types.String `tfsdk:"model"`
+}
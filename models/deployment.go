@@ -24,6 +24,9 @@ type TemplateDeployment struct {
 	DeviceServicetags               types.Set    `tfsdk:"device_servicetags"`
 	BootToNetworkISO                types.Object `tfsdk:"boot_to_network_iso"`
 	DeviceAttributes                types.List   `tfsdk:"device_attributes"`
+	DeviceAttributeOverrides        types.List   `tfsdk:"device_attribute_overrides"`
+	ChassisOptions                  types.Object `tfsdk:"chassis_options"`
+	TemplateDeviceType              types.String `tfsdk:"template_device_type"`
 	JobRetryCount                   types.Int64  `tfsdk:"job_retry_count"`
 	SleepInterval                   types.Int64  `tfsdk:"sleep_interval"`
 	ForcedShutdown                  types.Bool   `tfsdk:"forced_shutdown"`
@@ -34,6 +37,28 @@ type TemplateDeployment struct {
 	OptionsContinueOnWarning        types.Bool   `tfsdk:"options_continue_on_warning"`
 	RunLater                        types.Bool   `tfsdk:"run_later"`
 	Cron                            types.String `tfsdk:"cron"`
+	BatchSize                       types.Int64  `tfsdk:"batch_size"`
+	MaxFailures                     types.Int64  `tfsdk:"max_failures"`
+	PauseBetweenBatches             types.Int64  `tfsdk:"pause_between_batches"`
+	RetryFailedDevices              types.Bool   `tfsdk:"retry_failed_devices"`
+	DeviceStatus                    types.List   `tfsdk:"device_status"`
+}
+
+// DeploymentDeviceStatus to hold the state data of the deployment of a target device
+type DeploymentDeviceStatus struct {
+	DeviceID         types.Int64  `tfsdk:"device_id"`
+	DeviceServiceTag types.String `tfsdk:"device_servicetag"`
+	Status           types.String `tfsdk:"status"`
+	Message          types.String `tfsdk:"message"`
+	JobID            types.Int64  `tfsdk:"job_id"`
+}
+
+// DeviceDeploymentResult holds the outcome of the deployment of a target device
+type DeviceDeploymentResult struct {
+	DeviceID int64
+	JobID    int64
+	Status   string
+	Message  string
 }
 
 // BootToNetworkISO to hold planned and state data for boot info
@@ -79,6 +104,18 @@ type DeviceAttributes struct {
 	Attributes        types.List `tfsdk:"attributes"`
 }
 
+// DeviceAttributeOverrides to hold planned and state data of the attribute values keyed by path
+type DeviceAttributeOverrides struct {
+	DeviceServiceTags types.Set `tfsdk:"device_servicetags"`
+	Attributes        types.Map `tfsdk:"attributes"`
+}
+
+// ChassisDeployOptions to hold planned and state data of the settings of the lead chassis keyed by path
+type ChassisDeployOptions struct {
+	PowerSettings   types.Map `tfsdk:"power_settings"`
+	NetworkSettings types.Map `tfsdk:"network_settings"`
+}
+
 // OMETemplateDeployRequest to form a request to deploy template
 type OMETemplateDeployRequest struct {
 	ID                  int64                  `json:"Id"`
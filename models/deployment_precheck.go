@@ -0,0 +1,64 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// DevicePrecheckResult - outcome of the precheck of a deployment or a remediation for a device
+type DevicePrecheckResult struct {
+	DeviceID         int64
+	DeviceServiceTag string
+	Passed           bool
+	Message          string
+	Findings         []DevicePrecheckFinding
+}
+
+// DevicePrecheckFinding - issue or change reported by the precheck for a device
+type DevicePrecheckFinding struct {
+	Category string
+	Message  string
+}
+
+// OmeDeploymentPrecheck - tfsdk model of the deployment precheck data source
+type OmeDeploymentPrecheck struct {
+	ID                        types.String              `tfsdk:"id"`
+	TemplateID                types.Int64               `tfsdk:"template_id"`
+	TemplateName              types.String              `tfsdk:"template_name"`
+	BaselineID                types.Int64               `tfsdk:"baseline_id"`
+	BaselineName              types.String              `tfsdk:"baseline_name"`
+	DeviceIDs                 types.Set                 `tfsdk:"device_ids"`
+	DeviceServiceTags         types.Set                 `tfsdk:"device_servicetags"`
+	OptionsStrictCheckingVlan types.Bool                `tfsdk:"options_strict_checking_vlan"`
+	JobRetryCount             types.Int64               `tfsdk:"job_retry_count"`
+	SleepInterval             types.Int64               `tfsdk:"sleep_interval"`
+	JobID                     types.Int64               `tfsdk:"job_id"`
+	ComplianceCheckedAt       types.String              `tfsdk:"compliance_checked_at"`
+	Passed                    types.Bool                `tfsdk:"passed"`
+	Devices                   []OmeDevicePrecheckResult `tfsdk:"devices"`
+}
+
+// OmeDevicePrecheckResult - tfsdk model of the precheck result of a device
+type OmeDevicePrecheckResult struct {
+	DeviceID         types.Int64                `tfsdk:"device_id"`
+	DeviceServiceTag types.String               `tfsdk:"device_servicetag"`
+	Passed           types.Bool                 `tfsdk:"passed"`
+	Message          types.String               `tfsdk:"message"`
+	Findings         []OmeDevicePrecheckFinding `tfsdk:"findings"`
+}
+
+// OmeDevicePrecheckFinding - tfsdk model of a precheck finding
+type OmeDevicePrecheckFinding struct {
+	Category types.String `tfsdk:"category"`
+	Message  types.String `tfsdk:"message"`
+}
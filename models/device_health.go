@@ -0,0 +1,50 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// OmeDeviceHealthData - schema for the device health data source
+type OmeDeviceHealthData struct {
+	ID                types.Int64       `tfsdk:"id"`
+	DeviceIDs         types.List        `tfsdk:"device_ids"`
+	DeviceServiceTags types.List        `tfsdk:"device_service_tags"`
+	DeviceGroupNames  types.List        `tfsdk:"device_group_names"`
+	FilterExpression  types.String      `tfsdk:"filter_expression"`
+	StatusFilter      types.Set         `tfsdk:"status_filter"`
+	IncludeSubsystems types.Bool        `tfsdk:"include_subsystems"`
+	StatusCounts      types.Map         `tfsdk:"status_counts"`
+	Devices           []OmeDeviceHealth `tfsdk:"devices"`
+}
+
+// OmeDeviceHealth - schema for the health of a device of the device health data source
+type OmeDeviceHealth struct {
+	ID              types.Int64          `tfsdk:"id"`
+	ServiceTag      types.String         `tfsdk:"service_tag"`
+	Name            types.String         `tfsdk:"name"`
+	Model           types.String         `tfsdk:"model"`
+	GlobalStatus    types.String         `tfsdk:"global_status"`
+	ConnectionState types.Bool           `tfsdk:"connection_state"`
+	PowerState      types.String         `tfsdk:"power_state"`
+	LastStatusTime  types.String         `tfsdk:"last_status_time"`
+	Subsystems      []OmeSubsystemHealth `tfsdk:"subsystems"`
+}
+
+// OmeSubsystemHealth - schema for the rollup status of a subsystem of a device
+type OmeSubsystemHealth struct {
+	Name   types.String `tfsdk:"name"`
+	Status types.String `tfsdk:"status"`
+}
@@ -0,0 +1,46 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// OmeDeviceOnboarding - schema for the device onboarding resource
+type OmeDeviceOnboarding struct {
+	ID              types.Int64  `tfsdk:"id"`
+	ManagementIP    types.String `tfsdk:"management_ip"`
+	DeviceType      types.String `tfsdk:"device_type"`
+	Redfish         *OmeRedfish  `tfsdk:"redfish"`
+	WSMAN           *OmeWSMAN    `tfsdk:"wsman"`
+	TrapDestination types.Bool   `tfsdk:"trap_destination"`
+	ManagedState    types.String `tfsdk:"managed_state"`
+	GroupIDs        types.Set    `tfsdk:"group_ids"`
+	Timeout         types.Int64  `tfsdk:"timeout"`
+	RemoveOnDestroy types.Bool   `tfsdk:"remove_on_destroy"`
+	ServiceTag      types.String `tfsdk:"service_tag"`
+	DeviceName      types.String `tfsdk:"device_name"`
+	DiscoveryJobID  types.Int64  `tfsdk:"discovery_job_id"`
+}
+
+// OmeDeviceManagement - schema for the device management resource
+type OmeDeviceManagement struct {
+	ID           types.Int64  `tfsdk:"id"`
+	DeviceID     types.Int64  `tfsdk:"device_id"`
+	ServiceTag   types.String `tfsdk:"service_tag"`
+	ManagedState types.String `tfsdk:"managed_state"`
+	Redfish      *OmeRedfish  `tfsdk:"redfish"`
+	WSMAN        *OmeWSMAN    `tfsdk:"wsman"`
+	Timeout      types.Int64  `tfsdk:"timeout"`
+}
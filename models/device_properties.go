@@ -0,0 +1,53 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// DeviceProperties - editable properties of a device
+type DeviceProperties struct {
+	ID         int64  `json:"Id"`
+	DeviceName string `json:"DeviceName"`
+	AssetTag   string `json:"AssetTag"`
+}
+
+// DeviceLocationSetting - location setting of a device
+type DeviceLocationSetting struct {
+	SettingType string `json:"SettingType"`
+	DataCenter  string `json:"DataCenter"`
+	Room        string `json:"Room"`
+	Aisle       string `json:"Aisle"`
+	RackName    string `json:"RackName"`
+	RackSlot    int64  `json:"RackSlot"`
+}
+
+// OmeDeviceProperties - schema for the device properties resource
+type OmeDeviceProperties struct {
+	ID         types.Int64                  `tfsdk:"id"`
+	ServiceTag types.String                 `tfsdk:"service_tag"`
+	Name       types.String                 `tfsdk:"name"`
+	AssetTag   types.String                 `tfsdk:"asset_tag"`
+	Location   *OmeDevicePropertiesLocation `tfsdk:"location"`
+}
+
+// OmeDevicePropertiesLocation - schema for the location of the device properties resource
+type OmeDevicePropertiesLocation struct {
+	Datacenter types.String `tfsdk:"datacenter"`
+	Room       types.String `tfsdk:"room"`
+	Aisle      types.String `tfsdk:"aisle"`
+	Rack       types.String `tfsdk:"rack"`
+	RackSlot   types.Int64  `tfsdk:"rack_slot"`
+}
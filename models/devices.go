@@ -79,7 +79,12 @@ type DiscoveryConfigurationJob struct {
 
 // SlotConfiguration - SlotConfiguration
 type SlotConfiguration struct {
-	ChassisName *string `json:"ChassisName"`
+	ChassisName       *string `json:"ChassisName"`
+	ChassisID         string  `json:"ChassisId"`
+	ChassisServiceTag string  `json:"ChassisServiceTag"`
+	SlotNumber        string  `json:"SlotNumber"`
+	SlotName          string  `json:"SlotName"`
+	SlotType          string  `json:"SlotType"`
 }
 
 // DeviceManagement - embedded device management response from the Devices
@@ -110,8 +115,9 @@ type ManagementProfile struct {
 
 // DevicesResModel - Tfsdk model for devices resource
 type DevicesResModel struct {
-	ID      types.String `tfsdk:"id"`
-	Devices types.List   `tfsdk:"devices"` // []DeviceItemModel
+	ID                 types.String `tfsdk:"id"`
+	Devices            types.List   `tfsdk:"devices"` // []DeviceItemModel
+	ManagementIPFilter types.List   `tfsdk:"management_ip_filter"`
 }
 
 // DeviceItemModel - Tfsdk model for each device in devices resource
@@ -133,10 +139,12 @@ func (DeviceItemModel) getType() map[string]attr.Type {
 
 // OmeDeviceData - schema for device data source
 type OmeDeviceData struct {
-	ID             types.Int64           `tfsdk:"id"`
-	Filters        types.Object          `tfsdk:"filters"`
-	Devices        []OmeSingleDeviceData `tfsdk:"devices"`
-	InventoryTypes []string              `tfsdk:"inventory_types"`
+	ID                   types.Int64           `tfsdk:"id"`
+	Filters              types.Object          `tfsdk:"filters"`
+	Devices              []OmeSingleDeviceData `tfsdk:"devices"`
+	InventoryTypes       []string              `tfsdk:"inventory_types"`
+	InventoryConcurrency types.Int64           `tfsdk:"inventory_concurrency"`
+	InventoryErrors      types.Map             `tfsdk:"inventory_errors"`
 }
 
 // OmeDeviceDataFilters - schema for device data source filters
@@ -144,6 +152,7 @@ type OmeDeviceDataFilters struct {
 	IDs        types.List   `tfsdk:"ids"`
 	SvcTags    types.List   `tfsdk:"device_service_tags"`
 	IPExprs    types.List   `tfsdk:"ip_expressions"`
+	MgmtIPs    types.List   `tfsdk:"management_ip_filter"`
 	FilterExpr types.String `tfsdk:"filter_expression"`
 }
 
@@ -234,8 +243,9 @@ func NewDevicesResModel(input []Device) (DevicesResModel, diag.Diagnostics) {
 	}
 	tfdevs, dgs := objListValue(DeviceItemModel{}.getType(), devs)
 	return DevicesResModel{
-		ID:      types.StringValue("dummy"),
-		Devices: tfdevs,
+		ID:                 types.StringValue("dummy"),
+		Devices:            tfdevs,
+		ManagementIPFilter: types.ListNull(types.StringType),
 	}, dgs
 }
 
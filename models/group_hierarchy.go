@@ -0,0 +1,64 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// OmeGroupHierarchyData - schema for the group hierarchy data source
+type OmeGroupHierarchyData struct {
+	ID            types.Int64              `tfsdk:"id"`
+	RootGroupName types.String             `tfsdk:"root_group_name"`
+	MaxDepth      types.Int64              `tfsdk:"max_depth"`
+	DeviceIDs     types.Set                `tfsdk:"device_ids"`
+	Groups        []OmeGroupHierarchyNode  `tfsdk:"groups"`
+	Levels        []OmeGroupHierarchyLevel `tfsdk:"levels"`
+}
+
+// OmeGroupHierarchyNode - schema for a group of the group hierarchy data source
+type OmeGroupHierarchyNode struct {
+	ID                 types.Int64  `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Description        types.String `tfsdk:"description"`
+	ParentID           types.Int64  `tfsdk:"parent_id"`
+	MembershipTypeID   types.Int64  `tfsdk:"membership_type_id"`
+	Level              types.Int64  `tfsdk:"level"`
+	Path               types.List   `tfsdk:"path"`
+	SubGroupIDs        types.List   `tfsdk:"sub_group_ids"`
+	DeviceIDs          types.Set    `tfsdk:"device_ids"`
+	RecursiveDeviceIDs types.Set    `tfsdk:"recursive_device_ids"`
+}
+
+// OmeGroupHierarchyLevel - schema for the groups and devices of a level of the group hierarchy data source
+type OmeGroupHierarchyLevel struct {
+	Level     types.Int64 `tfsdk:"level"`
+	GroupIDs  types.List  `tfsdk:"group_ids"`
+	DeviceIDs types.Set   `tfsdk:"device_ids"`
+}
+
+// OmeGroupTree - schema for the group tree resource
+type OmeGroupTree struct {
+	ID       types.String                `tfsdk:"id"`
+	ParentID types.Int64                 `tfsdk:"parent_id"`
+	Groups   map[string]OmeGroupTreeNode `tfsdk:"groups"`
+}
+
+// OmeGroupTreeNode - schema for a group of the group tree resource
+type OmeGroupTreeNode struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Parent      types.String `tfsdk:"parent"`
+	Description types.String `tfsdk:"description"`
+	DeviceIDs   types.Set    `tfsdk:"device_ids"`
+}
@@ -0,0 +1,126 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// IdentityPoolMacSettings - mac address range of the ethernet and fcoe settings of an identity pool
+type IdentityPoolMacSettings struct {
+	Mac *IdentityPoolMacRange `json:"Mac,omitempty"`
+}
+
+// IdentityPoolMacRange - range of mac addresses of an identity pool, the starting address is base64 encoded
+type IdentityPoolMacRange struct {
+	IdentityCount      int64  `json:"IdentityCount"`
+	StartingMacAddress string `json:"StartingMacAddress"`
+}
+
+// IdentityPoolIscsiSettings - iscsi settings of an identity pool
+type IdentityPoolIscsiSettings struct {
+	Mac                     *IdentityPoolMacRange        `json:"Mac,omitempty"`
+	InitiatorConfig         *IdentityPoolInitiatorConfig `json:"InitiatorConfig,omitempty"`
+	InitiatorIPPoolSettings *IdentityPoolInitiatorIPPool `json:"InitiatorIpPoolSettings,omitempty"`
+}
+
+// IdentityPoolInitiatorConfig - iscsi initiator of an identity pool
+type IdentityPoolInitiatorConfig struct {
+	IqnPrefix string `json:"IqnPrefix"`
+}
+
+// IdentityPoolInitiatorIPPool - ip addresses of the iscsi initiators of an identity pool
+type IdentityPoolInitiatorIPPool struct {
+	IPRange            string `json:"IpRange"`
+	SubnetMask         string `json:"SubnetMask"`
+	Gateway            string `json:"Gateway,omitempty"`
+	PrimaryDNSServer   string `json:"PrimaryDnsServer,omitempty"`
+	SecondaryDNSServer string `json:"SecondaryDnsServer,omitempty"`
+}
+
+// IdentityPoolFcSettings - fibre channel settings of an identity pool
+type IdentityPoolFcSettings struct {
+	Wwnn *IdentityPoolFcRange `json:"Wwnn,omitempty"`
+	Wwpn *IdentityPoolFcRange `json:"Wwpn,omitempty"`
+}
+
+// IdentityPoolFcRange - range of world wide names of an identity pool, the starting address is base64 encoded
+type IdentityPoolFcRange struct {
+	IdentityCount   int64  `json:"IdentityCount"`
+	StartingAddress string `json:"StartingAddress"`
+}
+
+// IdentitySet - identity set of the usage of an identity pool
+type IdentitySet struct {
+	ID   int64  `json:"IdentitySetId"`
+	Name string `json:"Name"`
+}
+
+// IdentityUsage - identity of an identity set of an identity pool
+type IdentityUsage struct {
+	ServerName    string `json:"ServerName"`
+	NicIdentifier string `json:"NicIdentifier"`
+	MacAddress    string `json:"MacAddress"`
+	Status        string `json:"Status"`
+}
+
+// OmeIdentityPool - schema for the identity pool resource
+type OmeIdentityPool struct {
+	ID               types.Int64                   `tfsdk:"id"`
+	Name             types.String                  `tfsdk:"name"`
+	Description      types.String                  `tfsdk:"description"`
+	EthernetSettings *OmeIdentityPoolMacSettings   `tfsdk:"ethernet_settings"`
+	IscsiSettings    *OmeIdentityPoolIscsiSettings `tfsdk:"iscsi_settings"`
+	FcoeSettings     *OmeIdentityPoolMacSettings   `tfsdk:"fcoe_settings"`
+	FcSettings       *OmeIdentityPoolFcSettings    `tfsdk:"fc_settings"`
+	Usage            types.List                    `tfsdk:"usage"`
+}
+
+// OmeIdentityPoolMacSettings - schema for the ethernet and fcoe settings of the identity pool resource
+type OmeIdentityPoolMacSettings struct {
+	StartingMacAddress types.String `tfsdk:"starting_mac_address"`
+	IdentityCount      types.Int64  `tfsdk:"identity_count"`
+}
+
+// OmeIdentityPoolIscsiSettings - schema for the iscsi settings of the identity pool resource
+type OmeIdentityPoolIscsiSettings struct {
+	StartingMacAddress types.String                    `tfsdk:"starting_mac_address"`
+	IdentityCount      types.Int64                     `tfsdk:"identity_count"`
+	IqnPrefix          types.String                    `tfsdk:"iqn_prefix"`
+	InitiatorIPPool    *OmeIdentityPoolInitiatorIPPool `tfsdk:"initiator_ip_pool"`
+}
+
+// OmeIdentityPoolInitiatorIPPool - schema for the iscsi initiator ip pool of the identity pool resource
+type OmeIdentityPoolInitiatorIPPool struct {
+	IPRange            types.String `tfsdk:"ip_range"`
+	SubnetMask         types.String `tfsdk:"subnet_mask"`
+	Gateway            types.String `tfsdk:"gateway"`
+	PrimaryDNSServer   types.String `tfsdk:"primary_dns_server"`
+	SecondaryDNSServer types.String `tfsdk:"secondary_dns_server"`
+}
+
+// OmeIdentityPoolFcSettings - schema for the fibre channel settings of the identity pool resource
+type OmeIdentityPoolFcSettings struct {
+	StartingAddress types.String `tfsdk:"starting_address"`
+	IdentityCount   types.Int64  `tfsdk:"identity_count"`
+}
+
+// OmeIdentityPoolUsage - schema for the usage of an identity type of the identity pool resource
+type OmeIdentityPoolUsage struct {
+	IdentityType types.String `tfsdk:"identity_type"`
+	Total        types.Int64  `tfsdk:"total"`
+	Assigned     types.Int64  `tfsdk:"assigned"`
+	Reserved     types.Int64  `tfsdk:"reserved"`
+	Free         types.Int64  `tfsdk:"free"`
+}
@@ -91,12 +91,14 @@ const (
 	ResetIDRACJobType
 	// ClearJobQueueJobType - iDrac job queue clear job type
 	ClearJobQueueJobType
+	// OnboardingJobType - device onboarding job type
+	OnboardingJobType
 )
 
 // MarshalJSON - implements marshaller interface
 func (j JobType) MarshalJSON() ([]byte, error) {
-	jobTypeMap := map[JobType]uint8{InventoryRefreshJobType: 8, ResetIDRACJobType: 3, ClearJobQueueJobType: 3}
-	jtypeMap := map[uint8]string{3: "DeviceAction_Task", 8: "Inventory_Task"}
+	jobTypeMap := map[JobType]uint8{InventoryRefreshJobType: 8, ResetIDRACJobType: 3, ClearJobQueueJobType: 3, OnboardingJobType: 102}
+	jtypeMap := map[uint8]string{3: "DeviceAction_Task", 8: "Inventory_Task", 102: "Onboarding_Task"}
 
 	return json.Marshal(&struct {
 		ID   uint8  `json:"Id"`
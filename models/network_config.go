@@ -17,13 +17,13 @@ import "github.com/hashicorp/terraform-plugin-framework/types"
 
 // VLanNetworks of OME
 type VLanNetworks struct {
-	ID                int64  `json:"Id"`
+	ID                int64  `json:"Id,omitempty"`
 	Name              string `json:"Name"`
 	Description       string `json:"Description"`
 	VLANMaximum       int64  `json:"VlanMaximum"`
 	VLANMinimum       int64  `json:"VlanMinimum"`
 	Type              int64  `json:"Type"`
-	InternalRefNWUUID string `json:"InternalRefNWUUId"`
+	InternalRefNWUUID string `json:"InternalRefNWUUId,omitempty"`
 }
 
 // VLanNetworksTypeTfsdk is used to hold the config data
@@ -42,3 +42,20 @@ type VLanNetworksTfsdk struct {
 	Type              types.Int64  `tfsdk:"type"`
 	InternalRefNWUUID types.String `tfsdk:"internal_ref_nwuu_id"`
 }
+
+// NetworkType - type of the vlan networks of OME
+type NetworkType struct {
+	ID   int64  `json:"Id"`
+	Name string `json:"Name"`
+}
+
+// OmeVlanNetwork - schema for the vlan network resource
+type OmeVlanNetwork struct {
+	ID                types.Int64  `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Description       types.String `tfsdk:"description"`
+	VLANMinimum       types.Int64  `tfsdk:"vlan_minimum"`
+	VLANMaximum       types.Int64  `tfsdk:"vlan_maximum"`
+	NetworkType       types.String `tfsdk:"network_type"`
+	InternalRefNWUUID types.String `tfsdk:"internal_ref_nwuu_id"`
+}
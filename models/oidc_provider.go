@@ -0,0 +1,46 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// OIDCProvider - OpenID Connect provider of the AccountService
+type OIDCProvider struct {
+	ID                 int64  `json:"Id,omitempty"`
+	Name               string `json:"Name"`
+	DiscoveryURI       string `json:"DiscoveryURI"`
+	AuthType           string `json:"AuthType"`
+	ClientID           string `json:"ClientId,omitempty"`
+	ClientSecret       string `json:"ClientSecret,omitempty"`
+	InitialAccessToken string `json:"InitialAccessToken,omitempty"`
+	VerifyCertificate  bool   `json:"VerifyCertificate"`
+	CertificateData    string `json:"CertificateData,omitempty"`
+	Enabled            bool   `json:"Enabled"`
+}
+
+// OmeOIDCProvider - schema for the OpenID Connect provider resource
+type OmeOIDCProvider struct {
+	ID                 types.Int64  `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	DiscoveryURI       types.String `tfsdk:"discovery_uri"`
+	RegistrationMethod types.String `tfsdk:"registration_method"`
+	ClientID           types.String `tfsdk:"client_id"`
+	ClientSecret       types.String `tfsdk:"client_secret"`
+	InitialAccessToken types.String `tfsdk:"initial_access_token"`
+	VerifyCertificate  types.Bool   `tfsdk:"verify_certificate"`
+	Certificate        types.String `tfsdk:"certificate"`
+	Enabled            types.Bool   `tfsdk:"enabled"`
+}
@@ -0,0 +1,98 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// QueryContext - tables and fields which can be used in the conditions of a query
+type QueryContext struct {
+	ID     int64               `json:"Id"`
+	Name   string              `json:"Name"`
+	Tables []QueryContextTable `json:"Tables"`
+}
+
+// QueryContextTable - table of a query context
+type QueryContextTable struct {
+	ID     int64               `json:"Id"`
+	Name   string              `json:"Name"`
+	Fields []QueryContextField `json:"Fields"`
+}
+
+// QueryContextField - field of a query context table
+type QueryContextField struct {
+	ID          int64  `json:"Id"`
+	Name        string `json:"Name"`
+	FieldTypeID int64  `json:"FieldTypeId"`
+}
+
+// QueryOperatorInfo - operators which can be used in the conditions of a query
+type QueryOperatorInfo struct {
+	AvailableOperators []QueryOperator `json:"AvailableOperators"`
+}
+
+// QueryOperator - operator of a query condition
+type QueryOperator struct {
+	ID   int64  `json:"Id"`
+	Name string `json:"Name"`
+}
+
+// QueryGroupExtension - query of a query group
+type QueryGroupExtension struct {
+	FilterID   int64            `json:"FilterId"`
+	ContextID  int64            `json:"ContextId"`
+	Conditions []QueryCondition `json:"Conditions"`
+}
+
+// QueryCondition - condition of a query, the parentheses nest the conditions
+type QueryCondition struct {
+	LogicalOperatorID int64  `json:"LogicalOperatorId"`
+	LeftParen         bool   `json:"LeftParen"`
+	FieldID           int64  `json:"FieldId"`
+	OperatorID        int64  `json:"OperatorId"`
+	Value             string `json:"Value"`
+	RightParen        bool   `json:"RightParen"`
+}
+
+// OmeQueryGroup - schema for the query group resource
+type OmeQueryGroup struct {
+	ID               types.Int64            `tfsdk:"id"`
+	Name             types.String           `tfsdk:"name"`
+	Description      types.String           `tfsdk:"description"`
+	ParentID         types.Int64            `tfsdk:"parent_id"`
+	MembershipTypeID types.Int64            `tfsdk:"membership_type_id"`
+	Criteria         *OmeQueryGroupCriteria `tfsdk:"criteria"`
+	DeviceIDs        types.Set              `tfsdk:"device_ids"`
+}
+
+// OmeQueryGroupCriteria - schema for the criteria of the query group resource
+type OmeQueryGroupCriteria struct {
+	Operator   types.String             `tfsdk:"operator"`
+	Conditions []OmeQueryCondition      `tfsdk:"conditions"`
+	Groups     []OmeQueryConditionGroup `tfsdk:"groups"`
+}
+
+// OmeQueryConditionGroup - schema for a nested group of conditions of the query group resource
+type OmeQueryConditionGroup struct {
+	Operator   types.String        `tfsdk:"operator"`
+	Conditions []OmeQueryCondition `tfsdk:"conditions"`
+}
+
+// OmeQueryCondition - schema for a condition of the query group resource
+type OmeQueryCondition struct {
+	Field    types.String `tfsdk:"field"`
+	Operator types.String `tfsdk:"operator"`
+	Value    types.String `tfsdk:"value"`
+}
@@ -0,0 +1,89 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// OMEProfile - server profile of OME
+type OMEProfile struct {
+	ID                 int64  `json:"Id"`
+	ProfileName        string `json:"ProfileName"`
+	ProfileDescription string `json:"ProfileDescription"`
+	TemplateID         int64  `json:"TemplateId"`
+	TemplateName       string `json:"TemplateName"`
+	TargetID           int64  `json:"TargetId"`
+	TargetName         string `json:"TargetName"`
+	ProfileState       int64  `json:"ProfileState"`
+	LastRunStatus      int64  `json:"LastRunStatus"`
+}
+
+// OMECreateProfile - payload to create server profiles from a template
+type OMECreateProfile struct {
+	TemplateID               int64  `json:"TemplateId"`
+	NamePrefix               string `json:"NamePrefix"`
+	Description              string `json:"Description"`
+	NumberOfProfilesToCreate int64  `json:"NumberOfProfilesToCreate"`
+}
+
+// OMEUpdateProfile - payload to update a server profile
+type OMEUpdateProfile struct {
+	ID          int64          `json:"Id"`
+	ProfileName string         `json:"ProfileName"`
+	TemplateID  int64          `json:"TemplateId"`
+	Description string         `json:"Description"`
+	Attributes  []OMEAttribute `json:"Attributes,omitempty"`
+}
+
+// OMEAssignProfile - payload to assign a server profile to a device
+type OMEAssignProfile struct {
+	ID       int64       `json:"Id"`
+	TargetID int64       `json:"TargetId"`
+	Options  OMEOptions  `json:"Options"`
+	Schedule OMESchedule `json:"Schedule"`
+}
+
+// OMEMigrateProfile - payload to migrate a server profile to another device
+type OMEMigrateProfile struct {
+	SourceID     int64 `json:"SourceId"`
+	TargetID     int64 `json:"TargetId"`
+	ForceMigrate bool  `json:"ForceMigrate"`
+}
+
+// OMERedeployProfiles - payload to redeploy server profiles to their devices
+type OMERedeployProfiles struct {
+	SourceIDList []int64     `json:"SourceIdList"`
+	Options      OMEOptions  `json:"Options"`
+	Schedule     OMESchedule `json:"Schedule"`
+}
+
+// OmeServerProfile - tfsdk model of the server profile resource
+type OmeServerProfile struct {
+	ID                 types.Int64  `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Description        types.String `tfsdk:"description"`
+	TemplateID         types.Int64  `tfsdk:"template_id"`
+	TemplateName       types.String `tfsdk:"template_name"`
+	AttributeOverrides types.Map    `tfsdk:"attribute_overrides"`
+	DeviceID           types.Int64  `tfsdk:"device_id"`
+	DeviceServiceTag   types.String `tfsdk:"device_servicetag"`
+	ChassisServiceTag  types.String `tfsdk:"chassis_servicetag"`
+	SlotNumber         types.Int64  `tfsdk:"slot_number"`
+	ForceMigrate       types.Bool   `tfsdk:"force_migrate"`
+	ForcedShutdown     types.Bool   `tfsdk:"forced_shutdown"`
+	TargetID           types.Int64  `tfsdk:"target_id"`
+	TargetName         types.String `tfsdk:"target_name"`
+	ProfileState       types.String `tfsdk:"profile_state"`
+	JobRetryCount      types.Int64  `tfsdk:"job_retry_count"`
+	SleepInterval      types.Int64  `tfsdk:"sleep_interval"`
+}
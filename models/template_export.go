@@ -0,0 +1,106 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// OMEExportTemplate - payload to export the content of a template
+type OMEExportTemplate struct {
+	TemplateID int64  `json:"TemplateId"`
+	Format     string `json:"Format"`
+}
+
+// OMEExportedTemplate - response of the export of a template
+type OMEExportedTemplate struct {
+	TemplateID int64  `json:"TemplateId"`
+	Content    string `json:"Content"`
+	ViewTypeID int64  `json:"ViewTypeId"`
+}
+
+// OMESystemConfiguration - template exported as a server configuration profile in the json format
+type OMESystemConfiguration struct {
+	SystemConfiguration OMESystemConfigurationComponent `json:"SystemConfiguration"`
+}
+
+// OMESystemConfigurationComponent - component of a server configuration profile, identified by its FQDD
+type OMESystemConfigurationComponent struct {
+	FQDD       string                            `json:"FQDD"`
+	Attributes []OMESystemConfigurationAttribute `json:"Attributes"`
+	Components []OMESystemConfigurationComponent `json:"Components"`
+}
+
+// OMESystemConfigurationAttribute - attribute of a component of a server configuration profile, named after its DMTF name
+type OMESystemConfigurationAttribute struct {
+	Name  string `json:"Name"`
+	Value string `json:"Value"`
+}
+
+// OMEDeviceComplianceDetail - attribute compliance of a device against the template of a baseline
+type OMEDeviceComplianceDetail struct {
+	DeviceID                  int64                         `json:"DeviceId"`
+	DeviceName                string                        `json:"DeviceName"`
+	BaselineID                int64                         `json:"BaselineId"`
+	TemplateID                int64                         `json:"TemplateId"`
+	ComplianceAttributeGroups []OMEComplianceAttributeGroup `json:"ComplianceAttributeGroups"`
+}
+
+// OMEComplianceAttributeGroup - group of attributes in the compliance of a device
+type OMEComplianceAttributeGroup struct {
+	DisplayName                  string                        `json:"DisplayName"`
+	ComplianceStatus             int64                         `json:"ComplianceStatus"`
+	ComplianceSubAttributeGroups []OMEComplianceAttributeGroup `json:"ComplianceSubAttributeGroups"`
+	Attributes                   []OMEComplianceAttribute      `json:"Attributes"`
+}
+
+// OMEComplianceAttribute - attribute in the compliance of a device
+type OMEComplianceAttribute struct {
+	AttributeID      int64   `json:"AttributeId"`
+	DisplayName      string  `json:"DisplayName"`
+	Value            *string `json:"Value"`
+	ExpectedValue    *string `json:"ExpectedValue"`
+	ComplianceStatus int64   `json:"ComplianceStatus"`
+	ComplianceReason string  `json:"ComplianceReason"`
+}
+
+// TemplateAttributeDiff - difference of an attribute between a template and its reference
+type TemplateAttributeDiff struct {
+	Path           string
+	Value          *string
+	ReferenceValue *string
+	Status         string
+}
+
+// OmeTemplateExport - tfsdk model of the template export data source
+type OmeTemplateExport struct {
+	ID                      types.String               `tfsdk:"id"`
+	TemplateID              types.Int64                `tfsdk:"template_id"`
+	TemplateName            types.String               `tfsdk:"template_name"`
+	Format                  types.String               `tfsdk:"format"`
+	Content                 types.String               `tfsdk:"content"`
+	Attributes              types.Map                  `tfsdk:"attributes"`
+	CompareTemplateID       types.Int64                `tfsdk:"compare_template_id"`
+	CompareTemplateName     types.String               `tfsdk:"compare_template_name"`
+	CompareDeviceID         types.Int64                `tfsdk:"compare_device_id"`
+	CompareDeviceServiceTag types.String               `tfsdk:"compare_device_servicetag"`
+	CompareBaselineName     types.String               `tfsdk:"compare_baseline_name"`
+	Differences             []OmeTemplateAttributeDiff `tfsdk:"differences"`
+}
+
+// OmeTemplateAttributeDiff - tfsdk model of a difference of an attribute
+type OmeTemplateAttributeDiff struct {
+	Path           types.String `tfsdk:"path"`
+	Value          types.String `tfsdk:"value"`
+	ReferenceValue types.String `tfsdk:"reference_value"`
+	Status         types.String `tfsdk:"status"`
+}
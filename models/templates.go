@@ -48,6 +48,10 @@ type Template struct {
 	IdentityPoolID      types.Int64  `tfsdk:"identity_pool_id"`
 	Vlan                types.Object `tfsdk:"vlan"`
 	Content             types.String `tfsdk:"content"`
+	AttributeOverrides  types.Map    `tfsdk:"attribute_overrides"`
+	Sanitize            types.Bool   `tfsdk:"sanitize"`
+	SanitizeDenyList    types.Set    `tfsdk:"sanitize_deny_list"`
+	SanitizedAttributes types.Set    `tfsdk:"sanitized_attributes"`
 }
 
 // Attribute template attributes
@@ -92,10 +96,29 @@ type SubAttributeGroup struct {
 
 // OmeAttribute resembles the Attribute in the response of Get AttributeDetails call
 type OmeAttribute struct {
-	AttributeID int64  `json:"AttributeId"`
-	DisplayName string `json:"DisplayName"`
-	Value       string `json:"Value"`
-	IsIgnored   bool   `json:"IsIgnored"`
+	AttributeID         int64  `json:"AttributeId"`
+	AttributeEditInfoID int64  `json:"AttributeEditInfoId"`
+	DisplayName         string `json:"DisplayName"`
+	Value               string `json:"Value"`
+	IsIgnored           bool   `json:"IsIgnored"`
+	IsReadOnly          bool   `json:"IsReadOnly"`
+}
+
+// OMEAttributeEditInfo resembles the constraints on the value of a template attribute
+type OMEAttributeEditInfo struct {
+	ID             int64                       `json:"Id"`
+	DataType       string                      `json:"DataType"`
+	MinLength      *int64                      `json:"MinLength"`
+	MaxLength      *int64                      `json:"MaxLength"`
+	MinValue       *int64                      `json:"MinValue"`
+	MaxValue       *int64                      `json:"MaxValue"`
+	PossibleValues []OMEAttributePossibleValue `json:"PossibleValues"`
+}
+
+// OMEAttributePossibleValue resembles a possible value of an enumerated template attribute
+type OMEAttributePossibleValue struct {
+	Value        string `json:"Value"`
+	DisplayValue string `json:"DisplayValue"`
 }
 
 // CreateTemplate - payload to create a template
@@ -172,8 +195,13 @@ type OMETemplates struct {
 
 // IdentityPool holds the details of the IdentityPool
 type IdentityPool struct {
-	Name string `json:"Name"`
-	ID   int64  `json:"Id"`
+	Name             string                     `json:"Name"`
+	ID               int64                      `json:"Id,omitempty"`
+	Description      string                     `json:"Description,omitempty"`
+	EthernetSettings *IdentityPoolMacSettings   `json:"EthernetSettings,omitempty"`
+	IscsiSettings    *IdentityPoolIscsiSettings `json:"IscsiSettings,omitempty"`
+	FcoeSettings     *IdentityPoolMacSettings   `json:"FcoeSettings,omitempty"`
+	FcSettings       *IdentityPoolFcSettings    `json:"FcSettings,omitempty"`
 }
 
 // OMEIdentityPools is used to parse the output of Get IdentityPools
@@ -0,0 +1,63 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// Warranty - warranty entitlement of a device of the WarrantyService
+type Warranty struct {
+	ID                      int64  `json:"Id"`
+	DeviceID                int64  `json:"DeviceId"`
+	DeviceModel             string `json:"DeviceModel"`
+	DeviceIdentifier        string `json:"DeviceIdentifier"`
+	DeviceType              int64  `json:"DeviceType"`
+	CustomerNumber          string `json:"CustomerNumber"`
+	OrderNumber             string `json:"OrderNumber"`
+	SystemShipDate          string `json:"SystemShipDate"`
+	ServiceLevelCode        string `json:"ServiceLevelCode"`
+	ServiceLevelDescription string `json:"ServiceLevelDescription"`
+	ServiceProvider         string `json:"ServiceProvider"`
+	StartDate               string `json:"StartDate"`
+	EndDate                 string `json:"EndDate"`
+	DaysRemaining           int64  `json:"DaysRemaining"`
+}
+
+// OmeWarrantyData - schema for the warranty data source
+type OmeWarrantyData struct {
+	ID                 types.Int64   `tfsdk:"id"`
+	DeviceIDs          types.List    `tfsdk:"device_ids"`
+	DeviceServiceTags  types.List    `tfsdk:"device_service_tags"`
+	DeviceGroupNames   types.List    `tfsdk:"device_group_names"`
+	ExpiringWithinDays types.Int64   `tfsdk:"expiring_within_days"`
+	IncludeExpired     types.Bool    `tfsdk:"include_expired"`
+	MinDaysRemaining   types.Int64   `tfsdk:"min_days_remaining"`
+	Warranties         []OmeWarranty `tfsdk:"warranties"`
+}
+
+// OmeWarranty - schema for a warranty entitlement of the warranty data source
+type OmeWarranty struct {
+	ID                      types.Int64  `tfsdk:"id"`
+	DeviceID                types.Int64  `tfsdk:"device_id"`
+	ServiceTag              types.String `tfsdk:"service_tag"`
+	DeviceModel             types.String `tfsdk:"device_model"`
+	ServiceLevelCode        types.String `tfsdk:"service_level_code"`
+	ServiceLevelDescription types.String `tfsdk:"service_level_description"`
+	ServiceProvider         types.String `tfsdk:"service_provider"`
+	StartDate               types.String `tfsdk:"start_date"`
+	EndDate                 types.String `tfsdk:"end_date"`
+	DaysRemaining           types.Int64  `tfsdk:"days_remaining"`
+	Expired                 types.Bool   `tfsdk:"expired"`
+}
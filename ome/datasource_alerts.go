@@ -0,0 +1,97 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"terraform-provider-ome/helper"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &alertsDataSource{}
+	_ datasource.DataSourceWithConfigure = &alertsDataSource{}
+)
+
+// NewAlertsDataSource creates a new alerts data source.
+func NewAlertsDataSource() datasource.DataSource {
+	return &alertsDataSource{}
+}
+
+type alertsDataSource struct {
+	p *omeProvider
+}
+
+// Configure implements datasource.DataSourceWithConfigure
+func (g *alertsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	g.p = req.ProviderData.(*omeProvider)
+}
+
+// Metadata implements datasource.DataSource
+func (*alertsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "alerts"
+}
+
+// Schema implements datasource.DataSource
+func (*alertsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform DataSource is used to query the alerts from OME." +
+			" The alerts can be filtered by severity, status, category, device, group and time range," +
+			" and the alert ids can be passed to the `ome_alert_action` resource to acknowledge or clear them.",
+		Description: "This Terraform DataSource is used to query the alerts from OME." +
+			" The alerts can be filtered by severity, status, category, device, group and time range," +
+			" and the alert ids can be passed to the 'ome_alert_action' resource to acknowledge or clear them.",
+		Attributes: omeAlertsDataSchema(),
+	}
+}
+
+// Read implements datasource.DataSource
+func (g *alertsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Trace(ctx, "datasource_alerts read: started")
+	var plan models.OmeAlertsData
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	omeClient, d := g.p.createOMESession(ctx, "datasource_alerts Read")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	alerts, d := helper.GetAlerts(ctx, omeClient, plan.Filters)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ID.IsNull() {
+		plan.ID = types.Int64Value(0)
+	}
+	plan.Alerts = helper.NewOmeAlertList(alerts)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Trace(ctx, "datasource_alerts read: finished")
+}
@@ -0,0 +1,209 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// alertTimeRegex matches the time format of the alerts, yyyy-MM-dd HH:mm:ss.SSS
+var alertTimeRegex = regexp.MustCompile(`^\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}\.\d{3}$`)
+
+func omeAlertsDataSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			MarkdownDescription: "Dummy ID of the datasource.",
+			Description:         "Dummy ID of the datasource.",
+			Computed:            true,
+		},
+		"filters": schema.SingleNestedAttribute{
+			MarkdownDescription: "Filters to apply while fetching alerts. All the configured filters must match." +
+				" `device_ids`, `device_servicetags` and `group_ids` together select the devices of the alerts.",
+			Description: "Filters to apply while fetching alerts. All the configured filters must match." +
+				" 'device_ids', 'device_servicetags' and 'group_ids' together select the devices of the alerts.",
+			Optional: true,
+			Attributes: map[string]schema.Attribute{
+				"severities": schema.SetAttribute{
+					MarkdownDescription: "Severities of the alerts to fetch." +
+						" Supported values are `Unknown`, `Info`, `Normal`, `Warning` and `Critical`.",
+					Description: "Severities of the alerts to fetch." +
+						" Supported values are 'Unknown', 'Info', 'Normal', 'Warning' and 'Critical'.",
+					ElementType: types.StringType,
+					Optional:    true,
+					Validators: []validator.Set{
+						setvalidator.SizeAtLeast(1),
+						setvalidator.ValueStringsAre(
+							stringvalidator.OneOf("Unknown", "Info", "Normal", "Warning", "Critical"),
+						),
+					},
+				},
+				"acknowledged": schema.BoolAttribute{
+					MarkdownDescription: "Fetch only the acknowledged alerts if `true`, or only the alerts which are not acknowledged if `false`.",
+					Description:         "Fetch only the acknowledged alerts if 'true', or only the alerts which are not acknowledged if 'false'.",
+					Optional:            true,
+				},
+				"category_names": schema.SetAttribute{
+					MarkdownDescription: "Category names of the alerts to fetch, for example `System Health` or `Audit`.",
+					Description:         "Category names of the alerts to fetch, for example 'System Health' or 'Audit'.",
+					ElementType:         types.StringType,
+					Optional:            true,
+					Validators: []validator.Set{
+						setvalidator.SizeAtLeast(1),
+						setvalidator.ValueStringsAre(
+							stringvalidator.LengthAtLeast(1),
+						),
+					},
+				},
+				"device_ids": schema.SetAttribute{
+					MarkdownDescription: "IDs of the devices of the alerts to fetch.",
+					Description:         "IDs of the devices of the alerts to fetch.",
+					ElementType:         types.Int64Type,
+					Optional:            true,
+					Validators: []validator.Set{
+						setvalidator.SizeAtLeast(1),
+					},
+				},
+				"device_servicetags": schema.SetAttribute{
+					MarkdownDescription: "Service tags of the devices of the alerts to fetch.",
+					Description:         "Service tags of the devices of the alerts to fetch.",
+					ElementType:         types.StringType,
+					Optional:            true,
+					Validators: []validator.Set{
+						setvalidator.SizeAtLeast(1),
+						setvalidator.ValueStringsAre(
+							stringvalidator.LengthAtLeast(1),
+						),
+					},
+				},
+				"group_ids": schema.SetAttribute{
+					MarkdownDescription: "IDs of the device groups whose device alerts to fetch.",
+					Description:         "IDs of the device groups whose device alerts to fetch.",
+					ElementType:         types.Int64Type,
+					Optional:            true,
+					Validators: []validator.Set{
+						setvalidator.SizeAtLeast(1),
+					},
+				},
+				"start_time": schema.StringAttribute{
+					MarkdownDescription: "Fetch the alerts raised at or after this time, in the format `yyyy-MM-dd HH:mm:ss.SSS`.",
+					Description:         "Fetch the alerts raised at or after this time, in the format 'yyyy-MM-dd HH:mm:ss.SSS'.",
+					Optional:            true,
+					Validators: []validator.String{
+						stringvalidator.RegexMatches(alertTimeRegex, "must be in the format yyyy-MM-dd HH:mm:ss.SSS"),
+					},
+				},
+				"end_time": schema.StringAttribute{
+					MarkdownDescription: "Fetch the alerts raised at or before this time, in the format `yyyy-MM-dd HH:mm:ss.SSS`.",
+					Description:         "Fetch the alerts raised at or before this time, in the format 'yyyy-MM-dd HH:mm:ss.SSS'.",
+					Optional:            true,
+					Validators: []validator.String{
+						stringvalidator.RegexMatches(alertTimeRegex, "must be in the format yyyy-MM-dd HH:mm:ss.SSS"),
+					},
+				},
+			},
+		},
+		"alerts": schema.ListNestedAttribute{
+			MarkdownDescription: "Alerts fetched.",
+			Description:         "Alerts fetched.",
+			Computed:            true,
+			NestedObject:        schema.NestedAttributeObject{Attributes: omeSingleAlertDataSchema()},
+		},
+	}
+}
+
+func omeSingleAlertDataSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the alert.",
+			Description:         "ID of the alert.",
+			Computed:            true,
+		},
+		"severity": schema.StringAttribute{
+			MarkdownDescription: "Severity of the alert.",
+			Description:         "Severity of the alert.",
+			Computed:            true,
+		},
+		"device_id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the device which raised the alert.",
+			Description:         "ID of the device which raised the alert.",
+			Computed:            true,
+		},
+		"device_name": schema.StringAttribute{
+			MarkdownDescription: "Name of the device which raised the alert.",
+			Description:         "Name of the device which raised the alert.",
+			Computed:            true,
+		},
+		"device_identifier": schema.StringAttribute{
+			MarkdownDescription: "Identifier of the device which raised the alert, the service tag for servers.",
+			Description:         "Identifier of the device which raised the alert, the service tag for servers.",
+			Computed:            true,
+		},
+		"device_ip": schema.StringAttribute{
+			MarkdownDescription: "IP address of the device which raised the alert.",
+			Description:         "IP address of the device which raised the alert.",
+			Computed:            true,
+		},
+		"catalog_name": schema.StringAttribute{
+			MarkdownDescription: "Message catalog of the alert.",
+			Description:         "Message catalog of the alert.",
+			Computed:            true,
+		},
+		"category_name": schema.StringAttribute{
+			MarkdownDescription: "Category of the alert.",
+			Description:         "Category of the alert.",
+			Computed:            true,
+		},
+		"sub_category_name": schema.StringAttribute{
+			MarkdownDescription: "Sub category of the alert.",
+			Description:         "Sub category of the alert.",
+			Computed:            true,
+		},
+		"acknowledged": schema.BoolAttribute{
+			MarkdownDescription: "Whether the alert is acknowledged.",
+			Description:         "Whether the alert is acknowledged.",
+			Computed:            true,
+		},
+		"status": schema.StringAttribute{
+			MarkdownDescription: "Status of the alert.",
+			Description:         "Status of the alert.",
+			Computed:            true,
+		},
+		"time_stamp": schema.StringAttribute{
+			MarkdownDescription: "Time at which the alert was raised.",
+			Description:         "Time at which the alert was raised.",
+			Computed:            true,
+		},
+		"message": schema.StringAttribute{
+			MarkdownDescription: "Message of the alert.",
+			Description:         "Message of the alert.",
+			Computed:            true,
+		},
+		"message_id": schema.StringAttribute{
+			MarkdownDescription: "Message ID of the alert.",
+			Description:         "Message ID of the alert.",
+			Computed:            true,
+		},
+		"recommended_action": schema.StringAttribute{
+			MarkdownDescription: "Recommended action to resolve the alert.",
+			Description:         "Recommended action to resolve the alert.",
+			Computed:            true,
+		},
+	}
+}
@@ -0,0 +1,230 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestDataSource_AlertsRead(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// No filter so should return all the alerts
+			{
+				Config: testAlertsAll + alertsOutputs,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("fetched_any", "true"),
+				),
+			},
+			// Filter by severity, status and device
+			{
+				Config: testAlertsFilter + alertsOutputs,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("all_match", "true"),
+				),
+			},
+			// Time range which matches no alert
+			{
+				Config: testAlertsNoMatch + alertsOutputs,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("fetched_any", "false"),
+				),
+			},
+			// Invalid time format
+			{
+				Config:      testAlertsInvalidTime,
+				ExpectError: regexp.MustCompile(`.*must be in the format yyyy-MM-dd HH:mm:ss.SSS.*`),
+			},
+			// Filter by the devices of a group
+			{
+				Config: testAlertsGroup,
+			},
+		},
+	})
+}
+
+func TestDataSource_AlertsFilters(t *testing.T) {
+	var queryParams map[string]string
+	alerts := []models.Alert{
+		{ID: 1, SeverityType: 16, AlertDeviceID: 10001, StatusType: 2000, CategoryName: "System Health", TimeStamp: "2025-01-01 10:00:00.000"},
+		{ID: 2, SeverityType: 8, AlertDeviceID: 10001, StatusType: 2000, CategoryName: "System Health", TimeStamp: "2025-01-01 11:00:00.000"},
+		{ID: 3, SeverityType: 2, AlertDeviceID: 10001, StatusType: 2000, CategoryName: "System Health", TimeStamp: "2025-01-01 12:00:00.000"},
+		{ID: 4, SeverityType: 16, AlertDeviceID: 10001, StatusType: 1000, CategoryName: "System Health", TimeStamp: "2025-01-01 13:00:00.000"},
+		{ID: 5, SeverityType: 16, AlertDeviceID: 10002, StatusType: 2000, CategoryName: "System Health", TimeStamp: "2025-01-01 14:00:00.000"},
+		{ID: 6, SeverityType: 2, AlertDeviceID: 10003, StatusType: 1000, CategoryName: "Audit", TimeStamp: "2025-01-01 15:00:00.000"},
+		{ID: 7, SeverityType: 16, AlertDeviceID: 10002, StatusType: 2000, CategoryName: "Storage", TimeStamp: "2025-01-01 16:00:00.000"},
+		{ID: 8, SeverityType: 16, AlertDeviceID: 10004, AlertDeviceIdentifier: "O'TAG", StatusType: 2000, CategoryName: "Vendor's", TimeStamp: "2025-01-01 17:00:00.000"},
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// the single value filters are sent to the appliance and every alert is checked again against all the filters
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock((*clients.Client).GetAlerts).To(func(_ *clients.Client, params map[string]string) ([]models.Alert, error) {
+						queryParams = params
+						return alerts, nil
+					}).Build()
+				},
+				Config: testAlertsMocked,
+				Check: resource.ComposeTestCheckFunc(
+					func(*terraform.State) error {
+						want := "StatusType eq 2000 and AlertDeviceId eq 10001"
+						if queryParams["$filter"] != want {
+							return fmt.Errorf("expected the filter %s, got %s", want, queryParams["$filter"])
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr("data.ome_alerts.alerts", "alerts.#", "2"),
+					resource.TestCheckResourceAttr("data.ome_alerts.alerts", "alerts.0.id", "1"),
+					resource.TestCheckResourceAttr("data.ome_alerts.alerts", "alerts.1.id", "2"),
+				),
+			},
+			// the quotes of the values are escaped in the filter of the appliance
+			{
+				Config: testAlertsMockedQuotes,
+				Check: resource.ComposeTestCheckFunc(
+					func(*terraform.State) error {
+						want := "CategoryName eq 'Vendor''s' and AlertDeviceIdentifier eq 'O''TAG'"
+						if queryParams["$filter"] != want {
+							return fmt.Errorf("expected the filter %s, got %s", want, queryParams["$filter"])
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr("data.ome_alerts.alerts", "alerts.#", "1"),
+					resource.TestCheckResourceAttr("data.ome_alerts.alerts", "alerts.0.id", "8"),
+				),
+			},
+			// the devices of the groups and the several categories are only filtered by the provider
+			{
+				PreConfig: func() {
+					localMocker = Mock((*clients.Client).GetDevicesByGroupID).To(func(_ *clients.Client, groupID int64) (models.Devices, error) {
+						if groupID != 500 {
+							return models.Devices{}, fmt.Errorf("unexpected group %d", groupID)
+						}
+						return models.Devices{Value: []models.Device{{ID: 10002}, {ID: 10003}}}, nil
+					}).Build()
+				},
+				Config: testAlertsMockedGroup,
+				Check: resource.ComposeTestCheckFunc(
+					func(*terraform.State) error {
+						FunctionMocker.UnPatch()
+						localMocker.UnPatch()
+						if queryParams != nil {
+							return fmt.Errorf("expected no filter, got %v", queryParams)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr("data.ome_alerts.alerts", "alerts.#", "2"),
+					resource.TestCheckResourceAttr("data.ome_alerts.alerts", "alerts.0.id", "5"),
+					resource.TestCheckResourceAttr("data.ome_alerts.alerts", "alerts.1.id", "6"),
+				),
+			},
+		},
+	})
+}
+
+var testAlertsMocked = testProvider + `
+data "ome_alerts" "alerts" {
+	filters = {
+		severities   = ["Critical", "Warning"]
+		acknowledged = false
+		device_ids   = [10001]
+	}
+}
+`
+
+var testAlertsMockedQuotes = testProvider + `
+data "ome_alerts" "alerts" {
+	filters = {
+		category_names     = ["Vendor's"]
+		device_servicetags = ["O'TAG"]
+	}
+}
+`
+
+var testAlertsMockedGroup = testProvider + `
+data "ome_alerts" "alerts" {
+	filters = {
+		group_ids      = [500]
+		category_names = ["System Health", "Audit"]
+	}
+}
+`
+
+var alertsOutputs = `
+output "fetched_any" {
+	value = length(data.ome_alerts.alerts.alerts) != 0
+}
+
+output "all_match" {
+	value = alltrue([for a in data.ome_alerts.alerts.alerts : a.severity == "Critical" && !a.acknowledged])
+}
+`
+
+var testAlertsAll = testProvider + `
+data "ome_alerts" "alerts" {
+}
+`
+
+var testAlertsFilter = testProvider + `
+data "ome_alerts" "alerts" {
+	filters = {
+		severities         = ["Critical"]
+		acknowledged       = false
+		device_servicetags = ["` + DeviceSvcTag1 + `"]
+	}
+}
+`
+
+var testAlertsNoMatch = testProvider + `
+data "ome_alerts" "alerts" {
+	filters = {
+		start_time = "2000-01-01 00:00:00.000"
+		end_time   = "2000-01-01 00:00:01.000"
+	}
+}
+`
+
+var testAlertsInvalidTime = testProvider + `
+data "ome_alerts" "alerts" {
+	filters = {
+		start_time = "2025-01-01"
+	}
+}
+`
+
+var testAlertsGroup = testProvider + `
+data "ome_groupdevices_info" "servers" {
+	device_group_names = ["Servers"]
+}
+
+data "ome_alerts" "alerts" {
+	filters = {
+		group_ids      = [data.ome_groupdevices_info.servers.device_groups["Servers"].id]
+		category_names = ["System Health", "Audit"]
+	}
+}
+`
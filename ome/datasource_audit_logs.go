@@ -0,0 +1,96 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/helper"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &auditLogsDataSource{}
+	_ datasource.DataSourceWithConfigure = &auditLogsDataSource{}
+)
+
+// NewAuditLogsDataSource creates a new audit logs data source.
+func NewAuditLogsDataSource() datasource.DataSource {
+	return &auditLogsDataSource{}
+}
+
+type auditLogsDataSource struct {
+	p *omeProvider
+}
+
+// Configure implements datasource.DataSourceWithConfigure
+func (g *auditLogsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	g.p = req.ProviderData.(*omeProvider)
+}
+
+// Metadata implements datasource.DataSource
+func (*auditLogsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "audit_logs"
+}
+
+// Schema implements datasource.DataSource
+func (*auditLogsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform DataSource is used to query the audit logs of OME." +
+			" The audit logs can be filtered by user, source IP, category, severity and time range, the filters are applied by the OME appliance.",
+		Description: "This Terraform DataSource is used to query the audit logs of OME." +
+			" The audit logs can be filtered by user, source IP, category, severity and time range, the filters are applied by the OME appliance.",
+		Attributes: omeAuditLogsDataSchema(),
+	}
+}
+
+// Read implements datasource.DataSource
+func (g *auditLogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Trace(ctx, "datasource_audit_logs read: started")
+	var plan models.OmeAuditLogsData
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	omeClient, d := g.p.createOMESession(ctx, "datasource_audit_logs Read")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	logs, err := helper.GetAuditLogs(omeClient, plan.Filters)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrReadAuditLogs, err.Error())
+		return
+	}
+
+	if plan.ID.IsNull() {
+		plan.ID = types.Int64Value(0)
+	}
+	plan.AuditLogs = helper.NewOmeAuditLogList(logs)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Trace(ctx, "datasource_audit_logs read: finished")
+}
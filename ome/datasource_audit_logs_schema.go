@@ -0,0 +1,139 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func omeAuditLogsDataSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			MarkdownDescription: "Dummy ID of the datasource.",
+			Description:         "Dummy ID of the datasource.",
+			Computed:            true,
+		},
+		"filters": schema.SingleNestedAttribute{
+			MarkdownDescription: "Filters to apply while fetching audit logs. All the configured filters must match.",
+			Description:         "Filters to apply while fetching audit logs. All the configured filters must match.",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"user_name": schema.StringAttribute{
+					MarkdownDescription: "Name of the user who performed the operations.",
+					Description:         "Name of the user who performed the operations.",
+					Optional:            true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+				"source_ip": schema.StringAttribute{
+					MarkdownDescription: "IP address from which the operations were performed.",
+					Description:         "IP address from which the operations were performed.",
+					Optional:            true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+				"category": schema.StringAttribute{
+					MarkdownDescription: "Category of the audit logs, for example `Audit` or `Configuration`.",
+					Description:         "Category of the audit logs, for example 'Audit' or 'Configuration'.",
+					Optional:            true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+				"severity": schema.StringAttribute{
+					MarkdownDescription: "Severity of the audit logs. Supported values are `Info`, `Warning` and `Critical`.",
+					Description:         "Severity of the audit logs. Supported values are 'Info', 'Warning' and 'Critical'.",
+					Optional:            true,
+					Validators: []validator.String{
+						stringvalidator.OneOf("Info", "Warning", "Critical"),
+					},
+				},
+				"start_time": schema.StringAttribute{
+					MarkdownDescription: "Fetch the audit logs created at or after this time, in the format `yyyy-MM-dd HH:mm:ss.SSS`.",
+					Description:         "Fetch the audit logs created at or after this time, in the format 'yyyy-MM-dd HH:mm:ss.SSS'.",
+					Optional:            true,
+					Validators: []validator.String{
+						stringvalidator.RegexMatches(alertTimeRegex, "must be in the format yyyy-MM-dd HH:mm:ss.SSS"),
+					},
+				},
+				"end_time": schema.StringAttribute{
+					MarkdownDescription: "Fetch the audit logs created at or before this time, in the format `yyyy-MM-dd HH:mm:ss.SSS`.",
+					Description:         "Fetch the audit logs created at or before this time, in the format 'yyyy-MM-dd HH:mm:ss.SSS'.",
+					Optional:            true,
+					Validators: []validator.String{
+						stringvalidator.RegexMatches(alertTimeRegex, "must be in the format yyyy-MM-dd HH:mm:ss.SSS"),
+					},
+				},
+			},
+		},
+		"audit_logs": schema.ListNestedAttribute{
+			MarkdownDescription: "Audit logs fetched.",
+			Description:         "Audit logs fetched.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.Int64Attribute{
+						MarkdownDescription: "ID of the audit log.",
+						Description:         "ID of the audit log.",
+						Computed:            true,
+					},
+					"severity": schema.StringAttribute{
+						MarkdownDescription: "Severity of the audit log.",
+						Description:         "Severity of the audit log.",
+						Computed:            true,
+					},
+					"message": schema.StringAttribute{
+						MarkdownDescription: "Message of the audit log.",
+						Description:         "Message of the audit log.",
+						Computed:            true,
+					},
+					"message_id": schema.StringAttribute{
+						MarkdownDescription: "Message ID of the audit log.",
+						Description:         "Message ID of the audit log.",
+						Computed:            true,
+					},
+					"message_args": schema.StringAttribute{
+						MarkdownDescription: "Arguments of the message of the audit log.",
+						Description:         "Arguments of the message of the audit log.",
+						Computed:            true,
+					},
+					"category": schema.StringAttribute{
+						MarkdownDescription: "Category of the audit log.",
+						Description:         "Category of the audit log.",
+						Computed:            true,
+					},
+					"user_name": schema.StringAttribute{
+						MarkdownDescription: "Name of the user who performed the operation.",
+						Description:         "Name of the user who performed the operation.",
+						Computed:            true,
+					},
+					"source_ip": schema.StringAttribute{
+						MarkdownDescription: "IP address from which the operation was performed.",
+						Description:         "IP address from which the operation was performed.",
+						Computed:            true,
+					},
+					"created_date": schema.StringAttribute{
+						MarkdownDescription: "Time at which the audit log was created.",
+						Description:         "Time at which the audit log was created.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
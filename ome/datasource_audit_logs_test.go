@@ -0,0 +1,176 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestDataSource_AuditLogsRead(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// No filter so should return all the audit logs
+			{
+				Config: testAuditLogsAll + auditLogsOutputs,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("fetched_any", "true"),
+				),
+			},
+			// Filter by the user of the provider, which has at least logged in
+			{
+				Config: testAuditLogsFilter + auditLogsOutputs,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("fetched_any", "true"),
+					resource.TestCheckOutput("all_match", "true"),
+				),
+			},
+			// Time range which matches no audit log
+			{
+				Config: testAuditLogsNoMatch + auditLogsOutputs,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("fetched_any", "false"),
+				),
+			},
+			{
+				Config:      testAuditLogsInvalidSeverity,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Value Match.*`),
+			},
+		},
+	})
+}
+
+func TestDataSource_AuditLogsFilters(t *testing.T) {
+	var queryParams map[string]string
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// all the filters are sent to the appliance and the severities are mapped to their names
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock((*clients.Client).GetAuditLogs).To(func(_ *clients.Client, params map[string]string) ([]models.AuditLog, error) {
+						queryParams = params
+						return []models.AuditLog{
+							{ID: 1, Severity: "2000", Category: "Audit", UserName: "admin", IPAddress: "192.0.2.1"},
+							{ID: 2, Severity: "4000", Category: "Audit", UserName: "admin", IPAddress: "192.0.2.1"},
+						}, nil
+					}).Build()
+				},
+				Config: testAuditLogsAllFilters,
+				Check: resource.ComposeTestCheckFunc(
+					func(*terraform.State) error {
+						want := "UserName eq 'admin' and IpAddress eq '192.0.2.1' and Category eq 'Audit' and Severity eq '2000'" +
+							" and CreatedDate ge '2025-01-01 00:00:00.000' and CreatedDate le '2025-01-02 00:00:00.000'"
+						if queryParams["$filter"] != want {
+							return fmt.Errorf("expected the filter %s, got %s", want, queryParams["$filter"])
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr("data.ome_audit_logs.logs", "audit_logs.#", "2"),
+					resource.TestCheckResourceAttr("data.ome_audit_logs.logs", "audit_logs.0.severity", "Warning"),
+					resource.TestCheckResourceAttr("data.ome_audit_logs.logs", "audit_logs.0.source_ip", "192.0.2.1"),
+					resource.TestCheckResourceAttr("data.ome_audit_logs.logs", "audit_logs.1.severity", "4000"),
+				),
+			},
+			// the quotes of the values are escaped in the filter of the appliance
+			{
+				Config: testAuditLogsQuotes,
+				Check: resource.ComposeTestCheckFunc(
+					func(*terraform.State) error {
+						FunctionMocker.UnPatch()
+						want := "UserName eq 'o''brien' and Category eq 'Audit'' or ''1'' eq ''1'"
+						if queryParams["$filter"] != want {
+							return fmt.Errorf("expected the filter %s, got %s", want, queryParams["$filter"])
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+var testAuditLogsAllFilters = testProvider + `
+data "ome_audit_logs" "logs" {
+	filters = {
+		user_name  = "admin"
+		source_ip  = "192.0.2.1"
+		category   = "Audit"
+		severity   = "Warning"
+		start_time = "2025-01-01 00:00:00.000"
+		end_time   = "2025-01-02 00:00:00.000"
+	}
+}
+`
+
+var testAuditLogsQuotes = testProvider + `
+data "ome_audit_logs" "logs" {
+	filters = {
+		user_name = "o'brien"
+		category  = "Audit' or '1' eq '1"
+	}
+}
+`
+
+var auditLogsOutputs = `
+output "fetched_any" {
+	value = length(data.ome_audit_logs.logs.audit_logs) != 0
+}
+
+output "all_match" {
+	value = alltrue([for l in data.ome_audit_logs.logs.audit_logs : l.user_name == "` + omeUserName + `"])
+}
+`
+
+var testAuditLogsAll = testProvider + `
+data "ome_audit_logs" "logs" {
+}
+`
+
+var testAuditLogsFilter = testProvider + `
+data "ome_audit_logs" "logs" {
+	filters = {
+		user_name  = "` + omeUserName + `"
+		category   = "Audit"
+		start_time = "2000-01-01 00:00:00.000"
+	}
+}
+`
+
+var testAuditLogsNoMatch = testProvider + `
+data "ome_audit_logs" "logs" {
+	filters = {
+		start_time = "2000-01-01 00:00:00.000"
+		end_time   = "2000-01-01 00:00:01.000"
+	}
+}
+`
+
+var testAuditLogsInvalidSeverity = testProvider + `
+data "ome_audit_logs" "logs" {
+	filters = {
+		severity = "Fatal"
+	}
+}
+`
@@ -0,0 +1,104 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/helper"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &chassisTopologyDataSource{}
+	_ datasource.DataSourceWithConfigure = &chassisTopologyDataSource{}
+)
+
+// NewChassisTopologyDataSource creates a new chassis topology data source.
+func NewChassisTopologyDataSource() datasource.DataSource {
+	return &chassisTopologyDataSource{}
+}
+
+type chassisTopologyDataSource struct {
+	p *omeProvider
+}
+
+// Configure implements datasource.DataSourceWithConfigure
+func (g *chassisTopologyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	g.p = req.ProviderData.(*omeProvider)
+}
+
+// Metadata implements datasource.DataSource
+func (*chassisTopologyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "chassis_topology"
+}
+
+// Schema implements datasource.DataSource
+func (*chassisTopologyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform DataSource is used to query the topology of the modular chassis, like the PowerEdge MX7000, from OME." +
+			" It returns the compute sleds, storage sleds and IOMs in the slots of each chassis," +
+			" and the role of each chassis in the multi-chassis management group.",
+		Description: "This Terraform DataSource is used to query the topology of the modular chassis, like the PowerEdge MX7000, from OME." +
+			" It returns the compute sleds, storage sleds and IOMs in the slots of each chassis," +
+			" and the role of each chassis in the multi-chassis management group.",
+		Attributes: omeChassisTopologyDataSchema(),
+	}
+}
+
+// Read implements datasource.DataSource
+func (g *chassisTopologyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Trace(ctx, "datasource_chassis_topology read: started")
+	var plan models.OmeChassisTopologyData
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var serviceTags []string
+	resp.Diagnostics.Append(plan.ChassisServiceTags.ElementsAs(ctx, &serviceTags, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	omeClient, d := g.p.createOMESession(ctx, "datasource_chassis_topology Read")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	chassis, devices, domains, err := helper.GetChassisTopology(omeClient, serviceTags)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrReadChassisTopology, err.Error())
+		return
+	}
+
+	if plan.ID.IsNull() {
+		plan.ID = types.Int64Value(0)
+	}
+	plan = helper.NewChassisTopologyState(chassis, devices, domains, plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Trace(ctx, "datasource_chassis_topology read: finished")
+}
@@ -0,0 +1,132 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func omeChassisTopologyDataSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			MarkdownDescription: "Dummy ID of the datasource.",
+			Description:         "Dummy ID of the datasource.",
+			Computed:            true,
+		},
+		"chassis_service_tags": schema.ListAttribute{
+			MarkdownDescription: "Service tags of the chassis. When not configured, the topology of all the chassis is read.",
+			Description:         "Service tags of the chassis. When not configured, the topology of all the chassis is read.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.UniqueValues(),
+				listvalidator.ValueStringsAre(
+					stringvalidator.LengthAtLeast(1),
+				),
+			},
+		},
+		"mcm_lead_service_tag": schema.StringAttribute{
+			MarkdownDescription: "Service tag of the lead chassis of the multi-chassis management group, null when there is no group.",
+			Description:         "Service tag of the lead chassis of the multi-chassis management group, null when there is no group.",
+			Computed:            true,
+		},
+		"chassis": schema.ListNestedAttribute{
+			MarkdownDescription: "Chassis with their occupied slots.",
+			Description:         "Chassis with their occupied slots.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: omeChassisTopologySchema(),
+			},
+		},
+	}
+}
+
+func omeChassisTopologySchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the chassis.",
+			Description:         "ID of the chassis.",
+			Computed:            true,
+		},
+		"service_tag": schema.StringAttribute{
+			MarkdownDescription: "Service tag of the chassis.",
+			Description:         "Service tag of the chassis.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the chassis.",
+			Description:         "Name of the chassis.",
+			Computed:            true,
+		},
+		"model": schema.StringAttribute{
+			MarkdownDescription: "Model of the chassis.",
+			Description:         "Model of the chassis.",
+			Computed:            true,
+		},
+		"mcm_role": schema.StringAttribute{
+			MarkdownDescription: "Role of the chassis in the multi-chassis management group, one of `lead`, `backup_lead`, `member` and `standalone`.",
+			Description:         "Role of the chassis in the multi-chassis management group, one of 'lead', 'backup_lead', 'member' and 'standalone'.",
+			Computed:            true,
+		},
+		"slots": schema.ListNestedAttribute{
+			MarkdownDescription: "Occupied slots of the chassis, ordered by slot type and slot number. The slots which are not listed are empty.",
+			Description:         "Occupied slots of the chassis, ordered by slot type and slot number. The slots which are not listed are empty.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"number": schema.Int64Attribute{
+						MarkdownDescription: "Number of the slot.",
+						Description:         "Number of the slot.",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Name of the slot.",
+						Description:         "Name of the slot.",
+						Computed:            true,
+					},
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Type of the device in the slot, one of `compute`, `storage`, `network_iom`, `storage_iom` and `other`.",
+						Description:         "Type of the device in the slot, one of 'compute', 'storage', 'network_iom', 'storage_iom' and 'other'.",
+						Computed:            true,
+					},
+					"device_id": schema.Int64Attribute{
+						MarkdownDescription: "ID of the device in the slot.",
+						Description:         "ID of the device in the slot.",
+						Computed:            true,
+					},
+					"service_tag": schema.StringAttribute{
+						MarkdownDescription: "Service tag of the device in the slot.",
+						Description:         "Service tag of the device in the slot.",
+						Computed:            true,
+					},
+					"device_name": schema.StringAttribute{
+						MarkdownDescription: "Name of the device in the slot.",
+						Description:         "Name of the device in the slot.",
+						Computed:            true,
+					},
+					"model": schema.StringAttribute{
+						MarkdownDescription: "Model of the device in the slot, for example the model of the IOM.",
+						Description:         "Model of the device in the slot, for example the model of the IOM.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,161 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"regexp"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestDataSource_ChassisTopologyRead(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testChassisTopologyAll,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ome_chassis_topology.topology", "chassis.#"),
+				),
+			},
+			{
+				SkipFunc: func() (bool, error) {
+					if ChassisSvcTag1 == "" {
+						t.Log("Skipping as CHASSISSVCTAG1 is not set")
+						return true, nil
+					}
+					return false, nil
+				},
+				Config: testChassisTopologyByServiceTag,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ome_chassis_topology.topology", "chassis.#", "1"),
+					resource.TestCheckResourceAttr("data.ome_chassis_topology.topology", "chassis.0.service_tag", ChassisSvcTag1),
+					resource.TestCheckResourceAttrSet("data.ome_chassis_topology.topology", "chassis.0.mcm_role"),
+					resource.TestCheckOutput("has_sleds", "true"),
+				),
+			},
+			{
+				Config:      testChassisTopologyInvalid,
+				ExpectError: regexp.MustCompile(`.*error reading chassis topology.*`),
+			},
+		},
+	})
+}
+
+func TestDataSource_ChassisTopologySlots(t *testing.T) {
+	devices := models.Devices{Value: []models.Device{
+		{ID: 100, Type: 2000, DeviceServiceTag: "CHASA"},
+		{ID: 101, Type: 1000, DeviceServiceTag: "SLED2", SlotConfiguration: models.SlotConfiguration{ChassisID: "100", SlotNumber: "2"}},
+		// a sled only referencing its chassis by service tag
+		{ID: 102, Type: 1000, DeviceServiceTag: "SLED1", SlotConfiguration: models.SlotConfiguration{ChassisServiceTag: "CHASA", SlotNumber: "1"}},
+		{ID: 103, Type: 4000, DeviceServiceTag: "IOM1", SlotConfiguration: models.SlotConfiguration{ChassisID: "100", SlotNumber: "1"}},
+		{ID: 200, Type: 2000, DeviceServiceTag: "CHASB"},
+		{ID: 201, Type: 3000, DeviceServiceTag: "STOR1", SlotConfiguration: models.SlotConfiguration{ChassisID: "200", SlotNumber: "3"}},
+		// a rack server outside of any chassis
+		{ID: 300, Type: 1000, DeviceServiceTag: "RACK1"},
+	}}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// the slots are ordered by type then number, the roles of the domains are case insensitive
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock((*clients.Client).GetAllDevices).Return(devices, nil).Build()
+					localMocker = Mock((*clients.Client).GetManagementDomains).Return([]models.ManagementDomain{
+						{DeviceID: 100, Identifier: "CHASA", DomainRoleTypeValue: "LEAD"},
+						{DeviceID: 200, Identifier: "CHASB", DomainRoleTypeValue: "Member"},
+					}, nil).Build()
+				},
+				Config: testChassisTopologyAll,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ome_chassis_topology.topology", "mcm_lead_service_tag", "CHASA"),
+					resource.TestCheckResourceAttr("data.ome_chassis_topology.topology", "chassis.#", "2"),
+					resource.TestCheckResourceAttr("data.ome_chassis_topology.topology", "chassis.0.mcm_role", "lead"),
+					resource.TestCheckResourceAttr("data.ome_chassis_topology.topology", "chassis.0.slots.#", "3"),
+					resource.TestCheckResourceAttr("data.ome_chassis_topology.topology", "chassis.0.slots.0.service_tag", "SLED1"),
+					resource.TestCheckResourceAttr("data.ome_chassis_topology.topology", "chassis.0.slots.1.service_tag", "SLED2"),
+					resource.TestCheckResourceAttr("data.ome_chassis_topology.topology", "chassis.0.slots.2.service_tag", "IOM1"),
+					resource.TestCheckResourceAttr("data.ome_chassis_topology.topology", "chassis.0.slots.2.type", "network_iom"),
+					resource.TestCheckResourceAttr("data.ome_chassis_topology.topology", "chassis.1.mcm_role", "member"),
+					resource.TestCheckResourceAttr("data.ome_chassis_topology.topology", "chassis.1.slots.#", "1"),
+					resource.TestCheckResourceAttr("data.ome_chassis_topology.topology", "chassis.1.slots.0.type", "storage"),
+					resource.TestCheckResourceAttr("data.ome_chassis_topology.topology", "chassis.1.slots.0.number", "3"),
+				),
+			},
+			{
+				Config:      testChassisTopologyMissing,
+				ExpectError: regexp.MustCompile(`.*invalid chassis service tags: \[NOPE\].*`),
+			},
+			// a chassis outside of a multi-chassis management group is standalone
+			{
+				PreConfig: func() {
+					localMocker.UnPatch()
+					localMocker = Mock((*clients.Client).GetManagementDomains).Return([]models.ManagementDomain{}, nil).Build()
+				},
+				Config: testChassisTopologyCHASB,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("data.ome_chassis_topology.topology", "mcm_lead_service_tag"),
+					resource.TestCheckResourceAttr("data.ome_chassis_topology.topology", "chassis.#", "1"),
+					resource.TestCheckResourceAttr("data.ome_chassis_topology.topology", "chassis.0.service_tag", "CHASB"),
+					resource.TestCheckResourceAttr("data.ome_chassis_topology.topology", "chassis.0.mcm_role", "standalone"),
+					func(_ *terraform.State) error {
+						FunctionMocker.UnPatch()
+						localMocker.UnPatch()
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+var testChassisTopologyAll = testProvider + `
+data "ome_chassis_topology" "topology" {
+}
+`
+
+var testChassisTopologyByServiceTag = testProvider + `
+data "ome_chassis_topology" "topology" {
+	chassis_service_tags = ["` + ChassisSvcTag1 + `"]
+}
+
+output "has_sleds" {
+	value = length([for slot in data.ome_chassis_topology.topology.chassis[0].slots : slot if slot.type == "compute"]) != 0
+}
+`
+
+var testChassisTopologyInvalid = testProvider + `
+data "ome_chassis_topology" "topology" {
+	chassis_service_tags = ["invalid-tag"]
+}
+`
+
+var testChassisTopologyMissing = testProvider + `
+data "ome_chassis_topology" "topology" {
+	chassis_service_tags = ["CHASB", "NOPE"]
+}
+`
+
+var testChassisTopologyCHASB = testProvider + `
+data "ome_chassis_topology" "topology" {
+	chassis_service_tags = ["CHASB"]
+}
+`
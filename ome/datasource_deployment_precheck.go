@@ -0,0 +1,169 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/helper"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &deploymentPrecheckDataSource{}
+	_ datasource.DataSourceWithConfigure = &deploymentPrecheckDataSource{}
+)
+
+// NewDeploymentPrecheckDataSource creates a new deployment precheck data source.
+func NewDeploymentPrecheckDataSource() datasource.DataSource {
+	return &deploymentPrecheckDataSource{}
+}
+
+type deploymentPrecheckDataSource struct {
+	p *omeProvider
+}
+
+// Configure implements datasource.DataSourceWithConfigure
+func (g *deploymentPrecheckDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	g.p = req.ProviderData.(*omeProvider)
+}
+
+// Metadata implements datasource.DataSource
+func (*deploymentPrecheckDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "deployment_precheck"
+}
+
+// Schema implements datasource.DataSource
+func (*deploymentPrecheckDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform DataSource is used to precheck the deployment of a template or the remediation of a configuration baseline on OME," +
+			" and to review the findings of each device before the change is approved." +
+			" A deployment is prechecked by a precheck only deployment job, which runs each time the data source is read." +
+			" OME has no precheck job for a remediation, its findings are the non compliant attributes of the last compliance check of the baseline," +
+			" which the data source does not run again: run the compliance check of the baseline first, its time is exposed by `compliance_checked_at`.",
+		Description: "This Terraform DataSource is used to precheck the deployment of a template or the remediation of a configuration baseline on OME," +
+			" and to review the findings of each device before the change is approved." +
+			" A deployment is prechecked by a precheck only deployment job, which runs each time the data source is read." +
+			" OME has no precheck job for a remediation, its findings are the non compliant attributes of the last compliance check of the baseline," +
+			" which the data source does not run again: run the compliance check of the baseline first, its time is exposed by 'compliance_checked_at'.",
+		Attributes: omeDeploymentPrecheckDataSchema(),
+	}
+}
+
+// Read implements datasource.DataSource
+func (g *deploymentPrecheckDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Trace(ctx, "datasource_deployment_precheck read: started")
+	var plan models.OmeDeploymentPrecheck
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.JobRetryCount.IsNull() {
+		plan.JobRetryCount = types.Int64Value(RetryCount)
+	}
+	if plan.SleepInterval.IsNull() {
+		plan.SleepInterval = types.Int64Value(SleepInterval)
+	}
+
+	serviceTags := []string{}
+	resp.Diagnostics.Append(plan.DeviceServiceTags.ElementsAs(ctx, &serviceTags, true)...)
+	devIDs := []int64{}
+	resp.Diagnostics.Append(plan.DeviceIDs.ElementsAs(ctx, &devIDs, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	isDeployment := !plan.TemplateID.IsNull() || !plan.TemplateName.IsNull()
+	if isDeployment && len(serviceTags) == 0 && len(devIDs) == 0 {
+		resp.Diagnostics.AddError(clients.ErrGnrReadDeploymentPrecheck,
+			"one of device_ids and device_servicetags is required to precheck the deployment of a template")
+		return
+	}
+
+	omeClient, d := g.p.createOMESession(ctx, "datasource_deployment_precheck Read")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	devices := []models.Device{}
+	if len(serviceTags) > 0 || len(devIDs) > 0 {
+		var err error
+		devices, err = omeClient.GetDevices(serviceTags, devIDs, []string{})
+		if err != nil {
+			resp.Diagnostics.AddError(clients.ErrGnrReadDeploymentPrecheck, err.Error())
+			return
+		}
+	}
+
+	if isDeployment {
+		tflog.Trace(ctx, "datasource_deployment_precheck read: prechecking deployment")
+		template, err := helper.GetTemplate(omeClient, plan.TemplateID.ValueInt64(), plan.TemplateName.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(clients.ErrGnrReadDeploymentPrecheck, err.Error())
+			return
+		}
+		uniqueDevices, deviceIDs, _ := omeClient.GetUniqueDevicesIdsAndServiceTags(devices)
+		request := models.OMETemplateDeployRequest{
+			ID:        template.ID,
+			TargetIDS: deviceIDs,
+			Options: models.OMEOptions{
+				StrictCheckingVLAN: plan.OptionsStrictCheckingVlan.ValueBool(),
+			},
+		}
+		jobID, results, err := helper.RunDeploymentPrecheck(ctx, omeClient, request, uniqueDevices, plan.JobRetryCount.ValueInt64(), plan.SleepInterval.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddError(clients.ErrGnrReadDeploymentPrecheck, err.Error())
+			return
+		}
+		plan = helper.SetStateDeploymentPrecheck(fmt.Sprintf("deployment-%d", template.ID), jobID, results, plan)
+	} else {
+		tflog.Trace(ctx, "datasource_deployment_precheck read: prechecking remediation")
+		var baseline models.OmeBaseline
+		var err error
+		if !plan.BaselineID.IsNull() {
+			baseline, err = omeClient.GetBaselineByID(plan.BaselineID.ValueInt64())
+		} else {
+			baseline, err = omeClient.GetBaselineByName(plan.BaselineName.ValueString())
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(clients.ErrGnrReadDeploymentPrecheck, err.Error())
+			return
+		}
+		deviceIDs := []int64{}
+		if len(devices) > 0 {
+			_, deviceIDs, _ = omeClient.GetUniqueDevicesIdsAndServiceTags(devices)
+		}
+		results, err := helper.RunRemediationPrecheck(ctx, omeClient, baseline, deviceIDs)
+		if err != nil {
+			resp.Diagnostics.AddError(clients.ErrGnrReadDeploymentPrecheck, err.Error())
+			return
+		}
+		plan = helper.SetStateDeploymentPrecheck(fmt.Sprintf("remediation-%d", baseline.ID), 0, results, plan)
+		plan.ComplianceCheckedAt = types.StringValue(baseline.LastRun)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Trace(ctx, "datasource_deployment_precheck read: finished")
+}
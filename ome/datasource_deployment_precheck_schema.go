@@ -0,0 +1,196 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func omeDeploymentPrecheckDataSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the precheck.",
+			Description:         "ID of the precheck.",
+			Computed:            true,
+		},
+		"template_id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the template whose deployment is prechecked." +
+				" Exactly one of `template_id`, `template_name`, `baseline_id` and `baseline_name` must be set.",
+			Description: "ID of the template whose deployment is prechecked." +
+				" Exactly one of 'template_id', 'template_name', 'baseline_id' and 'baseline_name' must be set.",
+			Optional: true,
+			Validators: []validator.Int64{
+				int64validator.ExactlyOneOf(
+					path.MatchRoot("template_name"),
+					path.MatchRoot("baseline_id"),
+					path.MatchRoot("baseline_name"),
+				),
+				int64validator.AtLeast(1),
+			},
+		},
+		"template_name": schema.StringAttribute{
+			MarkdownDescription: "Name of the template whose deployment is prechecked.",
+			Description:         "Name of the template whose deployment is prechecked.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"baseline_id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the configuration baseline whose remediation is prechecked.",
+			Description:         "ID of the configuration baseline whose remediation is prechecked.",
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"baseline_name": schema.StringAttribute{
+			MarkdownDescription: "Name of the configuration baseline whose remediation is prechecked.",
+			Description:         "Name of the configuration baseline whose remediation is prechecked.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"device_ids": schema.SetAttribute{
+			MarkdownDescription: "IDs of the target devices. Conflicts with `device_servicetags`." +
+				" The devices are required for a deployment, for a remediation the non compliant devices of the baseline are prechecked by default.",
+			Description: "IDs of the target devices. Conflicts with 'device_servicetags'." +
+				" The devices are required for a deployment, for a remediation the non compliant devices of the baseline are prechecked by default.",
+			ElementType: types.Int64Type,
+			Optional:    true,
+			Validators: []validator.Set{
+				setvalidator.ConflictsWith(path.MatchRoot("device_servicetags")),
+				setvalidator.SizeAtLeast(1),
+			},
+		},
+		"device_servicetags": schema.SetAttribute{
+			MarkdownDescription: "Service tags of the target devices. Conflicts with `device_ids`.",
+			Description:         "Service tags of the target devices. Conflicts with 'device_ids'.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+			},
+		},
+		"options_strict_checking_vlan": schema.BoolAttribute{
+			MarkdownDescription: "Whether the precheck of a deployment fails when the VLANs of the template are not available for the devices.",
+			Description:         "Whether the precheck of a deployment fails when the VLANs of the template are not available for the devices.",
+			Optional:            true,
+		},
+		"job_retry_count": schema.Int64Attribute{
+			MarkdownDescription: "Number of times the precheck job of a deployment is polled to get its final status." +
+				fmt.Sprintf(" Default value is `%d`.", RetryCount),
+			Description: "Number of times the precheck job of a deployment is polled to get its final status." +
+				fmt.Sprintf(" Default value is '%d'.", RetryCount),
+			Optional: true,
+			Computed: true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"sleep_interval": schema.Int64Attribute{
+			MarkdownDescription: "Sleep time interval in seconds between the polls of the precheck job of a deployment." +
+				fmt.Sprintf(" Default value is `%d`.", SleepInterval),
+			Description: "Sleep time interval in seconds between the polls of the precheck job of a deployment." +
+				fmt.Sprintf(" Default value is '%d'.", SleepInterval),
+			Optional: true,
+			Computed: true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"job_id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the precheck job of a deployment. It is `0` for a remediation, which has no precheck job.",
+			Description:         "ID of the precheck job of a deployment. It is '0' for a remediation, which has no precheck job.",
+			Computed:            true,
+		},
+		"compliance_checked_at": schema.StringAttribute{
+			MarkdownDescription: "Time of the last compliance check of the baseline, whose results are the findings of a remediation." +
+				" The findings do not show the changes made since then. It is not set for a deployment.",
+			Description: "Time of the last compliance check of the baseline, whose results are the findings of a remediation." +
+				" The findings do not show the changes made since then. It is not set for a deployment.",
+			Computed: true,
+		},
+		"passed": schema.BoolAttribute{
+			MarkdownDescription: "Whether the precheck passed for all the devices.",
+			Description:         "Whether the precheck passed for all the devices.",
+			Computed:            true,
+		},
+		"devices": schema.ListNestedAttribute{
+			MarkdownDescription: "Precheck results of the devices.",
+			Description:         "Precheck results of the devices.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"device_id": schema.Int64Attribute{
+						MarkdownDescription: "ID of the device.",
+						Description:         "ID of the device.",
+						Computed:            true,
+					},
+					"device_servicetag": schema.StringAttribute{
+						MarkdownDescription: "Service tag of the device.",
+						Description:         "Service tag of the device.",
+						Computed:            true,
+					},
+					"passed": schema.BoolAttribute{
+						MarkdownDescription: "Whether the precheck passed for the device." +
+							" The attribute changes of a remediation do not fail its precheck.",
+						Description: "Whether the precheck passed for the device." +
+							" The attribute changes of a remediation do not fail its precheck.",
+						Computed: true,
+					},
+					"message": schema.StringAttribute{
+						MarkdownDescription: "Message of the precheck job for the device, as reported by OME, the findings are extracted from its lines." +
+							" It is empty for a remediation, whose findings are the non compliant attributes.",
+						Description: "Message of the precheck job for the device, as reported by OME, the findings are extracted from its lines." +
+							" It is empty for a remediation, whose findings are the non compliant attributes.",
+						Computed: true,
+					},
+					"findings": schema.ListNestedAttribute{
+						MarkdownDescription: "Findings of the precheck for the device.",
+						Description:         "Findings of the precheck for the device.",
+						Computed:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"category": schema.StringAttribute{
+									MarkdownDescription: "Category of the finding, one of `missing_vlan`, `identity_pool_exhausted`," +
+										" `unsupported_attribute`, `attribute_change` and `other`." +
+										" OME reports the findings as free text, the category is a best effort guess from the keywords of the message, which is kept as it is.",
+									Description: "Category of the finding, one of 'missing_vlan', 'identity_pool_exhausted'," +
+										" 'unsupported_attribute', 'attribute_change' and 'other'." +
+										" OME reports the findings as free text, the category is a best effort guess from the keywords of the message, which is kept as it is.",
+									Computed: true,
+								},
+								"message": schema.StringAttribute{
+									MarkdownDescription: "Message of the finding, the line of the message of the device or the non compliant attribute with its reason.",
+									Description:         "Message of the finding, the line of the message of the device or the non compliant attribute with its reason.",
+									Computed:            true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,128 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-ome/clients"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestDataSource_DeploymentPrecheckRead(t *testing.T) {
+	temps := initTemplates(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDeploymentPrecheck + temps.templateDeploySvcTag1,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ome_deployment_precheck.precheck", "job_id"),
+					resource.TestCheckNoResourceAttr("data.ome_deployment_precheck.precheck", "compliance_checked_at"),
+					resource.TestCheckResourceAttrSet("data.ome_deployment_precheck.precheck", "devices.0.message"),
+					resource.TestCheckResourceAttrSet("data.ome_deployment_precheck.precheck", "passed"),
+					resource.TestCheckResourceAttr("data.ome_deployment_precheck.precheck", "devices.#", "1"),
+					resource.TestCheckResourceAttr("data.ome_deployment_precheck.precheck", "devices.0.device_servicetag", DeviceSvcTag1),
+				),
+			},
+			{
+				Config: testDeploymentPrecheckRemediation + temps.templateSvcTag1,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ome_deployment_precheck.precheck", "job_id", "0"),
+					resource.TestCheckResourceAttrSet("data.ome_deployment_precheck.precheck", "compliance_checked_at"),
+					resource.TestCheckResourceAttr("data.ome_deployment_precheck.precheck", "devices.0.message", ""),
+					resource.TestCheckResourceAttr("data.ome_deployment_precheck.precheck", "devices.#", "1"),
+					resource.TestCheckResourceAttr("data.ome_deployment_precheck.precheck", "devices.0.device_servicetag", DeviceSvcTag1),
+				),
+			},
+			{
+				Config:      testDeploymentPrecheckNoDevices,
+				ExpectError: regexp.MustCompile(`.*one of device_ids and device_servicetags is required.*`),
+			},
+			{
+				Config:      testDeploymentPrecheckInvalidTemplate,
+				ExpectError: regexp.MustCompile(`.*error reading deployment precheck.*`),
+			},
+			{
+				Config:      testDeploymentPrecheckInvalidCombination,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Combination.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock((*clients.Client).CreateDeployment).Return(int64(-1), fmt.Errorf("mock error")).Build()
+				},
+				Config:      testDeploymentPrecheck + temps.templateDeploySvcTag1,
+				ExpectError: regexp.MustCompile(`.*mock error.*`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+				},
+				Config: testDeploymentPrecheck + temps.templateDeploySvcTag1,
+			},
+		},
+	})
+}
+
+var testDeploymentPrecheck = testProvider + `
+data "ome_deployment_precheck" "precheck" {
+	template_name = "` + TestAccTemplateName + `"
+	device_servicetags = ["` + DeviceSvcTag1 + `"]
+	options_strict_checking_vlan = true
+	depends_on = [ome_template.terraform-acceptance-test-1]
+}
+`
+
+var testDeploymentPrecheckRemediation = testProvider + `
+resource "ome_configuration_baseline" "create_baseline" {
+	baseline_name = "` + BaselineName + `"
+	ref_template_name = "` + TestRefTemplateName + `"
+	device_servicetags = ["` + DeviceSvcTag1 + `"]
+	description = "baseline description"
+	depends_on = [ome_template.terraform-acceptance-test-1]
+}
+
+data "ome_deployment_precheck" "precheck" {
+	baseline_name = ome_configuration_baseline.create_baseline.baseline_name
+	device_servicetags = ["` + DeviceSvcTag1 + `"]
+}
+`
+
+var testDeploymentPrecheckNoDevices = testProvider + `
+data "ome_deployment_precheck" "precheck" {
+	template_name = "` + TestAccTemplateName + `"
+}
+`
+
+var testDeploymentPrecheckInvalidTemplate = testProvider + `
+data "ome_deployment_precheck" "precheck" {
+	template_name = "invalid-template"
+	device_servicetags = ["` + DeviceSvcTag1 + `"]
+}
+`
+
+var testDeploymentPrecheckInvalidCombination = testProvider + `
+data "ome_deployment_precheck" "precheck" {
+	template_name = "` + TestAccTemplateName + `"
+	baseline_name = "` + BaselineName + `"
+	device_servicetags = ["` + DeviceSvcTag1 + `"]
+}
+`
@@ -16,9 +16,12 @@ package ome
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"sync"
 	"terraform-provider-ome/clients"
 	"terraform-provider-ome/models"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -36,6 +39,9 @@ func NewDeviceDatasource() datasource.DataSource {
 	return &deviceDatasource{}
 }
 
+// defaultInventoryConcurrency is the number of devices whose inventory is fetched at the same time by default
+const defaultInventoryConcurrency = 5
+
 type deviceDatasource struct {
 	p *omeProvider
 }
@@ -101,24 +107,32 @@ func (g *deviceDatasource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	// If at least one of the filters are set then do detailed inventory
+	inventoryErrors := map[string]attr.Value{}
 	if !filters.FilterExpr.IsNull() ||
 		len(filters.IDs.Elements()) > 0 ||
 		len(filters.SvcTags.Elements()) > 0 ||
-		len(filters.IPExprs.Elements()) > 0 {
+		len(filters.IPExprs.Elements()) > 0 ||
+		len(filters.MgmtIPs.Elements()) > 0 {
+		concurrency := defaultInventoryConcurrency
+		if !plan.InventoryConcurrency.IsNull() {
+			concurrency = int(plan.InventoryConcurrency.ValueInt64())
+		}
+		invs, errs := g.ReadDevicesInventory(ctx, omeClient, devs, plan.InventoryTypes, concurrency)
 		for i, dev := range devs {
-			id := dev.ID
-			inv, err2 := g.ReadDeviceInventory(ctx, omeClient, id, plan.InventoryTypes)
-			if err2 != nil {
-				resp.Diagnostics.AddError(
-					fmt.Sprintf("Error getting detailed inventory by id: %d", id),
-					err2.Error(),
+			// an unreachable device is reported without failing the read of the other devices
+			if errs[i] != nil {
+				resp.Diagnostics.AddWarning(
+					fmt.Sprintf("Error getting detailed inventory by id: %d", dev.ID),
+					errs[i].Error(),
 				)
-				return
+				inventoryErrors[strconv.FormatInt(dev.ID, 10)] = types.StringValue(errs[i].Error())
+				continue
 			}
-			tflog.Info(ctx, fmt.Sprint(inv))
-			vals[i].Inventory = inv
+			vals[i].Inventory = invs[i]
 		}
 	}
+	plan.InventoryErrors, diags = types.MapValue(types.StringType, inventoryErrors)
+	resp.Diagnostics.Append(diags...)
 	g.WriteState(ctx, plan, vals, resp)
 }
 
@@ -165,14 +179,40 @@ func (g *deviceDatasource) ReadDevices(ctx context.Context, client *clients.Clie
 		return nil, err
 	}
 
-	if !filters.IPExprs.IsNull() {
-		inputs := make([]string, 0)
-		_ = filters.IPExprs.ElementsAs(ctx, &inputs, false)
-		return clients.FilterDeviceByIps(ret, inputs)
+	if !filters.IPExprs.IsNull() || !filters.MgmtIPs.IsNull() {
+		inputs, mgmtIPs := make([]string, 0), make([]string, 0)
+		_ = filters.IPExprs.ElementsAs(ctx, &inputs, true)
+		_ = filters.MgmtIPs.ElementsAs(ctx, &mgmtIPs, true)
+		return clients.FilterDeviceByIps(ret, append(inputs, mgmtIPs...))
 	}
 	return ret, err
 }
 
+// ReadDevicesInventory fetches the inventory of the devices with at most concurrency devices at the same time,
+// the inventories and errors are returned in the order of the devices
+func (g *deviceDatasource) ReadDevicesInventory(ctx context.Context, client *clients.Client,
+	devs []models.Device, itypes []string, concurrency int) ([]models.OmeDeviceInventory, []error) {
+
+	invs := make([]models.OmeDeviceInventory, len(devs))
+	errs := make([]error, len(devs))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, dev := range devs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, id int64) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			invs[i], errs[i] = g.ReadDeviceInventory(ctx, client, id, itypes)
+			tflog.Debug(ctx, fmt.Sprintf("fetched detailed inventory of device %d", id))
+		}(i, dev.ID)
+	}
+	wg.Wait()
+	return invs, errs
+}
+
 // Read implements datasource.DataSource
 func (g *deviceDatasource) ReadDeviceInventory(ctx context.Context, client *clients.Client,
 	id int64, itypes []string) (models.OmeDeviceInventory, error) {
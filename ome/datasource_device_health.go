@@ -0,0 +1,131 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"slices"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/helper"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &deviceHealthDataSource{}
+	_ datasource.DataSourceWithConfigure = &deviceHealthDataSource{}
+)
+
+// NewDeviceHealthDataSource creates a new device health data source.
+func NewDeviceHealthDataSource() datasource.DataSource {
+	return &deviceHealthDataSource{}
+}
+
+type deviceHealthDataSource struct {
+	p *omeProvider
+}
+
+// Configure implements datasource.DataSourceWithConfigure
+func (g *deviceHealthDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	g.p = req.ProviderData.(*omeProvider)
+}
+
+// Metadata implements datasource.DataSource
+func (*deviceHealthDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "device_health"
+}
+
+// Schema implements datasource.DataSource
+func (*deviceHealthDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform DataSource is used to query the health of devices from OME." +
+			" It returns the global status, connection state, power state and the rollup status of the subsystems of the devices" +
+			" selected by IDs, service tags, group names or filter expression, without reading their full inventory.",
+		Description: "This Terraform DataSource is used to query the health of devices from OME." +
+			" It returns the global status, connection state, power state and the rollup status of the subsystems of the devices" +
+			" selected by IDs, service tags, group names or filter expression, without reading their full inventory.",
+		Attributes: omeDeviceHealthDataSchema(),
+	}
+}
+
+// Read implements datasource.DataSource
+func (g *deviceHealthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Trace(ctx, "datasource_device_health read: started")
+	var plan models.OmeDeviceHealthData
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ids []int64
+	var serviceTags, groupNames, statuses []string
+	resp.Diagnostics.Append(plan.DeviceIDs.ElementsAs(ctx, &ids, true)...)
+	resp.Diagnostics.Append(plan.DeviceServiceTags.ElementsAs(ctx, &serviceTags, true)...)
+	resp.Diagnostics.Append(plan.DeviceGroupNames.ElementsAs(ctx, &groupNames, true)...)
+	resp.Diagnostics.Append(plan.StatusFilter.ElementsAs(ctx, &statuses, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	omeClient, d := g.p.createOMESession(ctx, "datasource_device_health Read")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	devices, err := helper.GetHealthDevices(omeClient, ids, serviceTags, groupNames, plan.FilterExpression.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrReadDeviceHealth, err.Error())
+		return
+	}
+
+	includeSubsystems := plan.IncludeSubsystems.IsNull() || plan.IncludeSubsystems.ValueBool()
+	plan.Devices = []models.OmeDeviceHealth{}
+	for _, device := range devices {
+		// the status filter is applied before reading the subsystems to save requests
+		if len(statuses) > 0 && !slices.Contains(statuses, helper.DeviceHealthStatusName(device.Status)) {
+			continue
+		}
+		var subsystems []models.SubSystemRollupStatus
+		if includeSubsystems {
+			subsystems, err = helper.GetSubsystemHealth(omeClient, device.ID)
+			if err != nil {
+				resp.Diagnostics.AddError(clients.ErrGnrReadDeviceHealth, err.Error())
+				return
+			}
+		}
+		plan.Devices = append(plan.Devices, helper.NewDeviceHealth(device, subsystems))
+	}
+
+	if plan.ID.IsNull() {
+		plan.ID = types.Int64Value(0)
+	}
+	plan.StatusCounts, d = helper.NewDeviceHealthStatusCounts(plan.Devices)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Trace(ctx, "datasource_device_health read: finished")
+}
@@ -0,0 +1,186 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"terraform-provider-ome/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func omeDeviceHealthDataSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			MarkdownDescription: "Dummy ID of the datasource.",
+			Description:         "Dummy ID of the datasource.",
+			Computed:            true,
+		},
+		"device_ids": schema.ListAttribute{
+			MarkdownDescription: "IDs of the devices. Only one among `device_ids`, `device_service_tags`, `device_group_names` and `filter_expression` can be configured." +
+				" When none is configured, the health of all the devices is read.",
+			Description: "IDs of the devices. Only one among 'device_ids', 'device_service_tags', 'device_group_names' and 'filter_expression' can be configured." +
+				" When none is configured, the health of all the devices is read.",
+			ElementType: types.Int64Type,
+			Optional:    true,
+			Validators: []validator.List{
+				listvalidator.ConflictsWith(path.MatchRoot("device_service_tags")),
+				listvalidator.ConflictsWith(path.MatchRoot("device_group_names")),
+				listvalidator.ConflictsWith(path.MatchRoot("filter_expression")),
+				listvalidator.SizeAtLeast(1),
+			},
+		},
+		"device_service_tags": schema.ListAttribute{
+			MarkdownDescription: "Service tags of the devices.",
+			Description:         "Service tags of the devices.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Validators: []validator.List{
+				listvalidator.ConflictsWith(path.MatchRoot("device_group_names")),
+				listvalidator.ConflictsWith(path.MatchRoot("filter_expression")),
+				listvalidator.SizeAtLeast(1),
+				listvalidator.ValueStringsAre(
+					stringvalidator.LengthAtLeast(1),
+				),
+			},
+		},
+		"device_group_names": schema.ListAttribute{
+			MarkdownDescription: "Names of the groups of the devices.",
+			Description:         "Names of the groups of the devices.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Validators: []validator.List{
+				listvalidator.ConflictsWith(path.MatchRoot("filter_expression")),
+				listvalidator.SizeAtLeast(1),
+				listvalidator.ValueStringsAre(
+					stringvalidator.LengthAtLeast(1),
+				),
+			},
+		},
+		"filter_expression": schema.StringAttribute{
+			MarkdownDescription: "OData `$filter` compatible expression to be used for querying devices, for example `Type eq 1000`.",
+			Description:         "OData '$filter' compatible expression to be used for querying devices, for example 'Type eq 1000'.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"status_filter": schema.SetAttribute{
+			MarkdownDescription: "Global statuses of the devices to return." +
+				makeSchemaAcceptedValues(helper.DeviceHealthStatuses, "`") +
+				" By default the devices of any status are returned.",
+			Description: "Global statuses of the devices to return." +
+				makeSchemaAcceptedValues(helper.DeviceHealthStatuses, "'") +
+				" By default the devices of any status are returned.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+				setvalidator.ValueStringsAre(
+					stringvalidator.OneOf(helper.DeviceHealthStatuses...),
+				),
+			},
+		},
+		"include_subsystems": schema.BoolAttribute{
+			MarkdownDescription: "Read the rollup status of the subsystems of the returned devices, one request per device." +
+				" Default value is `true`.",
+			Description: "Read the rollup status of the subsystems of the returned devices, one request per device." +
+				" Default value is 'true'.",
+			Optional: true,
+		},
+		"status_counts": schema.MapAttribute{
+			MarkdownDescription: "Number of returned devices by global status.",
+			Description:         "Number of returned devices by global status.",
+			ElementType:         types.Int64Type,
+			Computed:            true,
+		},
+		"devices": schema.ListNestedAttribute{
+			MarkdownDescription: "Health of the devices.",
+			Description:         "Health of the devices.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: omeDeviceHealthSchema(),
+			},
+		},
+	}
+}
+
+func omeDeviceHealthSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the device.",
+			Description:         "ID of the device.",
+			Computed:            true,
+		},
+		"service_tag": schema.StringAttribute{
+			MarkdownDescription: "Service tag of the device.",
+			Description:         "Service tag of the device.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the device.",
+			Description:         "Name of the device.",
+			Computed:            true,
+		},
+		"model": schema.StringAttribute{
+			MarkdownDescription: "Model of the device.",
+			Description:         "Model of the device.",
+			Computed:            true,
+		},
+		"global_status": schema.StringAttribute{
+			MarkdownDescription: "Global health status of the device, one of `normal`, `warning`, `critical` and `unknown`.",
+			Description:         "Global health status of the device, one of 'normal', 'warning', 'critical' and 'unknown'.",
+			Computed:            true,
+		},
+		"connection_state": schema.BoolAttribute{
+			MarkdownDescription: "Whether OME can connect to the device.",
+			Description:         "Whether OME can connect to the device.",
+			Computed:            true,
+		},
+		"power_state": schema.StringAttribute{
+			MarkdownDescription: "Power state of the device, one of `on`, `off`, `powering_on`, `powering_off` and `unknown`.",
+			Description:         "Power state of the device, one of 'on', 'off', 'powering_on', 'powering_off' and 'unknown'.",
+			Computed:            true,
+		},
+		"last_status_time": schema.StringAttribute{
+			MarkdownDescription: "Time of the last health status update of the device.",
+			Description:         "Time of the last health status update of the device.",
+			Computed:            true,
+		},
+		"subsystems": schema.ListNestedAttribute{
+			MarkdownDescription: "Rollup status of the subsystems of the device, empty when `include_subsystems` is `false`.",
+			Description:         "Rollup status of the subsystems of the device, empty when 'include_subsystems' is 'false'.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Name of the subsystem, for example `Memory` or `PowerSupply`.",
+						Description:         "Name of the subsystem, for example 'Memory' or 'PowerSupply'.",
+						Computed:            true,
+					},
+					"status": schema.StringAttribute{
+						MarkdownDescription: "Rollup health status of the subsystem.",
+						Description:         "Rollup health status of the subsystem.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
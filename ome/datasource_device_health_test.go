@@ -0,0 +1,192 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/helper"
+	"terraform-provider-ome/models"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestDataSource_DeviceHealthRead(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Health of a device with its subsystems
+			{
+				Config: testDeviceHealthByServiceTag,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ome_device_health.health", "devices.#", "1"),
+					resource.TestCheckResourceAttr("data.ome_device_health.health", "devices.0.service_tag", DeviceSvcTag1),
+					resource.TestCheckResourceAttrSet("data.ome_device_health.health", "devices.0.global_status"),
+					resource.TestCheckResourceAttrSet("data.ome_device_health.health", "devices.0.subsystems.#"),
+					resource.TestCheckResourceAttr("data.ome_device_health.health", "status_counts.%", "4"),
+				),
+			},
+			// Critical servers only, without subsystems
+			{
+				Config: testDeviceHealthByFilter,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ome_device_health.health", "status_counts.normal", "0"),
+					resource.TestCheckResourceAttr("data.ome_device_health.health", "status_counts.warning", "0"),
+				),
+			},
+			{
+				Config:      testDeviceHealthConflict,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Combination.*`),
+			},
+			{
+				Config:      testDeviceHealthInvalidStatus,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Value Match.*`),
+			},
+		},
+	})
+}
+
+func TestDataSource_DeviceHealthStatuses(t *testing.T) {
+	// the data source is read several times in a step
+	var subsystemReads map[int64]bool
+	mockDevices := func() {
+		subsystemReads = map[int64]bool{}
+		FunctionMocker = Mock(helper.GetHealthDevices).Return([]models.Device{
+			{ID: 10001, DeviceServiceTag: "TAG0001", Status: 1000, PowerState: 17},
+			{ID: 10002, DeviceServiceTag: "TAG0002", Status: 3000, PowerState: 18},
+			{ID: 10003, DeviceServiceTag: "TAG0003", Status: 4000, PowerState: 20},
+			{ID: 10004, DeviceServiceTag: "TAG0004", Status: 2000, PowerState: 1},
+			{ID: 10005, DeviceServiceTag: "TAG0005", Status: 5000, PowerState: 21},
+		}, nil).Build()
+		localMocker = Mock(helper.GetSubsystemHealth).To(func(_ *clients.Client, deviceID int64) ([]models.SubSystemRollupStatus, error) {
+			subsystemReads[deviceID] = true
+			return []models.SubSystemRollupStatus{
+				{SubsystemName: "PowerSupply", Status: 4000},
+				{SubsystemName: "Fan", Status: 1000},
+			}, nil
+		}).Build()
+	}
+	unPatch := func() {
+		FunctionMocker.UnPatch()
+		localMocker.UnPatch()
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// the statuses 2000 and 5000 of the appliance are both unknown
+			{
+				PreConfig: mockDevices,
+				Config:    testDeviceHealthAll,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ome_device_health.health", "devices.#", "5"),
+					resource.TestCheckResourceAttr("data.ome_device_health.health", "status_counts.normal", "1"),
+					resource.TestCheckResourceAttr("data.ome_device_health.health", "status_counts.warning", "1"),
+					resource.TestCheckResourceAttr("data.ome_device_health.health", "status_counts.critical", "1"),
+					resource.TestCheckResourceAttr("data.ome_device_health.health", "status_counts.unknown", "2"),
+					resource.TestCheckResourceAttr("data.ome_device_health.health", "devices.1.power_state", "off"),
+					resource.TestCheckResourceAttr("data.ome_device_health.health", "devices.3.power_state", "unknown"),
+					resource.TestCheckResourceAttr("data.ome_device_health.health", "devices.4.power_state", "powering_off"),
+					resource.TestCheckResourceAttr("data.ome_device_health.health", "devices.0.subsystems.#", "2"),
+					resource.TestCheckResourceAttr("data.ome_device_health.health", "devices.0.subsystems.0.name", "PowerSupply"),
+					resource.TestCheckResourceAttr("data.ome_device_health.health", "devices.0.subsystems.0.status", "critical"),
+				),
+			},
+			// the subsystems are only read for the devices kept by the status filter
+			{
+				PreConfig: func() {
+					unPatch()
+					mockDevices()
+				},
+				Config: testDeviceHealthStatuses,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ome_device_health.health", "devices.#", "3"),
+					resource.TestCheckResourceAttr("data.ome_device_health.health", "devices.0.service_tag", "TAG0003"),
+					resource.TestCheckResourceAttr("data.ome_device_health.health", "devices.1.global_status", "unknown"),
+					resource.TestCheckResourceAttr("data.ome_device_health.health", "status_counts.normal", "0"),
+					resource.TestCheckResourceAttr("data.ome_device_health.health", "status_counts.critical", "1"),
+					resource.TestCheckResourceAttr("data.ome_device_health.health", "status_counts.unknown", "2"),
+					func(_ *terraform.State) error {
+						if len(subsystemReads) != 3 || !subsystemReads[10003] || !subsystemReads[10004] || !subsystemReads[10005] {
+							return fmt.Errorf("expected the subsystems of the filtered devices only, read %v", subsystemReads)
+						}
+						return nil
+					},
+				),
+			},
+			// no subsystem is read when they are excluded
+			{
+				PreConfig: func() {
+					unPatch()
+					mockDevices()
+				},
+				Config: testDeviceHealthByFilter,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ome_device_health.health", "devices.#", "1"),
+					resource.TestCheckResourceAttr("data.ome_device_health.health", "devices.0.subsystems.#", "0"),
+					func(_ *terraform.State) error {
+						unPatch()
+						if len(subsystemReads) != 0 {
+							return fmt.Errorf("expected no subsystem read, read %v", subsystemReads)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+var testDeviceHealthByServiceTag = testProvider + `
+data "ome_device_health" "health" {
+	device_service_tags = ["` + DeviceSvcTag1 + `"]
+}
+`
+
+var testDeviceHealthByFilter = testProvider + `
+data "ome_device_health" "health" {
+	filter_expression  = "Type eq 1000"
+	status_filter      = ["critical"]
+	include_subsystems = false
+}
+`
+
+var testDeviceHealthAll = testProvider + `
+data "ome_device_health" "health" {
+}
+`
+
+var testDeviceHealthStatuses = testProvider + `
+data "ome_device_health" "health" {
+	status_filter = ["critical", "unknown"]
+}
+`
+
+var testDeviceHealthConflict = testProvider + `
+data "ome_device_health" "health" {
+	device_service_tags = ["` + DeviceSvcTag1 + `"]
+	filter_expression   = "Type eq 1000"
+}
+`
+
+var testDeviceHealthInvalidStatus = testProvider + `
+data "ome_device_health" "health" {
+	status_filter = ["broken"]
+}
+`
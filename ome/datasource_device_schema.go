@@ -14,6 +14,9 @@ limitations under the License.
 package ome
 
 import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -77,15 +80,35 @@ func omeDeviceDataSchema() map[string]schema.Attribute {
 				},
 				"ip_expressions": schema.ListAttribute{
 					MarkdownDescription: "IP expressions of the devices to fetch." +
-						" Supported expressions are IPv4, IPv6, CIDRs and IP ranges.",
+						" Supported expressions are IPv4, IPv6, CIDRs, IP ranges, IPv4 wildcards and host names.",
 					Description: "IP expressions of the devices to fetch." +
-						" Supported expressions are IPv4, IPv6, CIDRs and IP ranges.",
+						" Supported expressions are IPv4, IPv6, CIDRs, IP ranges, IPv4 wildcards and host names.",
+					DeprecationMessage: "Use management_ip_filter instead.",
+					Optional:           true,
+					ElementType:        types.StringType,
+					Validators: []validator.List{
+						listvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("management_ip_filter")),
+						listvalidator.SizeAtLeast(1),
+						listvalidator.ValueStringsAre(
+							stringvalidator.LengthAtLeast(1),
+							networkExpressionValidator{allowWildcard: true},
+						),
+					},
+				},
+				"management_ip_filter": schema.ListAttribute{
+					MarkdownDescription: "Management IPs of the devices to fetch, can be combined with the other filters." +
+						" Supported expressions are IPv4 and IPv6 addresses, CIDRs like `2001:db8::/32`, IP ranges," +
+						" IPv4 wildcards like `192.35.0.*` and host names, which are resolved to their IPs with DNS.",
+					Description: "Management IPs of the devices to fetch, can be combined with the other filters." +
+						" Supported expressions are IPv4 and IPv6 addresses, CIDRs like '2001:db8::/32', IP ranges," +
+						" IPv4 wildcards like '192.35.0.*' and host names, which are resolved to their IPs with DNS.",
 					Optional:    true,
 					ElementType: types.StringType,
 					Validators: []validator.List{
 						listvalidator.SizeAtLeast(1),
 						listvalidator.ValueStringsAre(
 							stringvalidator.LengthAtLeast(1),
+							networkExpressionValidator{allowWildcard: true},
 						),
 					},
 				},
@@ -128,6 +151,24 @@ func omeDeviceDataSchema() map[string]schema.Attribute {
 				listvalidator.UniqueValues(),
 			},
 		},
+		"inventory_concurrency": schema.Int64Attribute{
+			MarkdownDescription: "Maximum number of devices whose inventory is fetched at the same time." +
+				fmt.Sprintf(" Default value is `%d`.", defaultInventoryConcurrency),
+			Description: "Maximum number of devices whose inventory is fetched at the same time." +
+				fmt.Sprintf(" Default value is '%d'.", defaultInventoryConcurrency),
+			Optional: true,
+			Validators: []validator.Int64{
+				int64validator.Between(1, 20),
+			},
+		},
+		"inventory_errors": schema.MapAttribute{
+			MarkdownDescription: "Errors raised while fetching the inventory of the devices, keyed by device ID." +
+				" The inventory of a device in error is left empty and the read does not fail.",
+			Description: "Errors raised while fetching the inventory of the devices, keyed by device ID." +
+				" The inventory of a device in error is left empty and the read does not fail.",
+			Computed:    true,
+			ElementType: types.StringType,
+		},
 	}
 }
 
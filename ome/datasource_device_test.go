@@ -14,10 +14,14 @@ limitations under the License.
 package ome
 
 import (
+	"fmt"
 	"os"
 	"regexp"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
 	"testing"
 
+	. "github.com/bytedance/mockey"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -143,12 +147,57 @@ func TestDataSource_ReadDevice(t *testing.T) {
 					resource.TestCheckOutput("fetched_multiple", "false"),
 					resource.TestCheckOutput("fetched_inventory", "true"),
 					resource.TestCheckOutput("fetched_selected_inventory", "true"),
+					resource.TestCheckResourceAttr("data.ome_device.devs", "inventory_errors.%", "0"),
 				),
 			},
+			{
+				Config: testGetDevicesWithMgmtIPFilter + devDataOut,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("fetched_any", "true"),
+					resource.TestCheckOutput("fetched_multiple", "false"),
+					resource.TestCheckOutput("fetched_inventory", "true"),
+				),
+			},
+			{
+				Config:      testGetDevicesInvalidMgmtIPFilter,
+				ExpectError: regexp.MustCompile(`.*Invalid network expression.*`),
+			},
+			{
+				Config:      testGetDevicesInvalidConcurrency,
+				ExpectError: regexp.MustCompile(`.*inventory_concurrency value must be between 1 and 20.*`),
+			},
+			// The inventory of a device in error does not fail the read
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock((*clients.Client).GetDeviceInventoryByType).Return(models.DeviceInventory{}, fmt.Errorf("mock error")).Build()
+				},
+				Config: testGetDevicesWithIPAndInvType + devDataOut,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("fetched_any", "true"),
+					resource.TestCheckResourceAttr("data.ome_device.devs", "inventory_errors.%", "1"),
+				),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+				},
+				Config: testGetDevicesWithIPAndInvType + devDataOut,
+			},
 		},
 	})
 }
 
+var testGetDevicesInvalidConcurrency = testProvider + `
+data "ome_device" "devs" {
+	filters = {
+		ip_expressions = ["` + DeviceIP1 + `"]
+	}
+	inventory_concurrency = 0
+}
+`
+
 var devDataOut = `
 output "fetched_any" {
 	value = length(data.ome_device.devs.devices) != 0
@@ -320,3 +369,19 @@ output "fetched_selected_inventory" {
 	]))
 }
 `
+
+var testGetDevicesWithMgmtIPFilter = testProvider + `
+data "ome_device" "devs" {
+	filters = {
+		management_ip_filter = ["` + DeviceIP1 + `"]
+	}
+}
+`
+
+var testGetDevicesInvalidMgmtIPFilter = testProvider + `
+data "ome_device" "devs" {
+	filters = {
+		management_ip_filter = ["192.35.0.344"]
+	}
+}
+`
@@ -0,0 +1,106 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/helper"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &groupHierarchyDataSource{}
+	_ datasource.DataSourceWithConfigure = &groupHierarchyDataSource{}
+)
+
+// NewGroupHierarchyDataSource creates a new group hierarchy data source.
+func NewGroupHierarchyDataSource() datasource.DataSource {
+	return &groupHierarchyDataSource{}
+}
+
+type groupHierarchyDataSource struct {
+	p *omeProvider
+}
+
+// Configure implements datasource.DataSourceWithConfigure
+func (g *groupHierarchyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	g.p = req.ProviderData.(*omeProvider)
+}
+
+// Metadata implements datasource.DataSource
+func (*groupHierarchyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "group_hierarchy"
+}
+
+// Schema implements datasource.DataSource
+func (*groupHierarchyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform DataSource is used to walk the group tree of OME from a root group." +
+			" It returns every group of the tree with its level and path, its direct and recursive devices, and the groups and devices of each level." +
+			" The read fails when a cycle is detected in the group tree.",
+		Description: "This Terraform DataSource is used to walk the group tree of OME from a root group." +
+			" It returns every group of the tree with its level and path, its direct and recursive devices, and the groups and devices of each level." +
+			" The read fails when a cycle is detected in the group tree.",
+		Attributes: omeGroupHierarchyDataSchema(),
+	}
+}
+
+// Read implements datasource.DataSource
+func (g *groupHierarchyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Trace(ctx, "datasource_group_hierarchy read: started")
+	var plan models.OmeGroupHierarchyData
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	omeClient, d := g.p.createOMESession(ctx, "datasource_group_hierarchy Read")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	maxDepth := int64(-1)
+	if !plan.MaxDepth.IsNull() {
+		maxDepth = plan.MaxDepth.ValueInt64()
+	}
+	nodes, err := helper.GetGroupHierarchy(omeClient, plan.RootGroupName.ValueString(), maxDepth)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrReadGroupHierarchy, err.Error())
+		return
+	}
+
+	if plan.ID.IsNull() {
+		plan.ID = types.Int64Value(0)
+	}
+	plan, d = helper.NewGroupHierarchyState(nodes, plan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Trace(ctx, "datasource_group_hierarchy read: finished")
+}
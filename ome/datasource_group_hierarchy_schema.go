@@ -0,0 +1,143 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func omeGroupHierarchyDataSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			MarkdownDescription: "Dummy ID of the datasource.",
+			Description:         "Dummy ID of the datasource.",
+			Computed:            true,
+		},
+		"root_group_name": schema.StringAttribute{
+			MarkdownDescription: "Name of the group from which the group tree is walked, for example `Static Groups`.",
+			Description:         "Name of the group from which the group tree is walked, for example 'Static Groups'.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"max_depth": schema.Int64Attribute{
+			MarkdownDescription: "Maximum level of the groups to read, the root group is at level `0`. By default the whole tree is read.",
+			Description:         "Maximum level of the groups to read, the root group is at level '0'. By default the whole tree is read.",
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+		},
+		"device_ids": schema.SetAttribute{
+			MarkdownDescription: "IDs of the devices of all the groups of the tree.",
+			Description:         "IDs of the devices of all the groups of the tree.",
+			Computed:            true,
+			ElementType:         types.Int64Type,
+		},
+		"groups": schema.ListNestedAttribute{
+			MarkdownDescription: "Groups of the tree, depth first from the root group. The sub groups are sorted by name.",
+			Description:         "Groups of the tree, depth first from the root group. The sub groups are sorted by name.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.Int64Attribute{
+						MarkdownDescription: "ID of the group.",
+						Description:         "ID of the group.",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Name of the group.",
+						Description:         "Name of the group.",
+						Computed:            true,
+					},
+					"description": schema.StringAttribute{
+						MarkdownDescription: "Description of the group.",
+						Description:         "Description of the group.",
+						Computed:            true,
+					},
+					"parent_id": schema.Int64Attribute{
+						MarkdownDescription: "ID of the parent group.",
+						Description:         "ID of the parent group.",
+						Computed:            true,
+					},
+					"membership_type_id": schema.Int64Attribute{
+						MarkdownDescription: "Membership type of the group.",
+						Description:         "Membership type of the group.",
+						Computed:            true,
+					},
+					"level": schema.Int64Attribute{
+						MarkdownDescription: "Level of the group in the tree, the root group is at level `0`.",
+						Description:         "Level of the group in the tree, the root group is at level '0'.",
+						Computed:            true,
+					},
+					"path": schema.ListAttribute{
+						MarkdownDescription: "Names of the groups from the root group to the group.",
+						Description:         "Names of the groups from the root group to the group.",
+						Computed:            true,
+						ElementType:         types.StringType,
+					},
+					"sub_group_ids": schema.ListAttribute{
+						MarkdownDescription: "IDs of the direct sub groups of the group. It is empty for the groups at `max_depth`.",
+						Description:         "IDs of the direct sub groups of the group. It is empty for the groups at 'max_depth'.",
+						Computed:            true,
+						ElementType:         types.Int64Type,
+					},
+					"device_ids": schema.SetAttribute{
+						MarkdownDescription: "IDs of the devices which are direct members of the group.",
+						Description:         "IDs of the devices which are direct members of the group.",
+						Computed:            true,
+						ElementType:         types.Int64Type,
+					},
+					"recursive_device_ids": schema.SetAttribute{
+						MarkdownDescription: "IDs of the devices of the group and of all its sub groups.",
+						Description:         "IDs of the devices of the group and of all its sub groups.",
+						Computed:            true,
+						ElementType:         types.Int64Type,
+					},
+				},
+			},
+		},
+		"levels": schema.ListNestedAttribute{
+			MarkdownDescription: "Groups and devices of each level of the tree.",
+			Description:         "Groups and devices of each level of the tree.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"level": schema.Int64Attribute{
+						MarkdownDescription: "Level in the tree, the root group is at level `0`.",
+						Description:         "Level in the tree, the root group is at level '0'.",
+						Computed:            true,
+					},
+					"group_ids": schema.ListAttribute{
+						MarkdownDescription: "IDs of the groups of the level.",
+						Description:         "IDs of the groups of the level.",
+						Computed:            true,
+						ElementType:         types.Int64Type,
+					},
+					"device_ids": schema.SetAttribute{
+						MarkdownDescription: "IDs of the devices which are direct members of the groups of the level.",
+						Description:         "IDs of the devices which are direct members of the groups of the level.",
+						Computed:            true,
+						ElementType:         types.Int64Type,
+					},
+				},
+			},
+		},
+	}
+}
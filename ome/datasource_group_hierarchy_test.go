@@ -0,0 +1,136 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"regexp"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestDataSource_GroupHierarchyRead(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Whole tree from the All Devices group
+			{
+				Config: testGroupHierarchyAll + groupHierarchyOutputs,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ome_group_hierarchy.tree", "groups.0.name", "All Devices"),
+					resource.TestCheckResourceAttr("data.ome_group_hierarchy.tree", "groups.0.level", "0"),
+					resource.TestCheckResourceAttr("data.ome_group_hierarchy.tree", "levels.0.level", "0"),
+					resource.TestCheckOutput("root_has_all_devices", "true"),
+					resource.TestCheckOutput("has_sub_groups", "true"),
+				),
+			},
+			// Only the root group and its direct sub groups
+			{
+				Config: testGroupHierarchyDepth + groupHierarchyOutputs,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ome_group_hierarchy.tree", "levels.#", "2"),
+					resource.TestCheckOutput("root_has_all_devices", "true"),
+				),
+			},
+			{
+				Config:      testGroupHierarchyInvalid,
+				ExpectError: regexp.MustCompile(`.*error reading group hierarchy.*`),
+			},
+		},
+	})
+}
+
+func TestDataSource_GroupHierarchyWalk(t *testing.T) {
+	// root has the sub groups b and a, both of them contain c, the group of each id contains the device 100 + id
+	subGroups := map[int64][]models.Group{
+		1: {{ID: 3, Name: "b"}, {ID: 2, Name: "a"}},
+		2: {{ID: 4, Name: "c"}},
+		3: {{ID: 4, Name: "c"}},
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// the groups are walked depth first by name and a group shared by several parents is only read once
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock((*clients.Client).GetExpandedGroupByName).Return(models.Group{ID: 1, Name: "root"}, nil).Build()
+					localMocker = Mock((*clients.Client).GetDevicesByGroupID).To(func(_ *clients.Client, id int64) (models.Devices, error) {
+						return models.Devices{Value: []models.Device{{ID: 100 + id}}}, nil
+					}).Build()
+					localMocker2 = Mock((*clients.Client).GetSubGroupsByGroupID).To(func(_ *clients.Client, id int64) ([]models.Group, error) {
+						return subGroups[id], nil
+					}).Build()
+				},
+				Config: testGroupHierarchyAll,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ome_group_hierarchy.tree", "groups.#", "4"),
+					resource.TestCheckResourceAttr("data.ome_group_hierarchy.tree", "groups.1.name", "a"),
+					resource.TestCheckResourceAttr("data.ome_group_hierarchy.tree", "groups.2.name", "c"),
+					resource.TestCheckResourceAttr("data.ome_group_hierarchy.tree", "groups.2.level", "2"),
+					resource.TestCheckResourceAttr("data.ome_group_hierarchy.tree", "groups.2.path.#", "3"),
+					resource.TestCheckResourceAttr("data.ome_group_hierarchy.tree", "groups.2.path.1", "a"),
+					resource.TestCheckResourceAttr("data.ome_group_hierarchy.tree", "groups.3.name", "b"),
+					resource.TestCheckResourceAttr("data.ome_group_hierarchy.tree", "groups.3.sub_group_ids.0", "4"),
+					resource.TestCheckResourceAttr("data.ome_group_hierarchy.tree", "groups.0.recursive_device_ids.#", "4"),
+					resource.TestCheckResourceAttr("data.ome_group_hierarchy.tree", "groups.1.recursive_device_ids.#", "2"),
+					resource.TestCheckResourceAttr("data.ome_group_hierarchy.tree", "levels.1.group_ids.#", "2"),
+					resource.TestCheckResourceAttr("data.ome_group_hierarchy.tree", "levels.2.group_ids.0", "4"),
+					resource.TestCheckResourceAttr("data.ome_group_hierarchy.tree", "device_ids.#", "4"),
+				),
+			},
+			// a group which contains one of its parents is reported
+			{
+				PreConfig: func() {
+					subGroups[4] = []models.Group{{ID: 2, Name: "a"}}
+				},
+				Config:      testGroupHierarchyAll,
+				ExpectError: regexp.MustCompile(`.*cycle detected in the group hierarchy: a > c > a.*`),
+			},
+		},
+	})
+}
+
+var groupHierarchyOutputs = `
+output "root_has_all_devices" {
+	value = length(setsubtract(data.ome_group_hierarchy.tree.device_ids, data.ome_group_hierarchy.tree.groups[0].recursive_device_ids)) == 0
+}
+
+output "has_sub_groups" {
+	value = length(data.ome_group_hierarchy.tree.groups[0].sub_group_ids) != 0
+}
+`
+
+var testGroupHierarchyAll = testProvider + `
+data "ome_group_hierarchy" "tree" {
+	root_group_name = "All Devices"
+}
+`
+
+var testGroupHierarchyDepth = testProvider + `
+data "ome_group_hierarchy" "tree" {
+	root_group_name = "All Devices"
+	max_depth       = 1
+}
+`
+
+var testGroupHierarchyInvalid = testProvider + `
+data "ome_group_hierarchy" "tree" {
+	root_group_name = "invalid-group"
+}
+`
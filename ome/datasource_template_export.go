@@ -0,0 +1,142 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"strings"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/helper"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &templateExportDataSource{}
+	_ datasource.DataSourceWithConfigure = &templateExportDataSource{}
+)
+
+// NewTemplateExportDataSource creates a new template export data source.
+func NewTemplateExportDataSource() datasource.DataSource {
+	return &templateExportDataSource{}
+}
+
+type templateExportDataSource struct {
+	p *omeProvider
+}
+
+// Configure implements datasource.DataSourceWithConfigure
+func (g *templateExportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	g.p = req.ProviderData.(*omeProvider)
+}
+
+// Metadata implements datasource.DataSource
+func (*templateExportDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "template_export"
+}
+
+// Schema implements datasource.DataSource
+func (*templateExportDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform DataSource is used to export the content of a template from OME, in a full and an attributes only form." +
+			" It can also compare the template with another template or with the configuration of a device, to review the changes before a deployment.",
+		Description: "This Terraform DataSource is used to export the content of a template from OME, in a full and an attributes only form." +
+			" It can also compare the template with another template or with the configuration of a device, to review the changes before a deployment.",
+		Attributes: omeTemplateExportDataSchema(),
+	}
+}
+
+// Read implements datasource.DataSource
+func (g *templateExportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Trace(ctx, "datasource_template_export read: started")
+	var plan models.OmeTemplateExport
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.Format.IsNull() {
+		plan.Format = types.StringValue("XML")
+	}
+
+	omeClient, d := g.p.createOMESession(ctx, "datasource_template_export Read")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	template, err := helper.GetTemplate(omeClient, plan.TemplateID.ValueInt64(), plan.TemplateName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrReadTemplateExport, err.Error())
+		return
+	}
+	content, err := helper.ExportTemplate(omeClient, template.ID, strings.ToLower(plan.Format.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrReadTemplateExport, err.Error())
+		return
+	}
+	values, err := helper.GetTemplateAttributeValues(omeClient, template.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrReadTemplateExport, err.Error())
+		return
+	}
+
+	var diffs []models.TemplateAttributeDiff
+	if !plan.CompareTemplateID.IsNull() || !plan.CompareTemplateName.IsNull() {
+		tflog.Trace(ctx, "datasource_template_export read: comparing with template")
+		reference, err := helper.GetTemplate(omeClient, plan.CompareTemplateID.ValueInt64(), plan.CompareTemplateName.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(clients.ErrGnrReadTemplateExport, err.Error())
+			return
+		}
+		referenceValues, err := helper.GetTemplateAttributeValues(omeClient, reference.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(clients.ErrGnrReadTemplateExport, err.Error())
+			return
+		}
+		diffs = helper.DiffTemplateAttributes(values, referenceValues)
+	}
+	if !plan.CompareDeviceID.IsNull() || !plan.CompareDeviceServiceTag.IsNull() {
+		tflog.Trace(ctx, "datasource_template_export read: comparing with device")
+		device, err := omeClient.GetDevice(plan.CompareDeviceServiceTag.ValueString(), plan.CompareDeviceID.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddError(clients.ErrGnrReadTemplateExport, err.Error())
+			return
+		}
+		baseline, err := helper.FindComplianceBaseline(omeClient, template.ID, device.ID, plan.CompareBaselineName.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(clients.ErrGnrReadTemplateExport, err.Error())
+			return
+		}
+		diffs, err = helper.GetDeviceAttributeDiffs(omeClient, baseline.ID, device.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(clients.ErrGnrReadTemplateExport, err.Error())
+			return
+		}
+		plan.CompareBaselineName = types.StringValue(baseline.Name)
+	}
+
+	plan, d = helper.SetStateTemplateExport(ctx, template, content, values, diffs, plan)
+	resp.Diagnostics.Append(d...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Trace(ctx, "datasource_template_export read: finished")
+}
@@ -0,0 +1,169 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func omeTemplateExportDataSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the exported template.",
+			Description:         "ID of the exported template.",
+			Computed:            true,
+		},
+		"template_id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the template to export. Conflicts with `template_name`.",
+			Description:         "ID of the template to export. Conflicts with 'template_name'.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.Int64{
+				int64validator.ExactlyOneOf(path.MatchRoot("template_name")),
+				int64validator.AtLeast(1),
+			},
+		},
+		"template_name": schema.StringAttribute{
+			MarkdownDescription: "Name of the template to export. Conflicts with `template_id`.",
+			Description:         "Name of the template to export. Conflicts with 'template_id'.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"format": schema.StringAttribute{
+			MarkdownDescription: "Format of the exported content, `XML` or `JSON`. Default value is `XML`.",
+			Description:         "Format of the exported content, 'XML' or 'JSON'. Default value is 'XML'.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("XML", "JSON"),
+			},
+		},
+		"content": schema.StringAttribute{
+			MarkdownDescription: "Full content of the template, as a server configuration profile in the requested format.",
+			Description:         "Full content of the template, as a server configuration profile in the requested format.",
+			Computed:            true,
+		},
+		"attributes": schema.MapAttribute{
+			MarkdownDescription: "Values of the attributes of the template which are not ignored, keyed by the path of their display names." +
+				" This is the attributes only form of the template, and its keys can be used in `attribute_overrides` of `ome_template`.",
+			Description: "Values of the attributes of the template which are not ignored, keyed by the path of their display names." +
+				" This is the attributes only form of the template, and its keys can be used in 'attribute_overrides' of 'ome_template'.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"compare_template_id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the template to compare the template with.",
+			Description:         "ID of the template to compare the template with.",
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.ConflictsWith(
+					path.MatchRoot("compare_template_name"),
+					path.MatchRoot("compare_device_id"),
+					path.MatchRoot("compare_device_servicetag"),
+				),
+				int64validator.AtLeast(1),
+			},
+		},
+		"compare_template_name": schema.StringAttribute{
+			MarkdownDescription: "Name of the template to compare the template with.",
+			Description:         "Name of the template to compare the template with.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(
+					path.MatchRoot("compare_device_id"),
+					path.MatchRoot("compare_device_servicetag"),
+				),
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"compare_device_id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the device to compare the template with." +
+				" The configuration of the device is the one of the last compliance check of a configuration baseline of the template which targets the device.",
+			Description: "ID of the device to compare the template with." +
+				" The configuration of the device is the one of the last compliance check of a configuration baseline of the template which targets the device.",
+			Optional: true,
+			Validators: []validator.Int64{
+				int64validator.ConflictsWith(path.MatchRoot("compare_device_servicetag")),
+				int64validator.AtLeast(1),
+			},
+		},
+		"compare_device_servicetag": schema.StringAttribute{
+			MarkdownDescription: "Service tag of the device to compare the template with." +
+				" The configuration of the device is the one of the last compliance check of a configuration baseline of the template which targets the device.",
+			Description: "Service tag of the device to compare the template with." +
+				" The configuration of the device is the one of the last compliance check of a configuration baseline of the template which targets the device.",
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"compare_baseline_name": schema.StringAttribute{
+			MarkdownDescription: "Name of the configuration baseline used to compare the template with the device." +
+				" When not configured, the first configuration baseline of the template which targets the device is used.",
+			Description: "Name of the configuration baseline used to compare the template with the device." +
+				" When not configured, the first configuration baseline of the template which targets the device is used.",
+			Optional: true,
+			Computed: true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+				stringvalidator.AtLeastOneOf(path.MatchRoot("compare_device_id"), path.MatchRoot("compare_device_servicetag")),
+			},
+		},
+		"differences": schema.ListNestedAttribute{
+			MarkdownDescription: "Differences of the attributes between the template and the compared template or device, ordered by path." +
+				" Null when nothing is compared.",
+			Description: "Differences of the attributes between the template and the compared template or device, ordered by path." +
+				" Null when nothing is compared.",
+			Computed: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: omeTemplateAttributeDiffSchema(),
+			},
+		},
+	}
+}
+
+func omeTemplateAttributeDiffSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"path": schema.StringAttribute{
+			MarkdownDescription: "Path of the display names of the attribute.",
+			Description:         "Path of the display names of the attribute.",
+			Computed:            true,
+		},
+		"value": schema.StringAttribute{
+			MarkdownDescription: "Value of the attribute in the template, null when the attribute is not set in the template.",
+			Description:         "Value of the attribute in the template, null when the attribute is not set in the template.",
+			Computed:            true,
+		},
+		"reference_value": schema.StringAttribute{
+			MarkdownDescription: "Value of the attribute in the compared template or device, null when the attribute is not set there.",
+			Description:         "Value of the attribute in the compared template or device, null when the attribute is not set there.",
+			Computed:            true,
+		},
+		"status": schema.StringAttribute{
+			MarkdownDescription: "Status of the difference, `added` when the attribute is only set in the template," +
+				" `removed` when it is only set in the compared template and `changed` when the values differ.",
+			Description: "Status of the difference, 'added' when the attribute is only set in the template," +
+				" 'removed' when it is only set in the compared template and 'changed' when the values differ.",
+			Computed: true,
+		},
+	}
+}
@@ -0,0 +1,259 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestDataSource_TemplateExportRead(t *testing.T) {
+	temps := initTemplates(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testTemplateExport + temps.templateSvcTag1 + temps.templateSvcTag2,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ome_template_export.export", "template_name", TestRefTemplateName),
+					resource.TestCheckResourceAttr("data.ome_template_export.export", "format", "XML"),
+					resource.TestMatchResourceAttr("data.ome_template_export.export", "content", regexp.MustCompile(`.*SystemConfiguration.*`)),
+					resource.TestCheckResourceAttrSet("data.ome_template_export.export", "attributes.%"),
+					resource.TestCheckNoResourceAttr("data.ome_template_export.export", "differences"),
+				),
+			},
+			{
+				Config: testTemplateExportCompareTemplate + temps.templateSvcTag1 + temps.templateSvcTag2,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ome_template_export.export", "compare_template_name", TestRefTemplateNameUpdate),
+					resource.TestCheckResourceAttrSet("data.ome_template_export.export", "differences.#"),
+				),
+			},
+			{
+				Config:      testTemplateExportCompareDevice + temps.templateSvcTag1 + temps.templateSvcTag2,
+				ExpectError: regexp.MustCompile(`.*no configuration baseline of template.*`),
+			},
+			{
+				Config:      testTemplateExportInvalid,
+				ExpectError: regexp.MustCompile(`.*error reading template export.*`),
+			},
+			{
+				Config:      testTemplateExportInvalidCompare,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Combination.*`),
+			},
+		},
+	})
+}
+
+func TestDataSource_TemplateExportDifferences(t *testing.T) {
+	var exportFormat string
+	strPtr := func(s string) *string { return &s }
+	mockTemplates := func() {
+		FunctionMocker = Mock((*clients.Client).GetTemplateByIDOrName).To(func(_ *clients.Client, _ int64, name string) (models.OMETemplate, error) {
+			return map[string]models.OMETemplate{
+				"tfacc_export":    {ID: 1, Name: "tfacc_export"},
+				"tfacc_reference": {ID: 2, Name: "tfacc_reference"},
+			}[name], nil
+		}).Build()
+		localMocker = Mock((*clients.Client).ExportTemplate).To(func(_ *clients.Client, _ int64, format string) (string, error) {
+			exportFormat = format
+			return "{}", nil
+		}).Build()
+		localMocker2 = Mock((*clients.Client).GetTemplateAttributes).To(func(_ *clients.Client, templateID int64, _ []models.Attribute, _ bool) ([]models.OmeAttribute, error) {
+			if templateID == 1 {
+				return []models.OmeAttribute{
+					{DisplayName: "BIOS,Boot Mode", Value: "Uefi"},
+					{DisplayName: "BIOS,Memory Test", Value: "Enabled"},
+					{DisplayName: "BIOS,Ignored", Value: "1", IsIgnored: true},
+					{DisplayName: "NIC,Speed", Value: "10G"},
+				}, nil
+			}
+			return []models.OmeAttribute{
+				{DisplayName: "BIOS,Boot Mode", Value: "Bios"},
+				{DisplayName: "BIOS,Ignored", Value: "1"},
+				{DisplayName: "NIC,Speed", Value: "10G"},
+			}, nil
+		}).Build()
+		localFunctionalMocker = Mock((*clients.Client).GetBaselines).Return([]models.OmeBaseline{
+			{ID: 10, Name: "tfacc_other_template", TemplateID: 3, BaselineTargets: []models.BaselineTarget{{ID: 10001}}},
+			{ID: 11, Name: "tfacc_other_device", TemplateID: 1, BaselineTargets: []models.BaselineTarget{{ID: 10002}}},
+			{ID: 12, Name: "tfacc_baseline", TemplateID: 1, BaselineTargets: []models.BaselineTarget{{ID: 10001}}},
+		}, nil).Build()
+	}
+	var deviceMocker, detailMocker *Mocker
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// the ignored attributes are left out, the differences are ordered by path
+			{
+				PreConfig: mockTemplates,
+				Config:    testTemplateExportMockedCompareTemplate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ome_template_export.export", "attributes.%", "3"),
+					resource.TestCheckNoResourceAttr("data.ome_template_export.export", "attributes.BIOS,Ignored"),
+					resource.TestCheckResourceAttr("data.ome_template_export.export", "differences.#", "3"),
+					resource.TestCheckResourceAttr("data.ome_template_export.export", "differences.0.path", "BIOS,Boot Mode"),
+					resource.TestCheckResourceAttr("data.ome_template_export.export", "differences.0.status", "changed"),
+					resource.TestCheckResourceAttr("data.ome_template_export.export", "differences.0.value", "Uefi"),
+					resource.TestCheckResourceAttr("data.ome_template_export.export", "differences.0.reference_value", "Bios"),
+					resource.TestCheckResourceAttr("data.ome_template_export.export", "differences.1.path", "BIOS,Ignored"),
+					resource.TestCheckResourceAttr("data.ome_template_export.export", "differences.1.status", "removed"),
+					resource.TestCheckNoResourceAttr("data.ome_template_export.export", "differences.1.value"),
+					resource.TestCheckResourceAttr("data.ome_template_export.export", "differences.2.path", "BIOS,Memory Test"),
+					resource.TestCheckResourceAttr("data.ome_template_export.export", "differences.2.status", "added"),
+					func(_ *terraform.State) error {
+						if exportFormat != "json" {
+							return fmt.Errorf("expected the json format to be exported, got %s", exportFormat)
+						}
+						return nil
+					},
+				),
+			},
+			// the baseline of the template targeting the device is used, the compliant attributes are left out
+			{
+				PreConfig: func() {
+					deviceMocker = Mock((*clients.Client).GetDevice).Return(models.Device{ID: 10001}, nil).Build()
+					detailMocker = Mock((*clients.Client).GetBaselineDeviceComplianceDetail).To(func(_ *clients.Client, baselineID, deviceID int64) (models.OMEDeviceComplianceDetail, error) {
+						if baselineID != 12 || deviceID != 10001 {
+							return models.OMEDeviceComplianceDetail{}, fmt.Errorf("unexpected baseline %d and device %d", baselineID, deviceID)
+						}
+						return models.OMEDeviceComplianceDetail{ComplianceAttributeGroups: []models.OMEComplianceAttributeGroup{
+							{
+								DisplayName: "NIC",
+								ComplianceSubAttributeGroups: []models.OMEComplianceAttributeGroup{{
+									DisplayName: "Port 1",
+									Attributes: []models.OMEComplianceAttribute{
+										{DisplayName: "Speed", ExpectedValue: strPtr("10G"), Value: strPtr("1G"), ComplianceStatus: 2},
+										{DisplayName: "Mode", ExpectedValue: strPtr("Auto"), Value: strPtr("Auto"), ComplianceStatus: 1},
+									},
+								}},
+							},
+							{
+								DisplayName: "BIOS",
+								Attributes: []models.OMEComplianceAttribute{
+									{DisplayName: "Boot Mode", ExpectedValue: strPtr("Uefi"), ComplianceStatus: 2},
+								},
+							},
+						}}, nil
+					}).Build()
+				},
+				Config: testTemplateExportMockedCompareDevice,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ome_template_export.export", "compare_baseline_name", "tfacc_baseline"),
+					resource.TestCheckResourceAttr("data.ome_template_export.export", "differences.#", "2"),
+					resource.TestCheckResourceAttr("data.ome_template_export.export", "differences.0.path", "BIOS,Boot Mode"),
+					resource.TestCheckResourceAttr("data.ome_template_export.export", "differences.0.status", "added"),
+					resource.TestCheckNoResourceAttr("data.ome_template_export.export", "differences.0.reference_value"),
+					resource.TestCheckResourceAttr("data.ome_template_export.export", "differences.1.path", "NIC,Port 1,Speed"),
+					resource.TestCheckResourceAttr("data.ome_template_export.export", "differences.1.status", "changed"),
+					resource.TestCheckResourceAttr("data.ome_template_export.export", "differences.1.value", "10G"),
+					resource.TestCheckResourceAttr("data.ome_template_export.export", "differences.1.reference_value", "1G"),
+				),
+			},
+			// a named baseline must use the template and target the device
+			{
+				Config:      testTemplateExportMockedBaseline("tfacc_other_device"),
+				ExpectError: regexp.MustCompile(`.*configuration baseline tfacc_other_device does not target device 10001.*`),
+			},
+			{
+				Config:      testTemplateExportMockedBaseline("tfacc_other_template"),
+				ExpectError: regexp.MustCompile(`.*configuration baseline tfacc_other_template does not use template 1.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.UnPatch()
+					localMocker.UnPatch()
+					localMocker2.UnPatch()
+					localFunctionalMocker.UnPatch()
+					deviceMocker.UnPatch()
+					detailMocker.UnPatch()
+				},
+				Config:      testTemplateExportInvalid,
+				ExpectError: regexp.MustCompile(`.*error reading template export.*`),
+			},
+		},
+	})
+}
+
+func testTemplateExportMockedBaseline(name string) string {
+	return testProvider + `
+	data "ome_template_export" "export" {
+		template_name = "tfacc_export"
+		compare_device_id = 10001
+		compare_baseline_name = "` + name + `"
+	}
+	`
+}
+
+var testTemplateExport = testProvider + `
+data "ome_template_export" "export" {
+	template_name = "` + TestRefTemplateName + `"
+	depends_on = [ome_template.terraform-acceptance-test-1]
+}
+`
+
+var testTemplateExportCompareTemplate = testProvider + `
+data "ome_template_export" "export" {
+	template_name = "` + TestRefTemplateName + `"
+	compare_template_name = "` + TestRefTemplateNameUpdate + `"
+	depends_on = [ome_template.terraform-acceptance-test-1, ome_template.terraform-acceptance-test-2]
+}
+`
+
+var testTemplateExportCompareDevice = testProvider + `
+data "ome_template_export" "export" {
+	template_name = "` + TestRefTemplateName + `"
+	compare_device_servicetag = "` + DeviceSvcTag1 + `"
+	depends_on = [ome_template.terraform-acceptance-test-1]
+}
+`
+
+var testTemplateExportInvalid = testProvider + `
+data "ome_template_export" "export" {
+	template_name = "invalid-template"
+}
+`
+
+var testTemplateExportInvalidCompare = testProvider + `
+data "ome_template_export" "export" {
+	template_name = "` + TestRefTemplateName + `"
+	compare_template_name = "` + TestRefTemplateNameUpdate + `"
+	compare_device_servicetag = "` + DeviceSvcTag1 + `"
+}
+`
+
+var testTemplateExportMockedCompareTemplate = testProvider + `
+data "ome_template_export" "export" {
+	template_name = "tfacc_export"
+	format = "JSON"
+	compare_template_name = "tfacc_reference"
+}
+`
+
+var testTemplateExportMockedCompareDevice = testProvider + `
+data "ome_template_export" "export" {
+	template_name = "tfacc_export"
+	compare_device_id = 10001
+}
+`
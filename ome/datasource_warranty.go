@@ -0,0 +1,113 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/helper"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &warrantyDataSource{}
+	_ datasource.DataSourceWithConfigure = &warrantyDataSource{}
+)
+
+// NewWarrantyDataSource creates a new warranty data source.
+func NewWarrantyDataSource() datasource.DataSource {
+	return &warrantyDataSource{}
+}
+
+type warrantyDataSource struct {
+	p *omeProvider
+}
+
+// Configure implements datasource.DataSourceWithConfigure
+func (g *warrantyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	g.p = req.ProviderData.(*omeProvider)
+}
+
+// Metadata implements datasource.DataSource
+func (*warrantyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "warranty"
+}
+
+// Schema implements datasource.DataSource
+func (*warrantyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform DataSource is used to query the warranty entitlements of devices from OME." +
+			" It returns the service level, start date, end date and days remaining of each entitlement" +
+			" of the devices selected by IDs, service tags or group names, optionally only those expiring within a number of days.",
+		Description: "This Terraform DataSource is used to query the warranty entitlements of devices from OME." +
+			" It returns the service level, start date, end date and days remaining of each entitlement" +
+			" of the devices selected by IDs, service tags or group names, optionally only those expiring within a number of days.",
+		Attributes: omeWarrantyDataSchema(),
+	}
+}
+
+// Read implements datasource.DataSource
+func (g *warrantyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Trace(ctx, "datasource_warranty read: started")
+	var plan models.OmeWarrantyData
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ids []int64
+	var serviceTags, groupNames []string
+	resp.Diagnostics.Append(plan.DeviceIDs.ElementsAs(ctx, &ids, true)...)
+	resp.Diagnostics.Append(plan.DeviceServiceTags.ElementsAs(ctx, &serviceTags, true)...)
+	resp.Diagnostics.Append(plan.DeviceGroupNames.ElementsAs(ctx, &groupNames, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	omeClient, d := g.p.createOMESession(ctx, "datasource_warranty Read")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	warranties, err := helper.GetWarranties(omeClient, ids, serviceTags, groupNames)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrReadWarranty, err.Error())
+		return
+	}
+
+	expiringWithinDays := int64(-1)
+	if !plan.ExpiringWithinDays.IsNull() {
+		expiringWithinDays = plan.ExpiringWithinDays.ValueInt64()
+	}
+	warranties = helper.FilterWarranties(warranties, expiringWithinDays, plan.IncludeExpired.ValueBool())
+
+	if plan.ID.IsNull() {
+		plan.ID = types.Int64Value(0)
+	}
+	plan = helper.NewWarrantyState(warranties, plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Trace(ctx, "datasource_warranty read: finished")
+}
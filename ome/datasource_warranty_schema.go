@@ -0,0 +1,160 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func omeWarrantyDataSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			MarkdownDescription: "Dummy ID of the datasource.",
+			Description:         "Dummy ID of the datasource.",
+			Computed:            true,
+		},
+		"device_ids": schema.ListAttribute{
+			MarkdownDescription: "IDs of the devices. Only one among `device_ids`, `device_service_tags` and `device_group_names` can be configured." +
+				" When none is configured, the warranties of all the devices are read.",
+			Description: "IDs of the devices. Only one among 'device_ids', 'device_service_tags' and 'device_group_names' can be configured." +
+				" When none is configured, the warranties of all the devices are read.",
+			ElementType: types.Int64Type,
+			Optional:    true,
+			Validators: []validator.List{
+				listvalidator.ConflictsWith(path.MatchRoot("device_service_tags")),
+				listvalidator.ConflictsWith(path.MatchRoot("device_group_names")),
+				listvalidator.SizeAtLeast(1),
+			},
+		},
+		"device_service_tags": schema.ListAttribute{
+			MarkdownDescription: "Service tags of the devices.",
+			Description:         "Service tags of the devices.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Validators: []validator.List{
+				listvalidator.ConflictsWith(path.MatchRoot("device_group_names")),
+				listvalidator.SizeAtLeast(1),
+				listvalidator.ValueStringsAre(
+					stringvalidator.LengthAtLeast(1),
+				),
+			},
+		},
+		"device_group_names": schema.ListAttribute{
+			MarkdownDescription: "Names of the groups of the devices.",
+			Description:         "Names of the groups of the devices.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.ValueStringsAre(
+					stringvalidator.LengthAtLeast(1),
+				),
+			},
+		},
+		"expiring_within_days": schema.Int64Attribute{
+			MarkdownDescription: "Return only the warranty entitlements ending within this number of days." +
+				" By default the entitlements are returned whatever their end date.",
+			Description: "Return only the warranty entitlements ending within this number of days." +
+				" By default the entitlements are returned whatever their end date.",
+			Optional: true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+		},
+		"include_expired": schema.BoolAttribute{
+			MarkdownDescription: "Return also the warranty entitlements which have already expired. Default value is `false`.",
+			Description:         "Return also the warranty entitlements which have already expired. Default value is 'false'.",
+			Optional:            true,
+		},
+		"min_days_remaining": schema.Int64Attribute{
+			MarkdownDescription: "Lowest number of days remaining among the returned warranty entitlements, null when none is returned.",
+			Description:         "Lowest number of days remaining among the returned warranty entitlements, null when none is returned.",
+			Computed:            true,
+		},
+		"warranties": schema.ListNestedAttribute{
+			MarkdownDescription: "Warranty entitlements of the devices.",
+			Description:         "Warranty entitlements of the devices.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: omeWarrantySchema(),
+			},
+		},
+	}
+}
+
+func omeWarrantySchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the warranty entitlement.",
+			Description:         "ID of the warranty entitlement.",
+			Computed:            true,
+		},
+		"device_id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the device.",
+			Description:         "ID of the device.",
+			Computed:            true,
+		},
+		"service_tag": schema.StringAttribute{
+			MarkdownDescription: "Service tag of the device.",
+			Description:         "Service tag of the device.",
+			Computed:            true,
+		},
+		"device_model": schema.StringAttribute{
+			MarkdownDescription: "Model of the device.",
+			Description:         "Model of the device.",
+			Computed:            true,
+		},
+		"service_level_code": schema.StringAttribute{
+			MarkdownDescription: "Code of the service level of the entitlement.",
+			Description:         "Code of the service level of the entitlement.",
+			Computed:            true,
+		},
+		"service_level_description": schema.StringAttribute{
+			MarkdownDescription: "Description of the service level of the entitlement.",
+			Description:         "Description of the service level of the entitlement.",
+			Computed:            true,
+		},
+		"service_provider": schema.StringAttribute{
+			MarkdownDescription: "Provider of the service.",
+			Description:         "Provider of the service.",
+			Computed:            true,
+		},
+		"start_date": schema.StringAttribute{
+			MarkdownDescription: "Start date of the entitlement.",
+			Description:         "Start date of the entitlement.",
+			Computed:            true,
+		},
+		"end_date": schema.StringAttribute{
+			MarkdownDescription: "End date of the entitlement.",
+			Description:         "End date of the entitlement.",
+			Computed:            true,
+		},
+		"days_remaining": schema.Int64Attribute{
+			MarkdownDescription: "Number of days remaining before the end of the entitlement.",
+			Description:         "Number of days remaining before the end of the entitlement.",
+			Computed:            true,
+		},
+		"expired": schema.BoolAttribute{
+			MarkdownDescription: "Whether the entitlement has expired.",
+			Description:         "Whether the entitlement has expired.",
+			Computed:            true,
+		},
+	}
+}
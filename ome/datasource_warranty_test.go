@@ -0,0 +1,167 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"regexp"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestDataSource_WarrantyRead(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testWarrantyByServiceTag,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ome_warranty.warranty", "warranties.0.service_tag", DeviceSvcTag1),
+					resource.TestCheckResourceAttr("data.ome_warranty.warranty", "warranties.0.expired", "false"),
+					resource.TestCheckResourceAttrSet("data.ome_warranty.warranty", "min_days_remaining"),
+				),
+			},
+			// Entitlements expiring within a year, expired ones included
+			{
+				Config: testWarrantyExpiring,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("all_expiring", "true"),
+				),
+			},
+			{
+				Config:      testWarrantyInvalidServiceTag,
+				ExpectError: regexp.MustCompile(`.*error reading warranty.*`),
+			},
+			{
+				Config:      testWarrantyConflict,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Combination.*`),
+			},
+		},
+	})
+}
+
+func TestDataSource_WarrantyFilters(t *testing.T) {
+	mockWarranties := func() {
+		FunctionMocker = Mock((*clients.Client).GetWarranties).Return([]models.Warranty{
+			{ID: 1, DeviceID: 10001, DeviceIdentifier: "TAG0001", DaysRemaining: 400},
+			{ID: 2, DeviceID: 10001, DeviceIdentifier: "TAG0001", DaysRemaining: 30},
+			{ID: 3, DeviceID: 10002, DeviceIdentifier: "TAG0002", DaysRemaining: 0},
+			{ID: 4, DeviceID: 10002, DeviceIdentifier: "TAG0002", DaysRemaining: -10},
+		}, nil).Build()
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// every entitlement is returned without filter, the ones without days remaining are expired
+			{
+				PreConfig: mockWarranties,
+				Config:    testWarrantyAll,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ome_warranty.warranty", "warranties.#", "4"),
+					resource.TestCheckResourceAttr("data.ome_warranty.warranty", "warranties.1.expired", "false"),
+					resource.TestCheckResourceAttr("data.ome_warranty.warranty", "warranties.2.expired", "true"),
+					resource.TestCheckResourceAttr("data.ome_warranty.warranty", "warranties.3.expired", "true"),
+					resource.TestCheckResourceAttr("data.ome_warranty.warranty", "min_days_remaining", "-10"),
+				),
+			},
+			// the expired entitlements are left out unless requested
+			{
+				Config: testWarrantyExpiringOnly,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ome_warranty.warranty", "warranties.#", "1"),
+					resource.TestCheckResourceAttr("data.ome_warranty.warranty", "warranties.0.id", "2"),
+					resource.TestCheckResourceAttr("data.ome_warranty.warranty", "min_days_remaining", "30"),
+				),
+			},
+			{
+				Config: testWarrantyExpiring,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ome_warranty.warranty", "warranties.#", "3"),
+					resource.TestCheckResourceAttr("data.ome_warranty.warranty", "warranties.0.id", "2"),
+					resource.TestCheckResourceAttr("data.ome_warranty.warranty", "warranties.2.id", "4"),
+				),
+			},
+			// only the entitlements of the resolved devices are kept
+			{
+				PreConfig: func() {
+					localMocker = Mock((*clients.Client).GetDevices).Return([]models.Device{{ID: 10002}}, nil).Build()
+				},
+				Config: testWarrantyByDeviceID,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ome_warranty.warranty", "warranties.#", "2"),
+					resource.TestCheckResourceAttr("data.ome_warranty.warranty", "warranties.0.service_tag", "TAG0002"),
+					resource.TestCheckResourceAttr("data.ome_warranty.warranty", "warranties.1.service_tag", "TAG0002"),
+					func(_ *terraform.State) error {
+						FunctionMocker.UnPatch()
+						localMocker.UnPatch()
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+var testWarrantyByServiceTag = testProvider + `
+data "ome_warranty" "warranty" {
+	device_service_tags = ["` + DeviceSvcTag1 + `"]
+}
+`
+
+var testWarrantyExpiring = testProvider + `
+data "ome_warranty" "warranty" {
+	expiring_within_days = 365
+	include_expired      = true
+}
+
+output "all_expiring" {
+	value = alltrue([for w in data.ome_warranty.warranty.warranties : w.days_remaining <= 365])
+}
+`
+
+var testWarrantyAll = testProvider + `
+data "ome_warranty" "warranty" {
+}
+`
+
+var testWarrantyExpiringOnly = testProvider + `
+data "ome_warranty" "warranty" {
+	expiring_within_days = 365
+}
+`
+
+var testWarrantyByDeviceID = testProvider + `
+data "ome_warranty" "warranty" {
+	device_ids = [10002]
+}
+`
+
+var testWarrantyInvalidServiceTag = testProvider + `
+data "ome_warranty" "warranty" {
+	device_service_tags = ["invalid-tag"]
+}
+`
+
+var testWarrantyConflict = testProvider + `
+data "ome_warranty" "warranty" {
+	device_ids         = [1]
+	device_group_names = ["Servers"]
+}
+`
@@ -0,0 +1,67 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"strings"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = &networkExpressionValidator{}
+
+// networkExpressionValidator validates that a string is an IP, a CIDR, an IP range or a host name,
+// IPv4 wildcards are accepted only when the targeted devices are filtered by the provider
+type networkExpressionValidator struct {
+	allowWildcard bool
+}
+
+// Description returns a plain text description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (v networkExpressionValidator) Description(ctx context.Context) string {
+	if v.allowWildcard {
+		return "value must be an IPv4 or IPv6 address, a CIDR, an IP range, an IPv4 wildcard or a host name"
+	}
+	return "value must be an IPv4 or IPv6 address, a CIDR, an IP range or a host name"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (v networkExpressionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString runs the main validation logic of the validator, reading configuration data out of `req` and updating `resp` with diagnostics.
+func (v networkExpressionValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+	network := req.ConfigValue.ValueString()
+	if !v.allowWildcard && strings.Contains(network, "*") {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			clients.ErrInvalidNetworkExpression,
+			v.Description(ctx)+", use an IP range instead of the wildcard "+network,
+		)
+		return
+	}
+	if err := utils.CheckNetwork(network); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			clients.ErrInvalidNetworkExpression,
+			v.Description(ctx)+": "+err.Error(),
+		)
+	}
+}
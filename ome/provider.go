@@ -266,6 +266,18 @@ func (p *omeProvider) Resources(ctx context.Context) []func() resource.Resource
 		NewDeviceActionResource,
 		NewFirmwareCatalogResource,
 		NewFirmwareBaselineResource,
+		NewAlertDestinationsResource,
+		NewAlertPolicyResource,
+		NewAlertActionResource,
+		NewOIDCProviderResource,
+		NewQueryGroupResource,
+		NewGroupTreeResource,
+		NewDeviceOnboardingResource,
+		NewDevicePropertiesResource,
+		NewDeviceManagementResource,
+		NewIdentityPoolResource,
+		NewVlanNetworkResource,
+		NewServerProfileResource,
 	}
 }
 
@@ -281,6 +293,14 @@ func (p *omeProvider) DataSources(ctx context.Context) []func() datasource.DataS
 		NewFirmwareBaselineComplianceRepositoryDatasource,
 		NewfwBaselineCompReportDatasource,
 		NewDeviceComplianceReportDataSource,
+		NewAlertsDataSource,
+		NewAuditLogsDataSource,
+		NewGroupHierarchyDataSource,
+		NewDeviceHealthDataSource,
+		NewWarrantyDataSource,
+		NewChassisTopologyDataSource,
+		NewTemplateExportDataSource,
+		NewDeploymentPrecheckDataSource,
 	}
 }
 
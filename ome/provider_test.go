@@ -69,6 +69,15 @@ var IdracUsername = globalEnvMap["IDRAC_USERNAME"]
 // idrac password
 var IdracPassword = globalEnvMap["IDRAC_PASSWORD"]
 
+// discovery URL of an OpenID Connect provider reachable from OME
+var OIDCDiscoveryURI = globalEnvMap["OIDC_DISCOVERY_URI"]
+
+// service tag of a modular chassis with sleds, like the PowerEdge MX7000
+var ChassisSvcTag1 = globalEnvMap["CHASSISSVCTAG1"]
+
+// service tag of an IO module of a modular chassis
+var IOMSvcTag1 = globalEnvMap["IOMSVCTAG1"]
+
 var testProvider = `
 provider "ome" {
 	username = "` + omeUserName + `"
@@ -0,0 +1,172 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/helper"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &alertActionResource{}
+	_ resource.ResourceWithConfigure = &alertActionResource{}
+)
+
+// NewAlertActionResource is a helper function to simplify the provider implementation.
+func NewAlertActionResource() resource.Resource {
+	return &alertActionResource{}
+}
+
+// alertActionResource is the resource implementation.
+type alertActionResource struct {
+	p *omeProvider
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *alertActionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.p = req.ProviderData.(*omeProvider)
+}
+
+// Metadata returns the resource type name.
+func (r *alertActionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "alert_action"
+}
+
+// Schema defines the schema for the resource.
+func (r *alertActionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This terraform resource is used to acknowledge, unacknowledge or clear alerts on OME," +
+			" for example the alerts fetched by the `ome_alerts` data source after a maintenance window." +
+			" The action runs on apply and does not support updating in-place, the resource generates a recreation plan instead." +
+			" Destroying the resource only removes it from the state.",
+		Description: "This terraform resource is used to acknowledge, unacknowledge or clear alerts on OME," +
+			" for example the alerts fetched by the 'ome_alerts' data source after a maintenance window." +
+			" The action runs on apply and does not support updating in-place, the resource generates a recreation plan instead." +
+			" Destroying the resource only removes it from the state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the alert action resource.",
+				Description:         "ID of the alert action resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"action": schema.StringAttribute{
+				MarkdownDescription: "Action to be performed on the alerts." +
+					" Accepted values are `acknowledge`, `unacknowledge` and `clear`.",
+				Description: "Action to be performed on the alerts." +
+					" Accepted values are 'acknowledge', 'unacknowledge' and 'clear'.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(helper.AlertActionAcknowledge, helper.AlertActionUnacknowledge, helper.AlertActionClear),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"alert_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the alerts on which the action would be carried out." +
+					" An empty set is accepted so that the resource can be used when no alert matches.",
+				Description: "IDs of the alerts on which the action would be carried out." +
+					" An empty set is accepted so that the resource can be used when no alert matches.",
+				Required:    true,
+				ElementType: types.Int64Type,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Create runs the action on the alerts and sets the initial Terraform state.
+func (r *alertActionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "resource_alert_action create: started")
+	var plan models.OmeAlertAction
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_alert_action Create")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	var ids []int64
+	resp.Diagnostics.Append(plan.AlertIDs.ElementsAs(ctx, &ids, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := helper.ApplyAlertAction(omeClient, plan.Action.ValueString(), ids); err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrAlertAction, err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue("alert_action")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Trace(ctx, "resource_alert_action create: finished")
+}
+
+// Read keeps the state as is, the alerts may no longer exist once the action has run.
+func (r *alertActionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "resource_alert_action read: started")
+	var state models.OmeAlertAction
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "resource_alert_action read: finished")
+}
+
+// Update is not reachable as every attribute requires replacement, the plan is copied into the state.
+func (r *alertActionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.OmeAlertAction
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the resource from the Terraform state.
+func (r *alertActionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "resource_alert_action delete: started")
+	resp.State.RemoveResource(ctx)
+	tflog.Trace(ctx, "resource_alert_action delete: finished")
+}
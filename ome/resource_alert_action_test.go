@@ -0,0 +1,160 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"terraform-provider-ome/clients"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAlertActionResource(t *testing.T) {
+	var alertActionTfName = "ome_alert_action.ack"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAlertActionAcknowledge,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(alertActionTfName, "id", "alert_action"),
+					resource.TestCheckResourceAttr(alertActionTfName, "action", "acknowledge"),
+				),
+			},
+			{
+				Config: testAlertActionAcknowledge + testAlertActionAcknowledgedOutput,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("none_unacknowledged", "true"),
+				),
+			},
+			// changing the action recreates the resource
+			{
+				Config: testAlertActionUnacknowledge,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(alertActionTfName, "action", "unacknowledge"),
+				),
+			},
+		},
+	})
+}
+
+func TestAlertActionResourceRuns(t *testing.T) {
+	var runs []string
+	record := func(action string) func(*clients.Client, []int64) error {
+		return func(_ *clients.Client, ids []int64) error {
+			slices.Sort(ids)
+			runs = append(runs, fmt.Sprintf("%s %v", action, ids))
+			return nil
+		}
+	}
+	checkRuns := func(want ...string) resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			if !slices.Equal(runs, want) {
+				return fmt.Errorf("expected the alert actions %v, got %v", want, runs)
+			}
+			return nil
+		}
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAlertActionInvalid,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Value Match.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock((*clients.Client).AcknowledgeAlerts).To(record("acknowledge")).Build()
+					localMocker = Mock((*clients.Client).UnacknowledgeAlerts).To(record("unacknowledge")).Build()
+					localMocker2 = Mock((*clients.Client).ClearAlerts).To(record("clear")).Build()
+				},
+				Config: testAlertActionIDs("acknowledge", "1, 2"),
+				Check:  checkRuns("acknowledge [1 2]"),
+			},
+			// the action only runs once for the same alerts
+			{
+				Config: testAlertActionIDs("acknowledge", "1, 2"),
+				Check:  checkRuns("acknowledge [1 2]"),
+			},
+			// a new action or new alerts recreate the resource, destroying it does not revert the previous action
+			{
+				Config: testAlertActionIDs("clear", "1, 2"),
+				Check:  checkRuns("acknowledge [1 2]", "clear [1 2]"),
+			},
+			{
+				Config: testAlertActionIDs("clear", "3"),
+				Check:  checkRuns("acknowledge [1 2]", "clear [1 2]", "clear [3]"),
+			},
+		},
+	})
+}
+
+func testAlertActionIDs(action, ids string) string {
+	return testProvider + `
+	resource "ome_alert_action" "ack" {
+		action    = "` + action + `"
+		alert_ids = [` + ids + `]
+	}
+	`
+}
+
+var testAlertActionAlerts = testProvider + `
+data "ome_alerts" "alerts" {
+	filters = {
+		acknowledged       = false
+		device_servicetags = ["` + DeviceSvcTag1 + `"]
+	}
+}
+`
+
+var testAlertActionAcknowledge = testAlertActionAlerts + `
+resource "ome_alert_action" "ack" {
+	action    = "acknowledge"
+	alert_ids = [for a in data.ome_alerts.alerts.alerts : a.id]
+}
+`
+
+var testAlertActionAcknowledgedOutput = `
+data "ome_alerts" "after" {
+	filters = {
+		acknowledged = false
+	}
+	depends_on = [ome_alert_action.ack]
+}
+
+output "none_unacknowledged" {
+	value = length([for a in data.ome_alerts.after.alerts : a if contains(ome_alert_action.ack.alert_ids, a.id)]) == 0
+}
+`
+
+var testAlertActionUnacknowledge = testProvider + `
+resource "ome_alert_action" "ack" {
+	action    = "unacknowledge"
+	alert_ids = []
+}
+`
+
+var testAlertActionInvalid = testProvider + `
+resource "ome_alert_action" "ack" {
+	action    = "invalid"
+	alert_ids = []
+}
+`
@@ -0,0 +1,253 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/helper"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &alertDestinationsResource{}
+	_ resource.ResourceWithConfigure      = &alertDestinationsResource{}
+	_ resource.ResourceWithImportState    = &alertDestinationsResource{}
+	_ resource.ResourceWithValidateConfig = &alertDestinationsResource{}
+)
+
+// NewAlertDestinationsResource is a helper function to simplify the provider implementation.
+func NewAlertDestinationsResource() resource.Resource {
+	return &alertDestinationsResource{}
+}
+
+// alertDestinationsResource is the resource implementation.
+type alertDestinationsResource struct {
+	p *omeProvider
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *alertDestinationsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.p = req.ProviderData.(*omeProvider)
+}
+
+// Metadata returns the resource type name.
+func (r *alertDestinationsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "alert_destinations"
+}
+
+// Schema defines the schema for the resource.
+func (r *alertDestinationsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This terraform resource is used to manage the SNMP trap and syslog alert destinations of OME." +
+			" We can Create, Update and Delete the OME alert destinations using this resource. We can also 'Import' the configured alert destinations from OME.",
+		Version:    1,
+		Attributes: AlertDestinationsSchema(),
+	}
+}
+
+// ValidateConfig validates the alert destinations configuration.
+func (r *alertDestinationsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data models.OmeAlertDestinations
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := helper.ValidateAlertDestinations(data); err != nil {
+		resp.Diagnostics.AddError(
+			"Attribute Error",
+			err.Error(),
+		)
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *alertDestinationsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "resource_alert_destinations create: started")
+	var plan models.OmeAlertDestinations
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_alert_destinations Create")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	state, err := r.apply(omeClient, plan, models.OmeAlertDestinations{})
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrCreateAlertDestinations, err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_alert_destinations create: finished")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *alertDestinationsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "resource_alert_destinations read: started")
+	var state models.OmeAlertDestinations
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_alert_destinations Read")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	newState, err := r.refresh(omeClient, state)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrReadAlertDestinations, err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &newState)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_alert_destinations read: finished")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *alertDestinationsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "resource_alert_destinations update: started")
+	var state, plan models.OmeAlertDestinations
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_alert_destinations Update")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	newState, err := r.apply(omeClient, plan, state)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrUpdateAlertDestinations, err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &newState)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_alert_destinations update: finished")
+}
+
+// Delete disables the managed destinations and removes the Terraform state on success.
+func (r *alertDestinationsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "resource_alert_destinations delete: started")
+	var state models.OmeAlertDestinations
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_alert_destinations Delete")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	if err := helper.DisableAlertDestinations(omeClient, state); err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrDeleteAlertDestinations, err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Trace(ctx, "resource_alert_destinations delete: finished")
+}
+
+// ImportState imports all the configured alert destinations of the appliance.
+func (r *alertDestinationsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Trace(ctx, "resource_alert_destinations import: started")
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_alert_destinations ImportState")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	snmp, err := omeClient.GetSNMPDestinations()
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrImportAlertDestinations, err.Error())
+		return
+	}
+	syslog, err := omeClient.GetSyslogDestinations()
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrImportAlertDestinations, err.Error())
+		return
+	}
+
+	state := helper.ImportStateAlertDestinations(snmp, syslog)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "resource_alert_destinations import: finished")
+}
+
+// apply updates the destinations of the plan, sends the test alerts if requested and returns the refreshed state
+func (r *alertDestinationsResource) apply(omeClient *clients.Client, plan, state models.OmeAlertDestinations) (models.OmeAlertDestinations, error) {
+	if err := helper.ApplyAlertDestinations(omeClient, plan, state); err != nil {
+		return plan, err
+	}
+	if plan.SendTest.ValueBool() {
+		if err := helper.SendTestAlertDestinations(omeClient, plan); err != nil {
+			return plan, err
+		}
+	}
+	return r.refresh(omeClient, plan)
+}
+
+// refresh reads the destinations of the given state from the appliance
+func (r *alertDestinationsResource) refresh(omeClient *clients.Client, state models.OmeAlertDestinations) (models.OmeAlertDestinations, error) {
+	snmp, err := omeClient.GetSNMPDestinations()
+	if err != nil {
+		return state, err
+	}
+	syslog, err := omeClient.GetSyslogDestinations()
+	if err != nil {
+		return state, err
+	}
+	return helper.SetStateAlertDestinations(snmp, syslog, state)
+}
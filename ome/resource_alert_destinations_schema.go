@@ -0,0 +1,198 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"terraform-provider-ome/clients"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// AlertDestinationsSchema returns the schema for the alert destinations resource
+func AlertDestinationsSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the alert destinations resource.",
+			Description:         "ID of the alert destinations resource.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"send_test": schema.BoolAttribute{
+			MarkdownDescription: "Send a test trap or syslog message to every enabled destination after the destinations are created or updated." +
+				" Default value is `false`.",
+			Description: "Send a test trap or syslog message to every enabled destination after the destinations are created or updated." +
+				" Default value is 'false'.",
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(false),
+		},
+		"snmp_destinations": schema.ListNestedAttribute{
+			MarkdownDescription: "SNMP trap destinations of the appliance. Destinations removed from the configuration are disabled on the appliance.",
+			Description:         "SNMP trap destinations of the appliance. Destinations removed from the configuration are disabled on the appliance.",
+			Optional:            true,
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(int(clients.MaxAlertDestinations)),
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: snmpDestinationSchema(),
+			},
+		},
+		"syslog_destinations": schema.ListNestedAttribute{
+			MarkdownDescription: "Syslog destinations of the appliance. Syslog messages are forwarded over UDP, the transport protocol cannot be configured." +
+				" Destinations removed from the configuration are disabled on the appliance.",
+			Description: "Syslog destinations of the appliance. Syslog messages are forwarded over UDP, the transport protocol cannot be configured." +
+				" Destinations removed from the configuration are disabled on the appliance.",
+			Optional: true,
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(int(clients.MaxAlertDestinations)),
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: syslogDestinationSchema(),
+			},
+		},
+	}
+}
+
+func snmpDestinationSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			MarkdownDescription: "Slot of the SNMP destination on the appliance, from `1` to `4`.",
+			Description:         "Slot of the SNMP destination on the appliance, from '1' to '4'.",
+			Required:            true,
+			Validators: []validator.Int64{
+				int64validator.Between(1, clients.MaxAlertDestinations),
+			},
+		},
+		"enabled": schema.BoolAttribute{
+			MarkdownDescription: "Enable the SNMP destination. Default value is `true`.",
+			Description:         "Enable the SNMP destination. Default value is 'true'.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+		},
+		"destination_address": schema.StringAttribute{
+			MarkdownDescription: "IP address or hostname of the SNMP trap receiver.",
+			Description:         "IP address or hostname of the SNMP trap receiver.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"port_number": schema.Int64Attribute{
+			MarkdownDescription: "Port of the SNMP trap receiver. Default value is `162`.",
+			Description:         "Port of the SNMP trap receiver. Default value is '162'.",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(162),
+			Validators: []validator.Int64{
+				int64validator.Between(1, 65535),
+			},
+		},
+		"snmp_version": schema.StringAttribute{
+			MarkdownDescription: "SNMP version of the trap destination. Supported values are `SNMPV1`, `SNMPV2` and `SNMPV3`.",
+			Description:         "SNMP version of the trap destination. Supported values are 'SNMPV1', 'SNMPV2' and 'SNMPV3'.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("SNMPV1", "SNMPV2", "SNMPV3"),
+			},
+		},
+		"community": schema.StringAttribute{
+			MarkdownDescription: "Community string of the trap destination. Required when `snmp_version` is `SNMPV1` or `SNMPV2`.",
+			Description:         "Community string of the trap destination. Required when 'snmp_version' is 'SNMPV1' or 'SNMPV2'.",
+			Optional:            true,
+			Sensitive:           true,
+		},
+		"username": schema.StringAttribute{
+			MarkdownDescription: "SNMP v3 username of the trap destination. Required when `snmp_version` is `SNMPV3`.",
+			Description:         "SNMP v3 username of the trap destination. Required when 'snmp_version' is 'SNMPV3'.",
+			Optional:            true,
+		},
+		"authentication_protocol": schema.StringAttribute{
+			MarkdownDescription: "SNMP v3 authentication protocol. Supported values are `SHA`, `MD5` and `NONE`.",
+			Description:         "SNMP v3 authentication protocol. Supported values are 'SHA', 'MD5' and 'NONE'.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("SHA", "MD5", "NONE"),
+			},
+		},
+		"authentication_passphrase": schema.StringAttribute{
+			MarkdownDescription: "SNMP v3 authentication passphrase. Required when `authentication_protocol` is `SHA` or `MD5`.",
+			Description:         "SNMP v3 authentication passphrase. Required when 'authentication_protocol' is 'SHA' or 'MD5'.",
+			Optional:            true,
+			Sensitive:           true,
+		},
+		"privacy_protocol": schema.StringAttribute{
+			MarkdownDescription: "SNMP v3 privacy protocol. Supported values are `DES`, `AES_128_CFB` and `NONE`.",
+			Description:         "SNMP v3 privacy protocol. Supported values are 'DES', 'AES_128_CFB' and 'NONE'.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("DES", "AES_128_CFB", "NONE"),
+			},
+		},
+		"privacy_passphrase": schema.StringAttribute{
+			MarkdownDescription: "SNMP v3 privacy passphrase. Required when `privacy_protocol` is `DES` or `AES_128_CFB`.",
+			Description:         "SNMP v3 privacy passphrase. Required when 'privacy_protocol' is 'DES' or 'AES_128_CFB'.",
+			Optional:            true,
+			Sensitive:           true,
+		},
+	}
+}
+
+func syslogDestinationSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			MarkdownDescription: "Slot of the syslog destination on the appliance, from `1` to `4`.",
+			Description:         "Slot of the syslog destination on the appliance, from '1' to '4'.",
+			Required:            true,
+			Validators: []validator.Int64{
+				int64validator.Between(1, clients.MaxAlertDestinations),
+			},
+		},
+		"enabled": schema.BoolAttribute{
+			MarkdownDescription: "Enable the syslog destination. Default value is `true`.",
+			Description:         "Enable the syslog destination. Default value is 'true'.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+		},
+		"destination_address": schema.StringAttribute{
+			MarkdownDescription: "IP address or hostname of the syslog server.",
+			Description:         "IP address or hostname of the syslog server.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"port_number": schema.Int64Attribute{
+			MarkdownDescription: "Port of the syslog server. Default value is `514`.",
+			Description:         "Port of the syslog server. Default value is '514'.",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(514),
+			Validators: []validator.Int64{
+				int64validator.Between(1, 65535),
+			},
+		},
+	}
+}
@@ -0,0 +1,313 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAlertDestinationsResource(t *testing.T) {
+	var alertDestTfName = "ome_alert_destinations.alert_dest"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAlertDestinationsCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(alertDestTfName, "id", "placeholder"),
+					resource.TestCheckResourceAttr(alertDestTfName, "snmp_destinations.#", "1"),
+					resource.TestCheckResourceAttr(alertDestTfName, "snmp_destinations.0.destination_address", "192.0.2.10"),
+					resource.TestCheckResourceAttr(alertDestTfName, "snmp_destinations.0.port_number", "162"),
+					resource.TestCheckResourceAttr(alertDestTfName, "snmp_destinations.0.snmp_version", "SNMPV2"),
+					resource.TestCheckResourceAttr(alertDestTfName, "snmp_destinations.0.enabled", "true"),
+					resource.TestCheckResourceAttr(alertDestTfName, "syslog_destinations.#", "1"),
+					resource.TestCheckResourceAttr(alertDestTfName, "syslog_destinations.0.destination_address", "192.0.2.20"),
+					resource.TestCheckResourceAttr(alertDestTfName, "syslog_destinations.0.port_number", "514"),
+				),
+			},
+			{
+				Config: testAlertDestinationsUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(alertDestTfName, "snmp_destinations.#", "1"),
+					resource.TestCheckResourceAttr(alertDestTfName, "snmp_destinations.0.snmp_version", "SNMPV3"),
+					resource.TestCheckResourceAttr(alertDestTfName, "snmp_destinations.0.username", "tfacc_user"),
+					resource.TestCheckResourceAttr(alertDestTfName, "snmp_destinations.0.authentication_protocol", "SHA"),
+					resource.TestCheckResourceAttr(alertDestTfName, "syslog_destinations.#", "2"),
+					resource.TestCheckResourceAttr(alertDestTfName, "syslog_destinations.1.port_number", "1514"),
+					resource.TestCheckResourceAttr(alertDestTfName, "syslog_destinations.1.enabled", "false"),
+				),
+			},
+			// Import testing
+			{
+				ResourceName:  alertDestTfName,
+				ImportState:   true,
+				ImportStateId: "placeholder",
+			},
+		},
+	})
+}
+
+func TestAlertDestinationsResourceValidationError(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAlertDestinationsDuplicateID,
+				ExpectError: regexp.MustCompile(`.*is configured more than once.*`),
+			},
+			{
+				Config:      testAlertDestinationsMissingCommunity,
+				ExpectError: regexp.MustCompile(`.*community is required.*`),
+			},
+			{
+				Config:      testAlertDestinationsMissingPassphrase,
+				ExpectError: regexp.MustCompile(`.*authentication_passphrase is required.*`),
+			},
+			{
+				Config:      testAlertDestinationsInvalidSlot,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Value.*`),
+			},
+		},
+	})
+}
+
+func TestAlertDestinationsResourceDisableRemoved(t *testing.T) {
+	var (
+		applySyslogDestinations func(*clients.Client, []models.SyslogDestination) error
+		appliedSyslog           []models.SyslogDestination
+	)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAlertDestinationsUpdate,
+			},
+			// the syslog slot removed from the configuration keeps its settings and is disabled
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock((*clients.Client).ApplySyslogDestinations).To(func(c *clients.Client, destinations []models.SyslogDestination) error {
+						appliedSyslog = destinations
+						return applySyslogDestinations(c, destinations)
+					}).Origin(&applySyslogDestinations).Build()
+				},
+				Config: testAlertDestinationsCreate,
+				Check: resource.ComposeTestCheckFunc(
+					func(*terraform.State) error {
+						FunctionMocker.UnPatch()
+						want := []models.SyslogDestination{
+							{ID: 1, Enabled: true, DestinationAddress: "192.0.2.20", PortNumber: 514},
+							{ID: 2, Enabled: false, DestinationAddress: "192.0.2.21", PortNumber: 1514},
+						}
+						if !reflect.DeepEqual(appliedSyslog, want) {
+							return fmt.Errorf("expected syslog destinations %v to be applied, got %v", want, appliedSyslog)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr("ome_alert_destinations.alert_dest", "syslog_destinations.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAlertDestinationsResourceSendTest(t *testing.T) {
+	var sentTraps, sentSyslog []string
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// the test messages are only sent to the enabled destinations
+			{
+				PreConfig: func() {
+					localMocker = Mock((*clients.Client).SendTestTrap).To(func(_ *clients.Client, destination models.SNMPDestination) error {
+						sentTraps = append(sentTraps, destination.DestinationAddress)
+						return nil
+					}).Build()
+					localMocker2 = Mock((*clients.Client).SendTestSyslog).To(func(_ *clients.Client, destination models.SyslogDestination) error {
+						sentSyslog = append(sentSyslog, destination.DestinationAddress)
+						return nil
+					}).Build()
+				},
+				Config: testAlertDestinationsSendTest,
+				Check: resource.ComposeTestCheckFunc(
+					func(*terraform.State) error {
+						if !reflect.DeepEqual(sentTraps, []string{"192.0.2.10"}) || !reflect.DeepEqual(sentSyslog, []string{"192.0.2.20"}) {
+							return fmt.Errorf("expected test messages to 192.0.2.10 and 192.0.2.20, sent traps to %v and syslog messages to %v",
+								sentTraps, sentSyslog)
+						}
+						return nil
+					},
+				),
+			},
+			// a failure to send the test message fails the apply
+			{
+				PreConfig: func() {
+					localMocker2.UnPatch()
+					localMocker2 = Mock((*clients.Client).SendTestSyslog).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      testAlertDestinationsSendTestUpdate,
+				ExpectError: regexp.MustCompile(`.*unable to send test message to 192.0.2.21.*`),
+			},
+		},
+	})
+}
+
+var testAlertDestinationsCreate = testProvider + `
+resource "ome_alert_destinations" "alert_dest" {
+	snmp_destinations = [
+		{
+			id                  = 1
+			destination_address = "192.0.2.10"
+			snmp_version        = "SNMPV2"
+			community           = "public"
+		}
+	]
+	syslog_destinations = [
+		{
+			id                  = 1
+			destination_address = "192.0.2.20"
+		}
+	]
+}
+`
+
+var testAlertDestinationsSendTest = testProvider + `
+resource "ome_alert_destinations" "alert_dest" {
+	send_test = true
+	snmp_destinations = [
+		{
+			id                  = 1
+			destination_address = "192.0.2.10"
+			snmp_version        = "SNMPV2"
+			community           = "public"
+		}
+	]
+	syslog_destinations = [
+		{
+			id                  = 1
+			destination_address = "192.0.2.20"
+		},
+		{
+			id                  = 2
+			destination_address = "192.0.2.21"
+			enabled             = false
+		}
+	]
+}
+`
+
+var testAlertDestinationsSendTestUpdate = testProvider + `
+resource "ome_alert_destinations" "alert_dest" {
+	send_test = true
+	syslog_destinations = [
+		{
+			id                  = 2
+			destination_address = "192.0.2.21"
+		}
+	]
+}
+`
+
+var testAlertDestinationsUpdate = testProvider + `
+resource "ome_alert_destinations" "alert_dest" {
+	snmp_destinations = [
+		{
+			id                        = 1
+			destination_address       = "192.0.2.10"
+			snmp_version              = "SNMPV3"
+			username                  = "tfacc_user"
+			authentication_protocol   = "SHA"
+			authentication_passphrase = "tfacc_auth_pass"
+			privacy_protocol          = "AES_128_CFB"
+			privacy_passphrase        = "tfacc_priv_pass"
+		}
+	]
+	syslog_destinations = [
+		{
+			id                  = 1
+			destination_address = "192.0.2.20"
+		},
+		{
+			id                  = 2
+			destination_address = "192.0.2.21"
+			port_number         = 1514
+			enabled             = false
+		}
+	]
+}
+`
+
+var testAlertDestinationsDuplicateID = testProvider + `
+resource "ome_alert_destinations" "alert_dest" {
+	syslog_destinations = [
+		{
+			id                  = 1
+			destination_address = "192.0.2.20"
+		},
+		{
+			id                  = 1
+			destination_address = "192.0.2.21"
+		}
+	]
+}
+`
+
+var testAlertDestinationsMissingCommunity = testProvider + `
+resource "ome_alert_destinations" "alert_dest" {
+	snmp_destinations = [
+		{
+			id                  = 1
+			destination_address = "192.0.2.10"
+			snmp_version        = "SNMPV1"
+		}
+	]
+}
+`
+
+var testAlertDestinationsMissingPassphrase = testProvider + `
+resource "ome_alert_destinations" "alert_dest" {
+	snmp_destinations = [
+		{
+			id                      = 1
+			destination_address     = "192.0.2.10"
+			snmp_version            = "SNMPV3"
+			username                = "tfacc_user"
+			authentication_protocol = "MD5"
+		}
+	]
+}
+`
+
+var testAlertDestinationsInvalidSlot = testProvider + `
+resource "ome_alert_destinations" "alert_dest" {
+	syslog_destinations = [
+		{
+			id                  = 5
+			destination_address = "192.0.2.20"
+		}
+	]
+}
+`
@@ -0,0 +1,282 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/helper"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &alertPolicyResource{}
+	_ resource.ResourceWithConfigure      = &alertPolicyResource{}
+	_ resource.ResourceWithImportState    = &alertPolicyResource{}
+	_ resource.ResourceWithValidateConfig = &alertPolicyResource{}
+)
+
+// NewAlertPolicyResource is a helper function to simplify the provider implementation.
+func NewAlertPolicyResource() resource.Resource {
+	return &alertPolicyResource{}
+}
+
+// alertPolicyResource is the resource implementation.
+type alertPolicyResource struct {
+	p *omeProvider
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *alertPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.p = req.ProviderData.(*omeProvider)
+}
+
+// Metadata returns the resource type name.
+func (r *alertPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "alert_policy"
+}
+
+// Schema defines the schema for the resource.
+func (r *alertPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This terraform resource is used to manage alert policies on OME." +
+			" We can Create, Update and Delete OME alert policies using this resource. We can also 'Import' an existing 'alert policy' from OME.",
+		Version:    1,
+		Attributes: AlertPolicySchema(),
+	}
+}
+
+// ValidateConfig validates the alert policy configuration.
+func (r *alertPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data models.OmeAlertPolicy
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := helper.ValidateAlertPolicy(data); err != nil {
+		resp.Diagnostics.AddError(
+			"Attribute Error",
+			err.Error(),
+		)
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *alertPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "resource_alert_policy create: started")
+	var plan models.OmeAlertPolicy
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_alert_policy Create")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	payload, err := helper.MakeAlertPolicyPayload(ctx, omeClient, plan, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrCreateAlertPolicy, err.Error())
+		return
+	}
+	policy, err := helper.CreateAlertPolicy(omeClient, payload)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrCreateAlertPolicy, err.Error())
+		return
+	}
+	policy, err = helper.SetAlertPolicyEnabled(omeClient, policy, plan.Enabled.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrCreateAlertPolicy, err.Error())
+		return
+	}
+
+	r.setState(ctx, omeClient, policy, plan, &resp.State, &resp.Diagnostics, clients.ErrGnrCreateAlertPolicy)
+	tflog.Trace(ctx, "resource_alert_policy create: finished")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *alertPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "resource_alert_policy read: started")
+	var state models.OmeAlertPolicy
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_alert_policy Read")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	policy, err := helper.GetAlertPolicy(omeClient, state.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrReadAlertPolicy, err.Error())
+		return
+	}
+
+	r.setState(ctx, omeClient, policy, state, &resp.State, &resp.Diagnostics, clients.ErrGnrReadAlertPolicy)
+	tflog.Trace(ctx, "resource_alert_policy read: finished")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *alertPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "resource_alert_policy update: started")
+	var state, plan models.OmeAlertPolicy
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_alert_policy Update")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	current, err := helper.GetAlertPolicy(omeClient, state.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrUpdateAlertPolicy, err.Error())
+		return
+	}
+	if current.DefaultPolicy || !current.Editable {
+		resp.Diagnostics.AddError(clients.ErrGnrUpdateAlertPolicy, fmt.Sprintf(clients.ErrAlertPolicyNotEditable, current.Name))
+		return
+	}
+
+	payload, err := helper.MakeAlertPolicyPayload(ctx, omeClient, plan, &current)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrUpdateAlertPolicy, err.Error())
+		return
+	}
+	policy, err := helper.UpdateAlertPolicy(omeClient, payload)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrUpdateAlertPolicy, err.Error())
+		return
+	}
+	policy, err = helper.SetAlertPolicyEnabled(omeClient, policy, plan.Enabled.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrUpdateAlertPolicy, err.Error())
+		return
+	}
+
+	r.setState(ctx, omeClient, policy, plan, &resp.State, &resp.Diagnostics, clients.ErrGnrUpdateAlertPolicy)
+	tflog.Trace(ctx, "resource_alert_policy update: finished")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *alertPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "resource_alert_policy delete: started")
+	var state models.OmeAlertPolicy
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_alert_policy Delete")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	if err := helper.DeleteAlertPolicy(omeClient, state.ID.ValueInt64()); err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrDeleteAlertPolicy, err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Trace(ctx, "resource_alert_policy delete: finished")
+}
+
+// ImportState imports an existing alert policy by id.
+func (r *alertPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Trace(ctx, "resource_alert_policy import: started")
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrImportAlertPolicy, fmt.Sprintf("invalid alert policy id %s: %s", req.ID, err.Error()))
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_alert_policy ImportState")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	policy, err := helper.GetAlertPolicy(omeClient, id)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrImportAlertPolicy, err.Error())
+		return
+	}
+
+	r.setState(ctx, omeClient, policy, helper.ImportStateAlertPolicy(policy), &resp.State, &resp.Diagnostics, clients.ErrGnrImportAlertPolicy)
+	tflog.Trace(ctx, "resource_alert_policy import: finished")
+}
+
+// setState maps the alert policy of the appliance into the terraform state
+func (r *alertPolicyResource) setState(ctx context.Context, omeClient *clients.Client, policy models.AlertPolicy, prior models.OmeAlertPolicy,
+	tfState *tfsdk.State, diags *diag.Diagnostics, summary string) {
+	allCatalogs, err := helper.GetAlertCategories(omeClient)
+	if err != nil {
+		diags.AddError(summary, err.Error())
+		return
+	}
+	deviceServiceTags := map[int64]string{}
+	if !prior.DeviceServicetags.IsNull() && !prior.DeviceServicetags.IsUnknown() {
+		deviceServiceTags, err = helper.GetAlertPolicyDeviceServiceTags(omeClient, policy)
+		if err != nil {
+			diags.AddError(summary, err.Error())
+			return
+		}
+	}
+	state, d := helper.SetStateAlertPolicy(ctx, policy, allCatalogs, prior, deviceServiceTags)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+	diags.Append(tfState.Set(ctx, &state)...)
+}
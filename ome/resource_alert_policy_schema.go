@@ -0,0 +1,194 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AlertPolicySchema returns the schema for the alert policy resource
+func AlertPolicySchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the alert policy.",
+			Description:         "ID of the alert policy.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the alert policy.",
+			Description:         "Name of the alert policy.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Description of the alert policy.",
+			Description:         "Description of the alert policy.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(""),
+		},
+		"enabled": schema.BoolAttribute{
+			MarkdownDescription: "Enable the alert policy. Default value is `true`.",
+			Description:         "Enable the alert policy. Default value is 'true'.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+		},
+		"device_ids": schema.SetAttribute{
+			MarkdownDescription: "List of the device id on which the alert policy applies." +
+				" At least one of `device_ids`, `device_servicetags` or `group_ids` is required.",
+			Description: "List of the device id on which the alert policy applies." +
+				" At least one of 'device_ids', 'device_servicetags' or 'group_ids' is required.",
+			ElementType: types.Int64Type,
+			Optional:    true,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+			},
+		},
+		"device_servicetags": schema.SetAttribute{
+			MarkdownDescription: "List of the device servicetag on which the alert policy applies." +
+				" At least one of `device_ids`, `device_servicetags` or `group_ids` is required.",
+			Description: "List of the device servicetag on which the alert policy applies." +
+				" At least one of 'device_ids', 'device_servicetags' or 'group_ids' is required.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+			},
+		},
+		"group_ids": schema.SetAttribute{
+			MarkdownDescription: "List of the device group id on which the alert policy applies, for example the id of an `ome_static_group`." +
+				" At least one of `device_ids`, `device_servicetags` or `group_ids` is required.",
+			Description: "List of the device group id on which the alert policy applies, for example the id of an 'ome_static_group'." +
+				" At least one of 'device_ids', 'device_servicetags' or 'group_ids' is required.",
+			ElementType: types.Int64Type,
+			Optional:    true,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+			},
+		},
+		"severities": schema.SetAttribute{
+			MarkdownDescription: "Severities of the alerts on which the alert policy applies." +
+				" Supported values are `Unknown`, `Info`, `Normal`, `Warning` and `Critical`.",
+			Description: "Severities of the alerts on which the alert policy applies." +
+				" Supported values are 'Unknown', 'Info', 'Normal', 'Warning' and 'Critical'.",
+			ElementType: types.StringType,
+			Required:    true,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+				setvalidator.ValueStringsAre(
+					stringvalidator.OneOf("Unknown", "Info", "Normal", "Warning", "Critical"),
+				),
+			},
+		},
+		"categories": schema.ListNestedAttribute{
+			MarkdownDescription: "Message catalogs and categories of the alerts on which the alert policy applies." +
+				" If not set, the alert policy applies to all the categories of all the catalogs.",
+			Description: "Message catalogs and categories of the alerts on which the alert policy applies." +
+				" If not set, the alert policy applies to all the categories of all the catalogs.",
+			Optional: true,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"catalog_name": schema.StringAttribute{
+						MarkdownDescription: "Name of the message catalog, for example `iDRAC` or `Application`.",
+						Description:         "Name of the message catalog, for example 'iDRAC' or 'Application'.",
+						Required:            true,
+					},
+					"categories": schema.SetAttribute{
+						MarkdownDescription: "Names of the categories of the catalog. If not set, all the categories of the catalog are included.",
+						Description:         "Names of the categories of the catalog. If not set, all the categories of the catalog are included.",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+					"sub_categories": schema.SetAttribute{
+						MarkdownDescription: "Names of the sub categories of the selected categories. If not set, all the sub categories are included.",
+						Description:         "Names of the sub categories of the selected categories. If not set, all the sub categories are included.",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+				},
+			},
+		},
+		"schedule": schema.SingleNestedAttribute{
+			MarkdownDescription: "Time window in which the alert policy is active. If not set, the alert policy is always active.",
+			Description:         "Time window in which the alert policy is active. If not set, the alert policy is always active.",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"start_time": schema.StringAttribute{
+					MarkdownDescription: "Start of the schedule in the format `yyyy-MM-dd HH:mm:ss.SSS`.",
+					Description:         "Start of the schedule in the format 'yyyy-MM-dd HH:mm:ss.SSS'.",
+					Required:            true,
+				},
+				"end_time": schema.StringAttribute{
+					MarkdownDescription: "End of the schedule in the format `yyyy-MM-dd HH:mm:ss.SSS`. If not set, the schedule does not end.",
+					Description:         "End of the schedule in the format 'yyyy-MM-dd HH:mm:ss.SSS'. If not set, the schedule does not end.",
+					Optional:            true,
+					Computed:            true,
+					Default:             stringdefault.StaticString(""),
+				},
+				"cron_string": schema.StringAttribute{
+					MarkdownDescription: "Cron expression of the days on which the alert policy is active." +
+						" Default value is `* * * ? * * *` which is every day, use `* * * ? * mon,wed,fri *` to select days.",
+					Description: "Cron expression of the days on which the alert policy is active." +
+						" Default value is '* * * ? * * *' which is every day, use '* * * ? * mon,wed,fri *' to select days.",
+					Optional: true,
+					Computed: true,
+					Default:  stringdefault.StaticString("* * * ? * * *"),
+				},
+			},
+		},
+		"actions": schema.ListNestedAttribute{
+			MarkdownDescription: "Actions of the alert policy. The action `Ignore` cannot be combined with other actions.",
+			Description:         "Actions of the alert policy. The action 'Ignore' cannot be combined with other actions.",
+			Required:            true,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Name of the alert action template, for example `Email`, `Trap`, `Syslog`, `PowerControl`, `RemoteCommand` or `Ignore`.",
+						Description:         "Name of the alert action template, for example 'Email', 'Trap', 'Syslog', 'PowerControl', 'RemoteCommand' or 'Ignore'.",
+						Required:            true,
+					},
+					"parameters": schema.MapAttribute{
+						MarkdownDescription: "Parameters of the action by name, for example `subject`, `to` and `message` for `Email`." +
+							" Parameters which are not set use the default value of the action template.",
+						Description: "Parameters of the action by name, for example 'subject', 'to' and 'message' for 'Email'." +
+							" Parameters which are not set use the default value of the action template.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+				},
+			},
+		},
+	}
+}
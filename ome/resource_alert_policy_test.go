@@ -0,0 +1,299 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/helper"
+	"terraform-provider-ome/models"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAlertPolicyResource(t *testing.T) {
+	var alertPolicyTfName = "ome_alert_policy.policy"
+	var getAlertPolicy func(*clients.Client, int64) (models.AlertPolicy, error)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAlertPolicyCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(alertPolicyTfName, "name", "tfacc_alert_policy"),
+					resource.TestCheckResourceAttr(alertPolicyTfName, "enabled", "true"),
+					resource.TestCheckResourceAttr(alertPolicyTfName, "device_servicetags.#", "1"),
+					resource.TestCheckResourceAttr(alertPolicyTfName, "severities.#", "2"),
+					resource.TestCheckResourceAttr(alertPolicyTfName, "actions.#", "1"),
+					resource.TestCheckResourceAttr(alertPolicyTfName, "actions.0.name", "Email"),
+				),
+			},
+			// the devices added on the appliance are detected by service tag
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock(helper.GetAlertPolicy).To(func(client *clients.Client, id int64) (models.AlertPolicy, error) {
+						policy, err := getAlertPolicy(client, id)
+						devID, _ := strconv.ParseInt(DeviceID2, 10, 64)
+						policy.PolicyData.Devices = append(policy.PolicyData.Devices, devID)
+						return policy, err
+					}).Origin(&getAlertPolicy).Build()
+				},
+				Config:             testAlertPolicyCreate,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.UnPatch()
+				},
+				Config: testAlertPolicyCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(alertPolicyTfName, "device_servicetags.#", "1"),
+					resource.TestCheckTypeSetElemAttr(alertPolicyTfName, "device_servicetags.*", DeviceSvcTag1),
+				),
+			},
+			{
+				Config: testAlertPolicyUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(alertPolicyTfName, "name", "tfacc_alert_policy_update"),
+					resource.TestCheckResourceAttr(alertPolicyTfName, "enabled", "false"),
+					resource.TestCheckResourceAttr(alertPolicyTfName, "device_ids.#", "1"),
+					resource.TestCheckResourceAttr(alertPolicyTfName, "categories.0.catalog_name", "iDRAC"),
+					resource.TestCheckResourceAttr(alertPolicyTfName, "schedule.cron_string", "* * * ? * mon,wed,fri *"),
+					resource.TestCheckResourceAttr(alertPolicyTfName, "actions.#", "2"),
+				),
+			},
+			// Import testing
+			{
+				ResourceName: alertPolicyTfName,
+				ImportState:  true,
+			},
+		},
+	})
+}
+
+func TestAlertPolicyResourceValidationError(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAlertPolicyNoTargets,
+				ExpectError: regexp.MustCompile(`.*at least one of device_ids, device_servicetags or group_ids is\s*required.*`),
+			},
+			{
+				Config:      testAlertPolicyIgnoreCombined,
+				ExpectError: regexp.MustCompile(`.*cannot be combined with other actions.*`),
+			},
+			{
+				Config:      testAlertPolicyInvalidSeverity,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Value Match.*`),
+			},
+			{
+				Config:      testAlertPolicyInvalidAction,
+				ExpectError: regexp.MustCompile(`.*invalid action.*`),
+			},
+			{
+				Config:      testAlertPolicyInvalidCatalog,
+				ExpectError: regexp.MustCompile(`.*invalid catalog name.*`),
+			},
+		},
+	})
+}
+
+func TestAlertPolicyResourcePayload(t *testing.T) {
+	var (
+		createAlertPolicy, updateAlertPolicy func(*clients.Client, models.AlertPolicy) (models.AlertPolicy, error)
+		getAlertPolicy                       func(*clients.Client, int64) (models.AlertPolicy, error)
+		payload                              models.AlertPolicy
+	)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// the severities, devices and actions are resolved and the policy is active every day by default
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock(helper.CreateAlertPolicy).To(func(c *clients.Client, p models.AlertPolicy) (models.AlertPolicy, error) {
+						payload = p
+						return createAlertPolicy(c, p)
+					}).Origin(&createAlertPolicy).Build()
+				},
+				Config: testAlertPolicyCreate,
+				Check: resource.ComposeTestCheckFunc(
+					func(*terraform.State) error {
+						FunctionMocker.UnPatch()
+						if !reflect.DeepEqual(payload.PolicyData.Severities, []int64{8, 16}) {
+							return fmt.Errorf("expected severities [8 16], got %v", payload.PolicyData.Severities)
+						}
+						if len(payload.PolicyData.Devices) != 1 || fmt.Sprint(payload.PolicyData.Devices[0]) != DeviceID1 {
+							return fmt.Errorf("expected service tag %s to be resolved to device %s, got %v", DeviceSvcTag1, DeviceID1, payload.PolicyData.Devices)
+						}
+						if payload.PolicyData.Schedule.CronString != "* * * ? * * *" || payload.PolicyData.Schedule.StartTime == "" {
+							return fmt.Errorf("expected the default schedule, got %+v", payload.PolicyData.Schedule)
+						}
+						if len(payload.PolicyData.Actions) != 1 || payload.PolicyData.Actions[0].TemplateID == 0 {
+							return fmt.Errorf("expected the Email action to be resolved to its template, got %+v", payload.PolicyData.Actions)
+						}
+						return nil
+					},
+				),
+			},
+			// the update keeps the id of the policy and resolves the categories of the catalog
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock(helper.UpdateAlertPolicy).To(func(c *clients.Client, p models.AlertPolicy) (models.AlertPolicy, error) {
+						payload = p
+						return updateAlertPolicy(c, p)
+					}).Origin(&updateAlertPolicy).Build()
+				},
+				Config: testAlertPolicyUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					func(s *terraform.State) error {
+						FunctionMocker.UnPatch()
+						if fmt.Sprint(payload.ID) != s.RootModule().Resources["ome_alert_policy.policy"].Primary.ID {
+							return fmt.Errorf("expected the policy to be updated in place, got id %d", payload.ID)
+						}
+						if len(payload.PolicyData.Catalogs) != 1 || payload.PolicyData.Catalogs[0].CatalogName != "iDRAC" ||
+							len(payload.PolicyData.Catalogs[0].Categories) != 1 {
+							return fmt.Errorf("expected the System Health category of iDRAC, got %+v", payload.PolicyData.Catalogs)
+						}
+						if payload.PolicyData.Schedule.CronString != "* * * ? * mon,wed,fri *" {
+							return fmt.Errorf("expected the configured cron string, got %s", payload.PolicyData.Schedule.CronString)
+						}
+						return nil
+					},
+				),
+			},
+			// the default policies cannot be modified
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock(helper.GetAlertPolicy).To(func(c *clients.Client, id int64) (models.AlertPolicy, error) {
+						policy, err := getAlertPolicy(c, id)
+						policy.DefaultPolicy = true
+						return policy, err
+					}).Origin(&getAlertPolicy).Build()
+				},
+				Config:      testAlertPolicyCreate,
+				ExpectError: regexp.MustCompile(`.*is a default policy and cannot be modified.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.UnPatch()
+				},
+				Config: testAlertPolicyCreate,
+			},
+		},
+	})
+}
+
+var testAlertPolicyCreate = testProvider + `
+resource "ome_alert_policy" "policy" {
+	name               = "tfacc_alert_policy"
+	description        = "terraform acceptance test alert policy"
+	device_servicetags = ["` + DeviceSvcTag1 + `"]
+	severities         = ["Critical", "Warning"]
+	actions = [
+		{
+			name = "Email"
+			parameters = {
+				subject = "Device Name: $name,  Device IP Address: $ip,  Severity: $severity"
+				to      = "admin@example.com"
+			}
+		}
+	]
+}
+`
+
+var testAlertPolicyUpdate = testProvider + `
+resource "ome_alert_policy" "policy" {
+	name       = "tfacc_alert_policy_update"
+	enabled    = false
+	device_ids = [` + DeviceID1 + `]
+	severities = ["Critical"]
+	categories = [
+		{
+			catalog_name = "iDRAC"
+			categories   = ["System Health"]
+		}
+	]
+	schedule = {
+		start_time  = "2025-01-01 00:00:00.000"
+		cron_string = "* * * ? * mon,wed,fri *"
+	}
+	actions = [
+		{
+			name = "Email"
+			parameters = {
+				to = "admin@example.com"
+			}
+		},
+		{
+			name = "Trap"
+		}
+	]
+}
+`
+
+var testAlertPolicyNoTargets = testProvider + `
+resource "ome_alert_policy" "policy" {
+	name       = "tfacc_alert_policy"
+	severities = ["Critical"]
+	actions    = [{ name = "Trap" }]
+}
+`
+
+var testAlertPolicyIgnoreCombined = testProvider + `
+resource "ome_alert_policy" "policy" {
+	name       = "tfacc_alert_policy"
+	device_ids = [` + DeviceID1 + `]
+	severities = ["Critical"]
+	actions    = [{ name = "Ignore" }, { name = "Trap" }]
+}
+`
+
+var testAlertPolicyInvalidSeverity = testProvider + `
+resource "ome_alert_policy" "policy" {
+	name       = "tfacc_alert_policy"
+	device_ids = [` + DeviceID1 + `]
+	severities = ["Fatal"]
+	actions    = [{ name = "Trap" }]
+}
+`
+
+var testAlertPolicyInvalidAction = testProvider + `
+resource "ome_alert_policy" "policy" {
+	name       = "tfacc_alert_policy"
+	device_ids = [` + DeviceID1 + `]
+	severities = ["Critical"]
+	actions    = [{ name = "invalid" }]
+}
+`
+
+var testAlertPolicyInvalidCatalog = testProvider + `
+resource "ome_alert_policy" "policy" {
+	name       = "tfacc_alert_policy"
+	device_ids = [` + DeviceID1 + `]
+	severities = ["Critical"]
+	categories = [{ catalog_name = "invalid" }]
+	actions    = [{ name = "Trap" }]
+}
+`
@@ -17,17 +17,28 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
+	"sort"
 	"strconv"
+	"strings"
 	"terraform-provider-ome/clients"
+	"terraform-provider-ome/helper"
 	"terraform-provider-ome/models"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -38,8 +49,36 @@ var (
 	_ resource.Resource                = &resourceDeployment{}
 	_ resource.ResourceWithConfigure   = &resourceDeployment{}
 	_ resource.ResourceWithImportState = &resourceDeployment{}
+	_ resource.ResourceWithModifyPlan  = &resourceDeployment{}
 )
 
+// deviceAttributeOverridesType is the type of an entry of the device attribute overrides
+var deviceAttributeOverridesType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"device_servicetags": types.SetType{ElemType: types.StringType},
+		"attributes":         types.MapType{ElemType: types.StringType},
+	},
+}
+
+// chassisOptionsType is the type of the settings of the lead chassis
+var chassisOptionsType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"power_settings":   types.MapType{ElemType: types.StringType},
+		"network_settings": types.MapType{ElemType: types.StringType},
+	},
+}
+
+// deploymentDeviceStatusType is the type of the deployment status of a target device
+var deploymentDeviceStatusType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"device_id":         types.Int64Type,
+		"device_servicetag": types.StringType,
+		"status":            types.StringType,
+		"message":           types.StringType,
+		"job_id":            types.Int64Type,
+	},
+}
+
 // NewDeploymentResource is a new resource for deployment
 func NewDeploymentResource() resource.Resource {
 	return &resourceDeployment{}
@@ -114,8 +153,8 @@ func (r resourceDeployment) Schema(_ context.Context, _ resource.SchemaRequest,
 				Optional:    true,
 			},
 			"boot_to_network_iso": schema.ObjectAttribute{
-				MarkdownDescription: "Boot To Network ISO deployment details.",
-				Description:         "Boot To Network ISO deployment details.",
+				MarkdownDescription: "Boot To Network ISO deployment details. Only applicable to the templates of servers.",
+				Description:         "Boot To Network ISO deployment details. Only applicable to the templates of servers.",
 				Optional:            true,
 				AttributeTypes: map[string]attr.Type{
 					"boot_to_network": types.BoolType,
@@ -200,6 +239,168 @@ func (r resourceDeployment) Schema(_ context.Context, _ resource.SchemaRequest,
 					},
 				},
 			},
+			"device_attribute_overrides": schema.ListNestedAttribute{
+				MarkdownDescription: "List of attribute values of the target devices for deployment, keyed by the path of their display names" +
+					" like 'iDRAC,NIC Information,DNS Domain Name'. The leading group names can be omitted as long as the path matches a single attribute." +
+					" The paths and values are checked against the attributes of the template during the plan." +
+					" Conflicts with `device_attributes`.",
+				Description: "List of attribute values of the target devices for deployment, keyed by the path of their display names" +
+					" like 'iDRAC,NIC Information,DNS Domain Name'. The leading group names can be omitted as long as the path matches a single attribute." +
+					" The paths and values are checked against the attributes of the template during the plan." +
+					" Conflicts with 'device_attributes'.",
+				Optional: true,
+				Validators: []validator.List{
+					listvalidator.ConflictsWith(path.MatchRoot("device_attributes")),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"device_servicetags": schema.SetAttribute{
+							MarkdownDescription: "Service tags of the target devices the attribute values apply to.",
+							Description:         "Service tags of the target devices the attribute values apply to.",
+							ElementType:         types.StringType,
+							Required:            true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+						},
+						"attributes": schema.MapAttribute{
+							MarkdownDescription: "Values of the attributes keyed by their path.",
+							Description:         "Values of the attributes keyed by their path.",
+							ElementType:         types.StringType,
+							Required:            true,
+							Validators: []validator.Map{
+								mapvalidator.SizeAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+			"chassis_options": schema.SingleNestedAttribute{
+				MarkdownDescription: "Settings of the lead chassis for the deployment of a chassis template, keyed by the path of their display names like in `device_attribute_overrides`." +
+					" The settings apply to the lead of the multi-chassis management group among the target devices, or to the single target chassis." +
+					" Only applicable to the templates of chassis.",
+				Description: "Settings of the lead chassis for the deployment of a chassis template, keyed by the path of their display names like in 'device_attribute_overrides'." +
+					" The settings apply to the lead of the multi-chassis management group among the target devices, or to the single target chassis." +
+					" Only applicable to the templates of chassis.",
+				Optional: true,
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(path.MatchRoot("device_attributes")),
+				},
+				Attributes: map[string]schema.Attribute{
+					"power_settings": schema.MapAttribute{
+						MarkdownDescription: "Values of the power attributes of the lead chassis, like the power cap or the redundancy policy, keyed by their path.",
+						Description:         "Values of the power attributes of the lead chassis, like the power cap or the redundancy policy, keyed by their path.",
+						ElementType:         types.StringType,
+						Optional:            true,
+						Validators: []validator.Map{
+							mapvalidator.SizeAtLeast(1),
+						},
+					},
+					"network_settings": schema.MapAttribute{
+						MarkdownDescription: "Values of the network attributes of the lead chassis, like the management VLAN or the DNS settings, keyed by their path.",
+						Description:         "Values of the network attributes of the lead chassis, like the management VLAN or the DNS settings, keyed by their path.",
+						ElementType:         types.StringType,
+						Optional:            true,
+						Validators: []validator.Map{
+							mapvalidator.SizeAtLeast(1),
+						},
+					},
+				},
+			},
+			"template_device_type": schema.StringAttribute{
+				MarkdownDescription: "Device type of the template, one of `Server`, `Chassis` or `IO Module`." +
+					" The templates of chassis are deployed to chassis and the templates of IO modules to IO modules.",
+				Description: "Device type of the template, one of 'Server', 'Chassis' or 'IO Module'." +
+					" The templates of chassis are deployed to chassis and the templates of IO modules to IO modules.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"batch_size": schema.Int64Attribute{
+				MarkdownDescription: "Number of target devices deployed by each deployment job." +
+					" The devices are deployed in batches, one after another, and all the devices are deployed by a single job when not set." +
+					" Cannot be used with `run_later`.",
+				Description: "Number of target devices deployed by each deployment job." +
+					" The devices are deployed in batches, one after another, and all the devices are deployed by a single job when not set." +
+					" Cannot be used with 'run_later'.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_failures": schema.Int64Attribute{
+				MarkdownDescription: "Number of devices allowed to fail before the deployment to the remaining batches is stopped." +
+					" The deployment is not stopped when not set. The apply fails once the deployment is stopped, after saving the status of the deployed devices." +
+					" The pending devices are deployed by the next apply, and the failed devices as well with `retry_failed_devices`.",
+				Description: "Number of devices allowed to fail before the deployment to the remaining batches is stopped." +
+					" The deployment is not stopped when not set. The apply fails once the deployment is stopped, after saving the status of the deployed devices." +
+					" The pending devices are deployed by the next apply, and the failed devices as well with 'retry_failed_devices'.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"pause_between_batches": schema.Int64Attribute{
+				MarkdownDescription: "Time to wait between two batches in seconds.",
+				Description:         "Time to wait between two batches in seconds.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+					int64validator.AlsoRequires(path.MatchRoot("batch_size")),
+				},
+			},
+			"retry_failed_devices": schema.BoolAttribute{
+				MarkdownDescription: "Deploy the template again to the failed devices of `device_status`, after removing the server profiles left by their deployment." +
+					" The failed devices are left as they are when not set, the status of their deployment jobs is read again by the refresh." +
+					" Default value is `false`.",
+				Description: "Deploy the template again to the failed devices of 'device_status', after removing the server profiles left by their deployment." +
+					" The failed devices are left as they are when not set, the status of their deployment jobs is read again by the refresh." +
+					" Default value is 'false'.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					BoolDefaultValue(types.BoolValue(false)),
+				},
+			},
+			"device_status": schema.ListNestedAttribute{
+				MarkdownDescription: "Deployment status of the target devices." +
+					" The refresh reads the status of the deployment jobs again, and the deployed devices of a server template whose server profile was removed are pending again." +
+					" The chassis and IO module templates leave no server profile, all their targets are kept in the device status whatever the outcome of their deployment.",
+				Description: "Deployment status of the target devices." +
+					" The refresh reads the status of the deployment jobs again, and the deployed devices of a server template whose server profile was removed are pending again." +
+					" The chassis and IO module templates leave no server profile, all their targets are kept in the device status whatever the outcome of their deployment.",
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"device_id": schema.Int64Attribute{
+							MarkdownDescription: "ID of the device.",
+							Description:         "ID of the device.",
+							Computed:            true,
+						},
+						"device_servicetag": schema.StringAttribute{
+							MarkdownDescription: "Service tag of the device.",
+							Description:         "Service tag of the device.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Deployment status of the device, one of `deployed`, `failed`, `pending` or `scheduled`.",
+							Description:         "Deployment status of the device, one of 'deployed', 'failed', 'pending' or 'scheduled'.",
+							Computed:            true,
+						},
+						"message": schema.StringAttribute{
+							MarkdownDescription: "Message of the deployment job for the device.",
+							Description:         "Message of the deployment job for the device.",
+							Computed:            true,
+						},
+						"job_id": schema.Int64Attribute{
+							MarkdownDescription: "ID of the deployment job of the device.",
+							Description:         "ID of the deployment job of the device.",
+							Computed:            true,
+						},
+					},
+				},
+			},
 			"job_retry_count": schema.Int64Attribute{
 				MarkdownDescription: "Number of times the job has to be polled to get the final status of the resource." +
 					" Default value is `20`.",
@@ -293,6 +494,18 @@ func (r resourceDeployment) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	err = helper.ValidateTemplateTargets(omeTemplate, devices)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			clients.ErrTemplateDeploymentCreate, err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(checkTemplateTypeOptions(omeTemplate, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	_, deviceIDs, _ := omeClient.GetUniqueDevicesIdsAndServiceTags(devices)
 
 	options := getOptions(plan)
@@ -325,34 +538,36 @@ func (r resourceDeployment) Create(ctx context.Context, req resource.CreateReque
 	if len(plan.DeviceAttributes.Elements()) > 0 {
 		deploymentRequest.Attributes = getDeviceAttributes(ctx, devices, plan)
 	}
+	if len(plan.DeviceAttributeOverrides.Elements()) > 0 || !plan.ChassisOptions.IsNull() {
+		deploymentRequest.Attributes, diags = getDeviceAttributeOverrides(ctx, omeClient, omeTemplate.ID, devices, plan, clients.ErrTemplateDeploymentCreate)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
-	tflog.Trace(ctx, "resource_deploy create: started creating deployment job")
+	tflog.Trace(ctx, "resource_deploy create: started creating deployment jobs")
 
-	deploymentJobID, err := omeClient.CreateDeployment(deploymentRequest)
-	if err != nil {
+	results, deployErr := helper.DeployTemplateInBatches(ctx, omeClient, deploymentRequest, getBatchOptions(plan))
+	if deployErr != nil && len(results) == 0 {
 		resp.Diagnostics.AddError(
-			clients.ErrTemplateDeploymentCreate, err.Error(),
+			clients.ErrTemplateDeploymentCreate, deployErr.Error(),
 		)
 		return
 	}
+	addDeploymentWarnings(&resp.Diagnostics, clients.ErrTemplateDeploymentCreate, results)
 
-	tflog.Debug(ctx, "resource_deploy create: finished creating deployment job", map[string]interface{}{
-		"deploymentJobID": deploymentJobID,
+	tflog.Debug(ctx, "resource_deploy create: finished deployment jobs", map[string]interface{}{
+		"results": results,
 	})
 
-	if !plan.RunLater.ValueBool() {
-		tflog.Trace(ctx, "resource_deploy create: started job tracking")
-		isSuccess, message := omeClient.TrackJob(deploymentJobID, plan.JobRetryCount.ValueInt64(), plan.SleepInterval.ValueInt64())
-		if !isSuccess {
-			resp.Diagnostics.AddWarning(
-				clients.ErrTemplateDeploymentCreate, message,
-			)
-		}
-	}
-
 	tflog.Trace(ctx, "resource_deploy create: updating state started")
 
-	stateUpdateErr := updateDeploymentState(&templateDeploymentState, &plan, omeTemplate.ID, omeTemplate.Name, omeClient, usedDeviceInput)
+	// the deployed targets of the chassis and IOM templates are known from the device status only
+	templateDeploymentState.TemplateDeviceType = types.StringValue(helper.TemplateTypeName(omeTemplate.TypeID))
+	templateDeploymentState.DeviceStatus, diags = getDeviceStatus(ctx, devices, results, nil, types.ListNull(deploymentDeviceStatusType))
+	resp.Diagnostics.Append(diags...)
+	stateUpdateErr := updateDeploymentState(ctx, &templateDeploymentState, &plan, omeTemplate.ID, omeTemplate.Name, omeClient, usedDeviceInput)
 	if stateUpdateErr != nil {
 		resp.Diagnostics.AddError(
 			clients.ErrTemplateDeploymentCreate, stateUpdateErr.Error(),
@@ -363,6 +578,12 @@ func (r resourceDeployment) Create(ctx context.Context, req resource.CreateReque
 	// Save into State
 	diags = resp.State.Set(ctx, &templateDeploymentState)
 	resp.Diagnostics.Append(diags...)
+	// the devices deployed before the deployment stopped are saved, the apply fails afterwards
+	if deployErr != nil {
+		resp.Diagnostics.AddError(
+			clients.ErrTemplateDeploymentCreate, deployErr.Error(),
+		)
+	}
 	tflog.Trace(ctx, "resource_deploy create: finish")
 }
 
@@ -413,7 +634,19 @@ func (r resourceDeployment) Read(ctx context.Context, req resource.ReadRequest,
 	defer omeClient.RemoveSession()
 
 	tflog.Trace(ctx, "resource_deploy read: client created started updating state")
-	stateUpdateErr := updateDeploymentState(&stateTemplateDeployment, &stateTemplateDeployment, templateID, templateName, omeClient, usedDeviceInput)
+	// the deployments of the older states are deployments of server templates
+	if stateTemplateDeployment.TemplateDeviceType.IsNull() {
+		stateTemplateDeployment.TemplateDeviceType = types.StringValue(helper.TemplateTypeName(helper.ServerTemplateTypeID))
+	}
+	if stateTemplateDeployment.RetryFailedDevices.IsNull() {
+		stateTemplateDeployment.RetryFailedDevices = types.BoolValue(false)
+	}
+	stateTemplateDeployment.DeviceStatus, diags = refreshDeviceStatus(ctx, omeClient, stateTemplateDeployment)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	stateUpdateErr := updateDeploymentState(ctx, &stateTemplateDeployment, &stateTemplateDeployment, templateID, templateName, omeClient, usedDeviceInput)
 	if stateUpdateErr != nil {
 		resp.Diagnostics.AddError(
 			clients.ErrTemplateDeploymentRead, stateUpdateErr.Error(),
@@ -507,9 +740,30 @@ func (r resourceDeployment) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	omeTemplate, err := omeClient.GetTemplateByIDOrName(state.TemplateID.ValueInt64(), state.TemplateName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			clients.ErrInvalidTemplate,
+			err.Error(),
+		)
+		return
+	}
+	err = helper.ValidateTemplateTargets(omeTemplate, planDevices)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			clients.ErrTemplateDeploymentUpdate, err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(checkTemplateTypeOptions(omeTemplate, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.TemplateDeviceType = types.StringValue(helper.TemplateTypeName(omeTemplate.TypeID))
+
 	_, planDeviceIDs, _ := omeClient.GetUniqueDevicesIdsAndServiceTags(planDevices)
 
-	serverProfiles, err := omeClient.GetServerProfileInfoByTemplateName(state.TemplateName.ValueString())
+	deployedTargets, err := getDeployedTargets(ctx, omeClient, state)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			clients.ErrTemplateDeploymentUpdate, err.Error(),
@@ -519,11 +773,27 @@ func (r resourceDeployment) Update(ctx context.Context, req resource.UpdateReque
 
 	var stateDeviceIDs []int64
 
-	for _, serverProfile := range serverProfiles.Value {
-		stateDeviceIDs = append(stateDeviceIDs, serverProfile.TargetID)
+	for _, deployedTarget := range deployedTargets {
+		stateDeviceIDs = append(stateDeviceIDs, deployedTarget.TargetID)
 	}
 
-	newDeployDevIDs := compare(planDeviceIDs, stateDeviceIDs)
+	// the new targets are the devices without a deployment status, the failed devices are only deployed again when they are retried
+	recordedDeviceIDs := getRecordedDeviceIDs(ctx, state.DeviceStatus, stateDeviceIDs)
+	newDeployDevIDs := compare(planDeviceIDs, recordedDeviceIDs)
+
+	// the pending devices are deployed, and the failed devices when they are retried after removing the server profile left by their deployment
+	retryProfileIDs := []int64{}
+	for _, retryDevID := range getUndeployedDeviceIDs(ctx, state.DeviceStatus, plan.RetryFailedDevices.ValueBool()) {
+		if !slices.Contains(planDeviceIDs, retryDevID) || slices.Contains(newDeployDevIDs, retryDevID) {
+			continue
+		}
+		for _, deployedTarget := range deployedTargets {
+			if deployedTarget.TargetID == retryDevID && deployedTarget.ID != 0 {
+				retryProfileIDs = append(retryProfileIDs, deployedTarget.ID)
+			}
+		}
+		newDeployDevIDs = append(newDeployDevIDs, retryDevID)
+	}
 
 	//remove
 	removeDeployDevIDs := compare(stateDeviceIDs, planDeviceIDs)
@@ -563,37 +833,53 @@ func (r resourceDeployment) Update(ctx context.Context, req resource.UpdateReque
 	if len(plan.DeviceAttributes.Elements()) > 0 {
 		deploymentRequest.Attributes = getDeviceAttributes(ctx, planDevices, plan)
 	}
+	if len(plan.DeviceAttributeOverrides.Elements()) > 0 || !plan.ChassisOptions.IsNull() {
+		deploymentRequest.Attributes, diags = getDeviceAttributeOverrides(ctx, omeClient, state.TemplateID.ValueInt64(), planDevices, plan, clients.ErrTemplateDeploymentUpdate)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
-	if len(newDeployDevIDs) > 0 {
-		tflog.Trace(ctx, "resource_deploy update: started deployment")
-		deploymentJobID, err := omeClient.CreateDeployment(deploymentRequest)
+	if len(retryProfileIDs) > 0 {
+		tflog.Debug(ctx, "resource_deploy update: deleting server profiles of failed deployments", map[string]interface{}{
+			"profileIds": retryProfileIDs,
+		})
+		err = deleteProfiles(ctx, omeClient, retryProfileIDs)
 		if err != nil {
 			resp.Diagnostics.AddError(
-				clients.ErrTemplateDeploymentUpdate, err.Error(),
+				clients.ErrTemplateDeploymentUpdate,
+				err.Error(),
 			)
 			return
 		}
+	}
 
-		if !plan.RunLater.ValueBool() {
-			tflog.Trace(ctx, "resource_deploy update: started job tracking")
-			isSuccess, message := omeClient.TrackJob(deploymentJobID, plan.JobRetryCount.ValueInt64(), plan.SleepInterval.ValueInt64())
-			if !isSuccess {
-				resp.Diagnostics.AddWarning(
-					"unable to complete the deployment for the template: ", message,
-				)
-			}
+	results := []models.DeviceDeploymentResult{}
+	var deployErr error
+	if len(newDeployDevIDs) > 0 {
+		tflog.Trace(ctx, "resource_deploy update: started deployment")
+		results, deployErr = helper.DeployTemplateInBatches(ctx, omeClient, deploymentRequest, getBatchOptions(plan))
+		if deployErr != nil && len(results) == 0 {
+			resp.Diagnostics.AddError(
+				clients.ErrTemplateDeploymentUpdate, deployErr.Error(),
+			)
+			return
 		}
+		addDeploymentWarnings(&resp.Diagnostics, clients.ErrTemplateDeploymentUpdate, results)
 	}
 
-	if len(removeDeployDevIDs) > 0 {
-		profileArr := make([]int64, len(removeDeployDevIDs))
-		for i, removeDevID := range removeDeployDevIDs {
-			for _, serverProfile := range serverProfiles.Value {
-				if serverProfile.TargetID == removeDevID {
-					profileArr[i] = serverProfile.ID
-				}
+	// the removed targets of the chassis and IOM templates have no server profile to delete
+	profileArr := []int64{}
+	for _, removeDevID := range removeDeployDevIDs {
+		for _, deployedTarget := range deployedTargets {
+			if deployedTarget.TargetID == removeDevID && deployedTarget.ID != 0 {
+				profileArr = append(profileArr, deployedTarget.ID)
 			}
 		}
+	}
+	// the removed targets are kept when the deployment stopped, they are removed by the next apply
+	if len(profileArr) > 0 && deployErr == nil {
 		tflog.Debug(ctx, "resource_deploy update: deleting server profiles", map[string]interface{}{
 			"profileIds": profileArr,
 		})
@@ -609,7 +895,9 @@ func (r resourceDeployment) Update(ctx context.Context, req resource.UpdateReque
 
 	tflog.Trace(ctx, "resource_deploy update: started state update")
 
-	stateUpdateErr := updateDeploymentState(&state, &plan, state.TemplateID.ValueInt64(), state.TemplateName.ValueString(), omeClient, usedDeviceInput)
+	state.DeviceStatus, diags = getDeviceStatus(ctx, planDevices, results, recordedDeviceIDs, state.DeviceStatus)
+	resp.Diagnostics.Append(diags...)
+	stateUpdateErr := updateDeploymentState(ctx, &state, &plan, state.TemplateID.ValueInt64(), state.TemplateName.ValueString(), omeClient, usedDeviceInput)
 	if stateUpdateErr != nil {
 		resp.Diagnostics.AddError(
 			clients.ErrTemplateDeploymentUpdate, stateUpdateErr.Error(),
@@ -620,6 +908,12 @@ func (r resourceDeployment) Update(ctx context.Context, req resource.UpdateReque
 	//Save into State
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	// the devices deployed before the deployment stopped are saved, the apply fails afterwards
+	if deployErr != nil {
+		resp.Diagnostics.AddError(
+			clients.ErrTemplateDeploymentUpdate, deployErr.Error(),
+		)
+	}
 	tflog.Trace(ctx, "resource_deploy update: finished")
 }
 
@@ -647,7 +941,7 @@ func (r resourceDeployment) Delete(ctx context.Context, req resource.DeleteReque
 		"name": statetemplateDeployment.TemplateName.ValueString(),
 	})
 
-	serverProfiles, err := omeClient.GetServerProfileInfoByTemplateName(statetemplateDeployment.TemplateName.ValueString())
+	deployedTargets, err := getDeployedTargets(ctx, omeClient, statetemplateDeployment)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			clients.ErrTemplateDeploymentDelete, err.Error(),
@@ -655,23 +949,28 @@ func (r resourceDeployment) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
-	profileArr := make([]int64, len(serverProfiles.Value))
+	profileArr := []int64{}
 
-	for i, serverProfile := range serverProfiles.Value {
-		profileArr[i] = serverProfile.ID
+	for _, deployedTarget := range deployedTargets {
+		if deployedTarget.ID != 0 {
+			profileArr = append(profileArr, deployedTarget.ID)
+		}
 	}
 
-	tflog.Debug(ctx, "resource_deploy delete: deleting server profiles", map[string]interface{}{
-		"profileIds": profileArr,
-	})
+	// the deployments of the chassis and IOM templates leave no server profile to delete
+	if len(profileArr) > 0 {
+		tflog.Debug(ctx, "resource_deploy delete: deleting server profiles", map[string]interface{}{
+			"profileIds": profileArr,
+		})
 
-	err = deleteProfiles(ctx, omeClient, profileArr)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			clients.ErrTemplateDeploymentDelete,
-			err.Error(),
-		)
-		return
+		err = deleteProfiles(ctx, omeClient, profileArr)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				clients.ErrTemplateDeploymentDelete,
+				err.Error(),
+			)
+			return
+		}
 	}
 	resp.State.RemoveResource(ctx)
 	tflog.Trace(ctx, "resource_deploy delete: finished")
@@ -693,7 +992,7 @@ func (r resourceDeployment) ImportState(ctx context.Context, req resource.Import
 	tflog.Trace(ctx, "resource_deploy import: started")
 	// Save the import identifier in the id attribute
 	var stateTemplateDeployment models.TemplateDeployment
-	templateName := req.ID
+	templateName, jobIDs := parseDeploymentImportID(req.ID)
 
 	//Create Session and defer the remove session
 	omeClient, d := r.p.createOMESession(ctx, "resource_deploy ImportState")
@@ -709,23 +1008,54 @@ func (r resourceDeployment) ImportState(ctx context.Context, req resource.Import
 		return
 	}
 	templateID := omeTemplate.ID
+	stateTemplateDeployment.TemplateDeviceType = types.StringValue(helper.TemplateTypeName(omeTemplate.TypeID))
+	stateTemplateDeployment.DeviceStatus = types.ListNull(deploymentDeviceStatusType)
 
 	profileDevSTVals := []attr.Value{}
-	serverProfiles, err := omeClient.GetServerProfileInfoByTemplateName(templateName)
-	if err != nil {
-		resp.Diagnostics.AddError(clients.ErrImportDeployment, err.Error())
-		return
-	}
+	if isServerTemplateDeployment(stateTemplateDeployment) {
+		if len(jobIDs) > 0 {
+			resp.Diagnostics.AddError(clients.ErrImportDeployment,
+				"the deployment jobs are only used to import the deployments of chassis and IO module templates, the deployment of a server template is imported with the template name")
+			return
+		}
+		serverProfiles, err := omeClient.GetServerProfileInfoByTemplateName(templateName)
+		if err != nil {
+			resp.Diagnostics.AddError(clients.ErrImportDeployment, err.Error())
+			return
+		}
 
-	if len(serverProfiles.Value) == 0 {
-		resp.Diagnostics.AddError(clients.ErrImportDeployment, fmt.Sprintf(clients.ErrImportNoProfiles, templateName))
-		return
-	}
+		if len(serverProfiles.Value) == 0 {
+			resp.Diagnostics.AddError(clients.ErrImportDeployment, fmt.Sprintf(clients.ErrImportNoProfiles, templateName))
+			return
+		}
 
-	for _, serverProfile := range serverProfiles.Value {
-		device, _ := omeClient.GetDevice("", serverProfile.TargetID)
-		deviceSTVal := types.StringValue(device.DeviceServiceTag)
-		profileDevSTVals = append(profileDevSTVals, deviceSTVal)
+		for _, serverProfile := range serverProfiles.Value {
+			device, _ := omeClient.GetDevice("", serverProfile.TargetID)
+			deviceSTVal := types.StringValue(device.DeviceServiceTag)
+			profileDevSTVals = append(profileDevSTVals, deviceSTVal)
+		}
+	} else {
+		// the chassis and IOM templates leave no server profile, their deployment is imported from its deployment jobs
+		if len(jobIDs) == 0 {
+			resp.Diagnostics.AddError(clients.ErrImportDeployment,
+				fmt.Sprintf("the deployment of %s template %s is imported with the ids of its deployment jobs, as <template_name>:<job_id>[,<job_id>]",
+					stateTemplateDeployment.TemplateDeviceType.ValueString(), templateName))
+			return
+		}
+		statuses, err := getImportedDeviceStatus(ctx, omeClient, jobIDs)
+		if err != nil {
+			resp.Diagnostics.AddError(clients.ErrImportDeployment, err.Error())
+			return
+		}
+		for _, status := range statuses {
+			profileDevSTVals = append(profileDevSTVals, status.DeviceServiceTag)
+		}
+		var diags diag.Diagnostics
+		stateTemplateDeployment.DeviceStatus, diags = types.ListValueFrom(ctx, deploymentDeviceStatusType, statuses)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 	stateTemplateDeployment.ID = types.StringValue(strconv.FormatInt(templateID, 10))
 	stateTemplateDeployment.TemplateID = types.Int64Value(templateID)
@@ -820,6 +1150,9 @@ func (r resourceDeployment) ImportState(ctx context.Context, req resource.Import
 	if !deviceAttributeTfsdk.IsUnknown() {
 		stateTemplateDeployment.DeviceAttributes = deviceAttributeTfsdk
 	}
+	stateTemplateDeployment.DeviceAttributeOverrides = types.ListNull(deviceAttributeOverridesType)
+	stateTemplateDeployment.ChassisOptions = types.ObjectNull(chassisOptionsType.AttrTypes)
+	stateTemplateDeployment.RetryFailedDevices = types.BoolValue(false)
 	shareDetailsTfsdk, _ := types.ObjectValue(
 		map[string]attr.Type{
 			"ip_address": types.StringType,
@@ -874,7 +1207,57 @@ func (r resourceDeployment) ImportState(ctx context.Context, req resource.Import
 	tflog.Trace(ctx, "resource_deploy import: finished")
 }
 
-func updateDeploymentState(stateTemplateDeployment, planTemplateDeployment *models.TemplateDeployment, templateID int64, templateName string, omeClient *clients.Client, usedDeviceInput string) error {
+// parseDeploymentImportID returns the template name and the ids of the deployment jobs of an import id <template_name>[:<job_id>[,<job_id>]]
+// The import id is a template name when it does not end with job ids
+func parseDeploymentImportID(id string) (string, []int64) {
+	sep := strings.LastIndex(id, ":")
+	if sep < 0 {
+		return id, nil
+	}
+	jobIDs := []int64{}
+	for _, value := range strings.Split(id[sep+1:], ",") {
+		jobID, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			return id, nil
+		}
+		jobIDs = append(jobIDs, jobID)
+	}
+	return id[:sep], jobIDs
+}
+
+// getImportedDeviceStatus returns the deployment status of the targets of the deployment jobs, read from OME
+func getImportedDeviceStatus(ctx context.Context, omeClient *clients.Client, jobIDs []int64) ([]models.DeploymentDeviceStatus, error) {
+	results := map[int64]models.DeviceDeploymentResult{}
+	for _, jobID := range jobIDs {
+		jobResults, err := helper.GetDeploymentJobDeviceResults(ctx, omeClient, jobID)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the deployment job %d: %s", jobID, err.Error())
+		}
+		for _, result := range jobResults {
+			results[result.DeviceID] = result
+		}
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("the deployment jobs %v have no target devices", jobIDs)
+	}
+	statuses := []models.DeploymentDeviceStatus{}
+	for deviceID, result := range results {
+		device, _ := omeClient.GetDevice("", deviceID)
+		statuses = append(statuses, models.DeploymentDeviceStatus{
+			DeviceID:         types.Int64Value(deviceID),
+			DeviceServiceTag: types.StringValue(device.DeviceServiceTag),
+			Status:           types.StringValue(result.Status),
+			Message:          types.StringValue(result.Message),
+			JobID:            types.Int64Value(result.JobID),
+		})
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].DeviceID.ValueInt64() < statuses[j].DeviceID.ValueInt64()
+	})
+	return statuses, nil
+}
+
+func updateDeploymentState(ctx context.Context, stateTemplateDeployment, planTemplateDeployment *models.TemplateDeployment, templateID int64, templateName string, omeClient *clients.Client, usedDeviceInput string) error {
 	stateTemplateDeployment.ID = types.StringValue(strconv.FormatInt(templateID, 10))
 	stateTemplateDeployment.TemplateID = types.Int64Value(templateID)
 	stateTemplateDeployment.TemplateName = types.StringValue(templateName)
@@ -888,15 +1271,15 @@ func updateDeploymentState(stateTemplateDeployment, planTemplateDeployment *mode
 	devSTList := planTemplateDeployment.DeviceServicetags.Elements()
 	profileDevSTVals := []attr.Value{}
 	profileDevIDVals := []attr.Value{}
-	serverProfiles, err := omeClient.GetServerProfileInfoByTemplateName(templateName)
+	deployedTargets, err := getDeployedTargets(ctx, omeClient, *stateTemplateDeployment)
 	if err != nil {
 		return err
 	}
-	for _, serverProfile := range serverProfiles.Value {
-		device, _ := omeClient.GetDevice("", serverProfile.TargetID)
+	for _, deployedTarget := range deployedTargets {
+		device, _ := omeClient.GetDevice("", deployedTarget.TargetID)
 		deviceSTVal := types.StringValue(device.DeviceServiceTag)
 		profileDevSTVals = append(profileDevSTVals, deviceSTVal)
-		deviceIDVal := types.Int64Value(serverProfile.TargetID)
+		deviceIDVal := types.Int64Value(deployedTarget.TargetID)
 		profileDevIDVals = append(profileDevIDVals, deviceIDVal)
 	}
 
@@ -949,6 +1332,14 @@ func updateDeploymentState(stateTemplateDeployment, planTemplateDeployment *mode
 	if !planTemplateDeployment.DeviceAttributes.IsUnknown() {
 		stateTemplateDeployment.DeviceAttributes = planTemplateDeployment.DeviceAttributes
 	}
+	stateTemplateDeployment.DeviceAttributeOverrides = planTemplateDeployment.DeviceAttributeOverrides
+	stateTemplateDeployment.ChassisOptions = planTemplateDeployment.ChassisOptions
+	stateTemplateDeployment.BatchSize = planTemplateDeployment.BatchSize
+	stateTemplateDeployment.MaxFailures = planTemplateDeployment.MaxFailures
+	stateTemplateDeployment.PauseBetweenBatches = planTemplateDeployment.PauseBetweenBatches
+	if !planTemplateDeployment.RetryFailedDevices.IsUnknown() {
+		stateTemplateDeployment.RetryFailedDevices = planTemplateDeployment.RetryFailedDevices
+	}
 	if !planTemplateDeployment.OptionsContinueOnWarning.IsUnknown() {
 		stateTemplateDeployment.OptionsContinueOnWarning = planTemplateDeployment.OptionsContinueOnWarning
 	}
@@ -1098,3 +1489,330 @@ func getDeviceAttributes(ctx context.Context, devices []models.Device, plan mode
 	}
 	return omeDeviceAttributes
 }
+
+// ModifyPlan checks the batch options, and the device attribute overrides and chassis options against the type and the attributes of the template
+// The pending devices are deployed by the next apply, and the failed devices as well when they are retried
+func (r resourceDeployment) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan models.TemplateDeployment
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.RunLater.ValueBool() && !plan.BatchSize.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("batch_size"),
+			clients.ErrTemplateDeploymentGeneral,
+			"batch_size cannot be used with run_later, the scheduled deployment jobs are not tracked",
+		)
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		var state models.TemplateDeployment
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if len(getUndeployedDeviceIDs(ctx, state.DeviceStatus, plan.RetryFailedDevices.ValueBool())) > 0 {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("device_status"), types.ListUnknown(deploymentDeviceStatusType))...)
+		}
+		// the overrides and the chassis options were checked when they were planned, no session is opened while they are unchanged
+		if plan.TemplateName.Equal(state.TemplateName) && plan.DeviceServicetags.Equal(state.DeviceServicetags) &&
+			plan.DeviceAttributeOverrides.Equal(state.DeviceAttributeOverrides) && plan.ChassisOptions.Equal(state.ChassisOptions) {
+			return
+		}
+	}
+	r.checkDeviceAttributeOverrides(ctx, plan, resp)
+}
+
+func (r resourceDeployment) checkDeviceAttributeOverrides(ctx context.Context, plan models.TemplateDeployment, resp *resource.ModifyPlanResponse) {
+	// the overrides are checked during the apply when the template or the overrides are only known then
+	checkOverrides := !plan.DeviceAttributeOverrides.IsNull() && !plan.DeviceAttributeOverrides.IsUnknown()
+	checkChassisOptions := !plan.ChassisOptions.IsNull() && !plan.ChassisOptions.IsUnknown()
+	if r.p == nil || !r.p.configured || (!checkOverrides && !checkChassisOptions) ||
+		plan.TemplateID.IsUnknown() || plan.TemplateName.IsUnknown() {
+		return
+	}
+
+	omeClient, d := r.p.createOMESession(ctx, "resource_deploy ModifyPlan")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	template, err := helper.GetTemplate(omeClient, plan.TemplateID.ValueInt64(), plan.TemplateName.ValueString())
+	if err != nil {
+		// the template may be created during the apply
+		tflog.Debug(ctx, "resource_deploy modify plan: template not found, the device attribute overrides are checked during the apply", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resp.Diagnostics.Append(checkTemplateTypeOptions(template, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if checkChassisOptions {
+		tflog.Trace(ctx, "resource_deploy modify plan: resolving chassis settings")
+		_, d = helper.ResolveChassisSettings(ctx, omeClient, template.ID, plan.ChassisOptions)
+		resp.Diagnostics.Append(d...)
+	}
+	if !checkOverrides {
+		return
+	}
+
+	tflog.Trace(ctx, "resource_deploy modify plan: resolving device attribute overrides")
+	resolved, d := helper.ResolveDeviceAttributeOverrides(ctx, omeClient, template.ID, plan.DeviceAttributeOverrides)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() || plan.DeviceServicetags.IsNull() || plan.DeviceServicetags.IsUnknown() {
+		return
+	}
+	serviceTags := []string{}
+	resp.Diagnostics.Append(plan.DeviceServicetags.ElementsAs(ctx, &serviceTags, true)...)
+	for serviceTag := range resolved {
+		if !slices.Contains(serviceTags, serviceTag) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("device_attribute_overrides"),
+				clients.ErrDeviceAttributeOverrides,
+				fmt.Sprintf("device %s of the device attribute overrides is not in device_servicetags", serviceTag),
+			)
+		}
+	}
+}
+
+func getDeviceAttributeOverrides(ctx context.Context, omeClient *clients.Client, templateID int64, devices []models.Device, plan models.TemplateDeployment, summary string) ([]models.OMEDeviceAttributes, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	resolved := map[string][]models.OMEAttribute{}
+	if len(plan.DeviceAttributeOverrides.Elements()) > 0 {
+		resolved, diags = helper.ResolveDeviceAttributeOverrides(ctx, omeClient, templateID, plan.DeviceAttributeOverrides)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+	if !plan.ChassisOptions.IsNull() {
+		leadChassis, err := helper.GetLeadChassis(omeClient, devices)
+		if err != nil {
+			diags.AddError(summary, err.Error())
+			return nil, diags
+		}
+		settings, d := helper.ResolveChassisSettings(ctx, omeClient, templateID, plan.ChassisOptions)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		if err := helper.AddLeadChassisSettings(resolved, leadChassis.DeviceServiceTag, settings); err != nil {
+			diags.AddError(summary, err.Error())
+			return nil, diags
+		}
+	}
+	deviceAttributes, err := helper.GetDeviceAttributeOverrides(devices, resolved)
+	if err != nil {
+		diags.AddError(summary, err.Error())
+	}
+	return deviceAttributes, diags
+}
+
+// checkTemplateTypeOptions checks that the deployment options are applicable to the type of the template
+func checkTemplateTypeOptions(template models.OMETemplate, plan models.TemplateDeployment) diag.Diagnostics {
+	var diags diag.Diagnostics
+	templateType := helper.TemplateTypeName(template.TypeID)
+	if templateType != helper.TemplateTypeName(helper.ServerTemplateTypeID) && !plan.BootToNetworkISO.IsNull() && !plan.BootToNetworkISO.IsUnknown() {
+		diags.AddAttributeError(
+			path.Root("boot_to_network_iso"),
+			clients.ErrTemplateDeploymentGeneral,
+			fmt.Sprintf("boot_to_network_iso is only applicable to the templates of servers, template %s is a %s template", template.Name, templateType),
+		)
+	}
+	if templateType != helper.TemplateTypeName(helper.ChassisTemplateTypeID) && !plan.ChassisOptions.IsNull() && !plan.ChassisOptions.IsUnknown() {
+		diags.AddAttributeError(
+			path.Root("chassis_options"),
+			clients.ErrTemplateDeploymentGeneral,
+			fmt.Sprintf("chassis_options is only applicable to the templates of chassis, template %s is a %s template", template.Name, templateType),
+		)
+	}
+	return diags
+}
+
+// getDeployedTargets returns the server profiles of the deployment. The chassis and IOM templates leave no server profile,
+// their targets are all the devices of the device status, whatever the outcome of their deployment
+func getDeployedTargets(ctx context.Context, omeClient *clients.Client, deployment models.TemplateDeployment) ([]models.OMEServerProfile, error) {
+	if isServerTemplateDeployment(deployment) {
+		serverProfiles, err := omeClient.GetServerProfileInfoByTemplateName(deployment.TemplateName.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		return serverProfiles.Value, nil
+	}
+	statuses := []models.DeploymentDeviceStatus{}
+	if !deployment.DeviceStatus.IsNull() && !deployment.DeviceStatus.IsUnknown() {
+		if diags := deployment.DeviceStatus.ElementsAs(ctx, &statuses, true); diags.HasError() {
+			return nil, fmt.Errorf("unable to read the device status of the deployment of template %s", deployment.TemplateName.ValueString())
+		}
+	}
+	deployedTargets := []models.OMEServerProfile{}
+	for _, status := range statuses {
+		deployedTargets = append(deployedTargets, models.OMEServerProfile{TargetID: status.DeviceID.ValueInt64()})
+	}
+	return deployedTargets, nil
+}
+
+// isServerTemplateDeployment checks if the template of the deployment is a server template, as are the deployments of the older states
+func isServerTemplateDeployment(deployment models.TemplateDeployment) bool {
+	return deployment.TemplateDeviceType.IsNull() || deployment.TemplateDeviceType.IsUnknown() ||
+		deployment.TemplateDeviceType.ValueString() == helper.TemplateTypeName(helper.ServerTemplateTypeID)
+}
+
+// refreshDeviceStatus reads the deployment jobs of the devices which are not deployed again, their status is kept while the job runs
+// The deployed devices of a server template whose server profile was removed are pending again
+func refreshDeviceStatus(ctx context.Context, omeClient *clients.Client, deployment models.TemplateDeployment) (types.List, diag.Diagnostics) {
+	if deployment.DeviceStatus.IsNull() || deployment.DeviceStatus.IsUnknown() {
+		return deployment.DeviceStatus, nil
+	}
+	statuses := []models.DeploymentDeviceStatus{}
+	diags := deployment.DeviceStatus.ElementsAs(ctx, &statuses, true)
+	if diags.HasError() {
+		return deployment.DeviceStatus, diags
+	}
+
+	jobTargets := map[int64][]int64{}
+	for _, status := range statuses {
+		if status.Status.ValueString() != helper.DeviceDeploymentDeployed && status.JobID.ValueInt64() > 0 {
+			jobTargets[status.JobID.ValueInt64()] = append(jobTargets[status.JobID.ValueInt64()], status.DeviceID.ValueInt64())
+		}
+	}
+	results := map[int64]models.DeviceDeploymentResult{}
+	for jobID, targetIDs := range jobTargets {
+		jobResults, ok := helper.GetDeploymentJobResults(ctx, omeClient, jobID, targetIDs)
+		if !ok {
+			continue
+		}
+		for _, result := range jobResults {
+			results[result.DeviceID] = result
+		}
+	}
+
+	profileTargetIDs := []int64{}
+	if isServerTemplateDeployment(deployment) {
+		serverProfiles, err := omeClient.GetServerProfileInfoByTemplateName(deployment.TemplateName.ValueString())
+		if err != nil {
+			diags.AddError(clients.ErrTemplateDeploymentRead, err.Error())
+			return deployment.DeviceStatus, diags
+		}
+		for _, serverProfile := range serverProfiles.Value {
+			profileTargetIDs = append(profileTargetIDs, serverProfile.TargetID)
+		}
+	}
+	for i, status := range statuses {
+		if result, ok := results[status.DeviceID.ValueInt64()]; ok {
+			statuses[i].Status = types.StringValue(result.Status)
+			statuses[i].Message = types.StringValue(result.Message)
+		}
+		if isServerTemplateDeployment(deployment) && statuses[i].Status.ValueString() == helper.DeviceDeploymentDeployed &&
+			!slices.Contains(profileTargetIDs, status.DeviceID.ValueInt64()) {
+			statuses[i].Status = types.StringValue(helper.DeviceDeploymentPending)
+			statuses[i].Message = types.StringValue("the server profile of the device was removed")
+		}
+	}
+	return types.ListValueFrom(ctx, deploymentDeviceStatusType, statuses)
+}
+
+func getBatchOptions(plan models.TemplateDeployment) helper.DeploymentBatchOptions {
+	opts := helper.DeploymentBatchOptions{
+		BatchSize:           plan.BatchSize.ValueInt64(),
+		MaxFailures:         -1,
+		PauseBetweenBatches: plan.PauseBetweenBatches.ValueInt64(),
+		RunLater:            plan.RunLater.ValueBool(),
+		JobRetryCount:       plan.JobRetryCount.ValueInt64(),
+		SleepInterval:       plan.SleepInterval.ValueInt64(),
+	}
+	if !plan.MaxFailures.IsNull() {
+		opts.MaxFailures = plan.MaxFailures.ValueInt64()
+	}
+	return opts
+}
+
+func addDeploymentWarnings(diags *diag.Diagnostics, summary string, results []models.DeviceDeploymentResult) {
+	for _, result := range results {
+		if result.Status == helper.DeviceDeploymentFailed {
+			diags.AddWarning(summary, fmt.Sprintf("unable to deploy the template to device %d: %s", result.DeviceID, result.Message))
+		}
+	}
+}
+
+// getUndeployedDeviceIDs returns the pending devices, and the devices whose deployment failed when they are retried
+func getUndeployedDeviceIDs(ctx context.Context, deviceStatus types.List, retryFailed bool) []int64 {
+	statuses := []models.DeploymentDeviceStatus{}
+	if deviceStatus.IsNull() || deviceStatus.IsUnknown() || deviceStatus.ElementsAs(ctx, &statuses, true).HasError() {
+		return nil
+	}
+	ids := []int64{}
+	for _, status := range statuses {
+		if (retryFailed && status.Status.ValueString() == helper.DeviceDeploymentFailed) || status.Status.ValueString() == helper.DeviceDeploymentPending {
+			ids = append(ids, status.DeviceID.ValueInt64())
+		}
+	}
+	return ids
+}
+
+// getRecordedDeviceIDs returns the devices of the device status, and the targets of the deployment which have no status,
+// like the server profiles of the states saved before the device status
+func getRecordedDeviceIDs(ctx context.Context, deviceStatus types.List, targetIDs []int64) []int64 {
+	ids := []int64{}
+	statuses := []models.DeploymentDeviceStatus{}
+	if !deviceStatus.IsNull() && !deviceStatus.IsUnknown() && !deviceStatus.ElementsAs(ctx, &statuses, true).HasError() {
+		for _, status := range statuses {
+			ids = append(ids, status.DeviceID.ValueInt64())
+		}
+	}
+	for _, id := range targetIDs {
+		if !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// getDeviceStatus returns the deployment status of the target devices, the devices which are not part of the results
+// keep their previous status when they are recorded targets of the deployment and are pending otherwise
+func getDeviceStatus(ctx context.Context, devices []models.Device, results []models.DeviceDeploymentResult, recordedIDs []int64, previous types.List) (types.List, diag.Diagnostics) {
+	previousStatuses := []models.DeploymentDeviceStatus{}
+	if !previous.IsNull() && !previous.IsUnknown() {
+		previous.ElementsAs(ctx, &previousStatuses, true)
+	}
+	statuses := []models.DeploymentDeviceStatus{}
+	for _, device := range devices {
+		status := models.DeploymentDeviceStatus{
+			DeviceID:         types.Int64Value(device.ID),
+			DeviceServiceTag: types.StringValue(device.DeviceServiceTag),
+			Status:           types.StringValue(helper.DeviceDeploymentPending),
+			Message:          types.StringValue(""),
+			JobID:            types.Int64Null(),
+		}
+		if slices.Contains(recordedIDs, device.ID) {
+			status.Status = types.StringValue(helper.DeviceDeploymentDeployed)
+			for _, previousStatus := range previousStatuses {
+				if previousStatus.DeviceID.ValueInt64() == device.ID {
+					status.Status = previousStatus.Status
+					status.Message = previousStatus.Message
+					status.JobID = previousStatus.JobID
+				}
+			}
+		}
+		for _, result := range results {
+			if result.DeviceID == device.ID {
+				status.Status = types.StringValue(result.Status)
+				status.Message = types.StringValue(result.Message)
+				status.JobID = types.Int64Value(result.JobID)
+			}
+		}
+		statuses = append(statuses, status)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].DeviceID.ValueInt64() < statuses[j].DeviceID.ValueInt64()
+	})
+	return types.ListValueFrom(ctx, deploymentDeviceStatusType, statuses)
+}
@@ -18,15 +18,25 @@ import (
 	"log"
 	"regexp"
 	"terraform-provider-ome/clients"
+	"terraform-provider-ome/helper"
 	"terraform-provider-ome/models"
 	"testing"
 
+	. "github.com/bytedance/mockey"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 const (
 	TestAccTemplateName       = "test_acc_create_deployment"
 	TestAccUpdateTemplateName = "test_acc_update_deployment"
+	TestAccIOMTemplateName    = "test_acc_iom_deployment"
+)
+
+var (
+	leadChassisServiceTag  string
+	leadChassisSettings    []models.OMEAttribute
+	addLeadChassisSettings func(map[string][]models.OMEAttribute, string, []models.OMEAttribute) error
 )
 
 func init() {
@@ -200,6 +210,13 @@ func TestTemplateDeploy_ImportDeploymentError(t *testing.T) {
 			{
 				Config: testTemplateDeploymentSuccess + temp.templateDeploySvcTag1,
 			},
+			{
+				Config:        testAccImportDeploymentSuccess,
+				ResourceName:  "ome_deployment.import-deployment-success",
+				ImportState:   true,
+				ImportStateId: TestAccTemplateName + ":1",
+				ExpectError:   regexp.MustCompile(`.*only used to import the deployments of chassis and IO module templates.*`),
+			},
 			{
 				Config:        testAccImportDeploymentSuccess,
 				ResourceName:  "ome_deployment.import-deployment-success",
@@ -266,6 +283,392 @@ func TestTemplateDeploy_CreateDeployBootNetworkISOSuccess(t *testing.T) {
 	})
 }
 
+func TestTemplateDeploy_DeviceAttributeOverrides(t *testing.T) {
+	if skipTest() {
+		t.Skip(SkipTestMsg)
+	}
+	temp := initTemplates(t)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testTemplateDeploymentAttributeOverrides("ServerTopology 1 Aisle Name", DeviceSvcTag1) + temp.templateDeploySvcTag1,
+				Check: resource.ComposeTestCheckFunc(resource.TestCheckResourceAttr("ome_deployment.deploy-template-3", "template_name", TestAccTemplateName),
+					resource.TestCheckResourceAttr("ome_deployment.deploy-template-3", "device_attribute_overrides.#", "1"),
+					resource.TestCheckResourceAttr("ome_deployment.deploy-template-3", "device_attribute_overrides.0.attributes.%", "1"),
+					resource.TestCheckResourceAttr("ome_deployment.deploy-template-3", "device_attribute_overrides.0.attributes.ServerTopology 1 Aisle Name", "aisle-1"),
+				),
+			},
+			// the unchanged overrides are not resolved again by the plan
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock((*clients.Client).GetTemplateAttributes).When(func(_ *clients.Client, _ int64, stateAttributes []models.Attribute, _ bool) bool {
+						return len(stateAttributes) == 0
+					}).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:   testTemplateDeploymentAttributeOverrides("ServerTopology 1 Aisle Name", DeviceSvcTag1) + temp.templateDeploySvcTag1,
+				PlanOnly: true,
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+				},
+				Config:      testTemplateDeploymentAttributeOverrides("ServerTopology 1 Unknown Name", DeviceSvcTag1) + temp.templateDeploySvcTag1,
+				ExpectError: regexp.MustCompile(`.*ServerTopology 1 Unknown Name does not exist in the template.*`),
+			},
+			{
+				Config:      testTemplateDeploymentAttributeOverrides("ServerTopology 1 Aisle Name", DeviceSvcTag2) + temp.templateDeploySvcTag1,
+				ExpectError: regexp.MustCompile(`.*is not in device_servicetags.*`),
+			},
+			// the paths are not case sensitive, both keys refer to the same attribute
+			{
+				Config:      testTemplateDeploymentDuplicateAttributeOverrides + temp.templateDeploySvcTag1,
+				ExpectError: regexp.MustCompile(`.*refer to the same attribute .*ServerTopology 1 Aisle Name.*`),
+			},
+			{
+				Config:      testTemplateDeploymentAttributesConflict + temp.templateDeploySvcTag1,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Combination.*`),
+			},
+			{
+				PreConfig: func() {
+					// the refresh of the template reads its attributes with the attributes of its state
+					FunctionMocker = Mock((*clients.Client).GetTemplateAttributes).When(func(_ *clients.Client, _ int64, stateAttributes []models.Attribute, _ bool) bool {
+						return len(stateAttributes) == 0
+					}).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				// the overrides are only resolved again when they change
+				Config:      testTemplateDeploymentAttributeOverrides("serverTopology 1 aisle name", DeviceSvcTag1) + temp.templateDeploySvcTag1,
+				ExpectError: regexp.MustCompile(`.*unable to read the attributes of template.*`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+				},
+				Config: testTemplateDeploymentAttributeOverrides("ServerTopology 1 Aisle Name", DeviceSvcTag1) + temp.templateDeploySvcTag1,
+			},
+		},
+	})
+}
+
+func TestTemplateDeploy_Batches(t *testing.T) {
+	if skipTest() {
+		t.Skip(SkipTestMsg)
+	}
+	temp := initTemplates(t)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testTemplateDeploymentBatches("run_later = true\n\t\tcron = \"0 0 0 * * ? 2099\"") + temp.templateDeploySvcTag1,
+				ExpectError: regexp.MustCompile(`.*batch_size cannot be used with run_later.*`),
+			},
+			{
+				Config:      testTemplateDeploymentPauseWithoutBatches + temp.templateDeploySvcTag1,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Combination.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock((*clients.Client).CreateDeployment).Return(int64(-1), fmt.Errorf("mock error")).Build()
+				},
+				Config:      testTemplateDeploymentBatches("") + temp.templateDeploySvcTag1,
+				ExpectError: regexp.MustCompile(`.*mock error.*`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+				},
+				Config: testTemplateDeploymentBatches("") + temp.templateDeploySvcTag1,
+				Check: resource.ComposeTestCheckFunc(resource.TestCheckResourceAttr("ome_deployment.deploy-template-3", "batch_size", "1"),
+					resource.TestCheckResourceAttr("ome_deployment.deploy-template-3", "max_failures", "0"),
+					resource.TestCheckResourceAttr("ome_deployment.deploy-template-3", "device_status.#", "2"),
+					resource.TestCheckResourceAttr("ome_deployment.deploy-template-3", "device_status.0.status", "deployed"),
+					resource.TestCheckResourceAttr("ome_deployment.deploy-template-3", "device_status.1.status", "deployed"),
+				),
+			},
+		},
+	})
+}
+
+func TestTemplateDeploy_FailureBudget(t *testing.T) {
+	if skipTest() {
+		t.Skip(SkipTestMsg)
+	}
+	temp := initTemplates(t)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// the first failed batch exceeds the failure budget, the apply fails without deploying the second batch
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock((*clients.Client).CreateDeployment).Return(int64(-1), nil).Build()
+					localMocker = Mock((*clients.Client).TrackJob).Return(false, "mock deployment failure").Build()
+				},
+				Config:      testTemplateDeploymentFailureBudget("max_failures = 0") + temp.templateDeploySvcTag1,
+				ExpectError: regexp.MustCompile(`.*1 devices failed to deploy, which exceeds the failure budget of 0.*`),
+			},
+			// without a failure budget the failed devices are saved with the message of their job
+			{
+				PreConfig: func() {
+					if FunctionMocker.MockTimes() != 1 {
+						t.Fatalf("expected the deployment to stop after the first batch, %d batches were deployed", FunctionMocker.MockTimes())
+					}
+					FunctionMocker.UnPatch()
+					localMocker.UnPatch()
+					FunctionMocker = Mock((*clients.Client).CreateDeployment).Return(int64(-1), nil).Build()
+					localMocker = Mock((*clients.Client).TrackJob).Return(false, "mock deployment failure").Build()
+				},
+				Config: testTemplateDeploymentFailureBudget("") + temp.templateDeploySvcTag1,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ome_deployment.deploy-template-3", "device_status.#", "2"),
+					resource.TestCheckResourceAttr("ome_deployment.deploy-template-3", "device_status.0.status", "failed"),
+					resource.TestCheckResourceAttr("ome_deployment.deploy-template-3", "device_status.0.message", "mock deployment failure"),
+					resource.TestCheckResourceAttr("ome_deployment.deploy-template-3", "device_status.1.status", "failed"),
+				),
+			},
+			// the failed devices are not deployed again without retry_failed_devices
+			{
+				PreConfig: func() {
+					if FunctionMocker.MockTimes() != 2 {
+						t.Fatalf("expected both batches to be deployed, %d batches were deployed", FunctionMocker.MockTimes())
+					}
+					FunctionMocker.UnPatch()
+					localMocker.UnPatch()
+				},
+				Config: testTemplateDeploymentFailureBudget("") + temp.templateDeploySvcTag1,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ome_deployment.deploy-template-3", "retry_failed_devices", "false"),
+					resource.TestCheckResourceAttr("ome_deployment.deploy-template-3", "device_status.0.status", "failed"),
+					resource.TestCheckResourceAttr("ome_deployment.deploy-template-3", "device_status.1.status", "failed"),
+				),
+			},
+			// the update deploys the template again to the failed devices
+			{
+				Config: testTemplateDeploymentFailureBudget("retry_failed_devices = true") + temp.templateDeploySvcTag1,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ome_deployment.deploy-template-3", "retry_failed_devices", "true"),
+					resource.TestCheckResourceAttr("ome_deployment.deploy-template-3", "device_status.#", "2"),
+					resource.TestCheckResourceAttr("ome_deployment.deploy-template-3", "device_status.0.status", "deployed"),
+					resource.TestCheckResourceAttr("ome_deployment.deploy-template-3", "device_status.1.status", "deployed"),
+				),
+			},
+		},
+	})
+}
+
+func TestTemplateDeploy_ChassisTemplates(t *testing.T) {
+	if skipTest() {
+		t.Skip(SkipTestMsg)
+	}
+	temp := initTemplates(t)
+	skipWithoutChassis := func() (bool, error) {
+		if ChassisSvcTag1 == "" {
+			t.Log("Skipping as CHASSISSVCTAG1 is not set")
+			return true, nil
+		}
+		return false, nil
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testTemplateDeploymentChassisOptions("resource.ome_template.terraform-acceptance-test-1.name", DeviceSvcTag1) + temp.templateDeploySvcTag1,
+				ExpectError: regexp.MustCompile(`.*chassis_options is only applicable to the templates of chassis.*`),
+			},
+			{
+				SkipFunc:    skipWithoutChassis,
+				Config:      testTemplateDeploymentToDevice(ChassisSvcTag1) + temp.templateDeploySvcTag1,
+				ExpectError: regexp.MustCompile(`.*is a Server template and cannot be deployed to devices.*`),
+			},
+			{
+				SkipFunc: skipWithoutChassis,
+				PreConfig: func() {
+					FunctionMocker = Mock(helper.GetLeadChassis).Return(models.Device{}, fmt.Errorf("mock error")).Build()
+				},
+				Config:      testTemplateDeploymentChassisOptions("resource.ome_template.terraform-acceptance-test-chassis.name", ChassisSvcTag1) + testChassisTemplateForDeploy,
+				ExpectError: regexp.MustCompile(`.*mock error.*`),
+			},
+			{
+				SkipFunc: skipWithoutChassis,
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+				},
+				Config:      testTemplateDeploymentChassisOptions("resource.ome_template.terraform-acceptance-test-chassis.name", DeviceSvcTag1) + testChassisTemplateForDeploy,
+				ExpectError: regexp.MustCompile(`.*is a Chassis template and cannot be deployed to devices.*`),
+			},
+			// the settings of chassis_options are deployed to the lead chassis
+			{
+				SkipFunc: skipWithoutChassis,
+				PreConfig: func() {
+					FunctionMocker = Mock(helper.AddLeadChassisSettings).To(func(resolved map[string][]models.OMEAttribute, leadServiceTag string, settings []models.OMEAttribute) error {
+						leadChassisServiceTag = leadServiceTag
+						leadChassisSettings = settings
+						return addLeadChassisSettings(resolved, leadServiceTag, settings)
+					}).Origin(&addLeadChassisSettings).Build()
+				},
+				Config: testTemplateDeploymentChassisOptions("resource.ome_template.terraform-acceptance-test-chassis.name", ChassisSvcTag1) + testChassisTemplateForDeploy,
+				Check: resource.ComposeTestCheckFunc(
+					func(*terraform.State) error {
+						if leadChassisServiceTag != ChassisSvcTag1 || len(leadChassisSettings) != 1 {
+							return fmt.Errorf("expected the power cap to be deployed to lead chassis %s, %d settings were deployed to %s",
+								ChassisSvcTag1, len(leadChassisSettings), leadChassisServiceTag)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr("ome_deployment.deploy-template-3", "template_device_type", "Chassis"),
+					resource.TestCheckResourceAttr("ome_deployment.deploy-template-3", "chassis_options.power_settings.Power Cap", "3000"),
+					resource.TestCheckResourceAttr("ome_deployment.deploy-template-3", "device_servicetags.0", ChassisSvcTag1),
+					resource.TestCheckResourceAttr("ome_deployment.deploy-template-3", "device_status.#", "1"),
+					resource.TestCheckResourceAttr("ome_deployment.deploy-template-3", "device_status.0.device_servicetag", ChassisSvcTag1),
+					resource.TestCheckResourceAttr("ome_deployment.deploy-template-3", "device_status.0.status", "deployed"),
+				),
+			},
+			// the deployment of a chassis template is imported with its deployment job
+			{
+				SkipFunc: skipWithoutChassis,
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+				},
+				Config:            testTemplateDeploymentChassisOptions("resource.ome_template.terraform-acceptance-test-chassis.name", ChassisSvcTag1) + testChassisTemplateForDeploy,
+				ResourceName:      "ome_deployment.deploy-template-3",
+				ImportState:       true,
+				ImportStateIdFunc: deploymentImportIDWithJob(TestAccUpdateTemplateName),
+				ImportStateCheck:  checkImportedDeployment(ChassisSvcTag1),
+			},
+		},
+	})
+}
+
+func TestTemplateDeploy_IOMTemplate(t *testing.T) {
+	if skipTest() {
+		t.Skip(SkipTestMsg)
+	}
+	if IOMSvcTag1 == "" {
+		t.Skip("Skipping as IOMSVCTAG1 is not set")
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testTemplateDeploymentIOM(DeviceSvcTag1),
+				ExpectError: regexp.MustCompile(`.*is a IO Module template and cannot be deployed to devices.*`),
+			},
+			{
+				Config: testTemplateDeploymentIOM(IOMSvcTag1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ome_deployment.deploy-iom-template", "template_device_type", "IO Module"),
+					resource.TestCheckResourceAttr("ome_deployment.deploy-iom-template", "device_servicetags.0", IOMSvcTag1),
+					resource.TestCheckResourceAttr("ome_deployment.deploy-iom-template", "device_status.#", "1"),
+					resource.TestCheckResourceAttr("ome_deployment.deploy-iom-template", "device_status.0.device_servicetag", IOMSvcTag1),
+					resource.TestCheckResourceAttr("ome_deployment.deploy-iom-template", "device_status.0.status", "deployed"),
+				),
+			},
+			{
+				Config:            testTemplateDeploymentIOM(IOMSvcTag1),
+				ResourceName:      "ome_deployment.deploy-iom-template",
+				ImportState:       true,
+				ImportStateIdFunc: deploymentImportIDWithJob(TestAccIOMTemplateName),
+				ImportStateCheck:  checkImportedDeployment(IOMSvcTag1),
+			},
+		},
+	})
+}
+
+func TestTemplateDeploy_IOMTemplateFailedTarget(t *testing.T) {
+	if skipTest() {
+		t.Skip(SkipTestMsg)
+	}
+	if IOMSvcTag1 == "" {
+		t.Skip("Skipping as IOMSVCTAG1 is not set")
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProvider + testIOMTemplateForDeploy,
+			},
+			// the failed target of an IOM template is kept in the device service tags and its outcome in the device status
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock((*clients.Client).CreateDeployment).Return(int64(-1), nil).Build()
+					localMocker = Mock((*clients.Client).TrackJob).Return(false, "mock deployment failure").Build()
+				},
+				Config: testTemplateDeploymentIOM(IOMSvcTag1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ome_deployment.deploy-iom-template", "device_servicetags.#", "1"),
+					resource.TestCheckResourceAttr("ome_deployment.deploy-iom-template", "device_servicetags.0", IOMSvcTag1),
+					resource.TestCheckResourceAttr("ome_deployment.deploy-iom-template", "device_status.#", "1"),
+					resource.TestCheckResourceAttr("ome_deployment.deploy-iom-template", "device_status.0.status", "failed"),
+					resource.TestCheckResourceAttr("ome_deployment.deploy-iom-template", "device_status.0.message", "mock deployment failure"),
+				),
+			},
+			// the failed target is kept without being deployed again when retry_failed_devices is false
+			{
+				PreConfig: func() {
+					FunctionMocker.UnPatch()
+					localMocker.UnPatch()
+					FunctionMocker = Mock((*clients.Client).CreateDeployment).Return(int64(-1), nil).Build()
+				},
+				Config: testTemplateDeploymentIOMWithOptions(IOMSvcTag1, "max_failures = 5"),
+				Check: resource.ComposeTestCheckFunc(
+					func(*terraform.State) error {
+						if FunctionMocker.MockTimes() != 0 {
+							return fmt.Errorf("expected no deployment job for the failed target, %d jobs were created", FunctionMocker.MockTimes())
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr("ome_deployment.deploy-iom-template", "retry_failed_devices", "false"),
+					resource.TestCheckResourceAttr("ome_deployment.deploy-iom-template", "max_failures", "5"),
+					resource.TestCheckResourceAttr("ome_deployment.deploy-iom-template", "device_servicetags.0", IOMSvcTag1),
+					resource.TestCheckResourceAttr("ome_deployment.deploy-iom-template", "device_status.0.status", "failed"),
+				),
+			},
+		},
+	})
+}
+
+// deploymentImportIDWithJob returns the import id of the deployment of a chassis or IOM template with its deployment job
+func deploymentImportIDWithJob(templateName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type == "ome_deployment" {
+				return templateName + ":" + rs.Primary.Attributes["device_status.0.job_id"], nil
+			}
+		}
+		return "", fmt.Errorf("no deployment found in the state")
+	}
+}
+
+// checkImportedDeployment checks that the device is the deployed target of the imported deployment
+func checkImportedDeployment(serviceTag string) resource.ImportStateCheckFunc {
+	return func(states []*terraform.InstanceState) error {
+		if len(states) != 1 {
+			return fmt.Errorf("expected one imported deployment, got %d", len(states))
+		}
+		attributes := states[0].Attributes
+		if attributes["device_servicetags.0"] != serviceTag || attributes["device_status.0.status"] != "deployed" {
+			return fmt.Errorf("expected the template to be deployed to %s, got the targets %s with status %s",
+				serviceTag, attributes["device_servicetags.0"], attributes["device_status.0.status"])
+		}
+		return nil
+	}
+}
+
 // Add resource as applicable
 var testTemplateDeploymentIDSTGNMutuallyExclusive1 = `
 	provider "ome" {
@@ -530,3 +933,177 @@ var testAccImportDeploymentSuccess = `
 	resource "ome_deployment" "import-deployment-success" {
 	}
 `
+
+var testTemplateDeploymentDuplicateAttributeOverrides = testProvider + `
+	resource "ome_deployment" "deploy-template-3" {
+		template_name = resource.ome_template.terraform-acceptance-test-1.name
+		device_servicetags = ["` + DeviceSvcTag1 + `"]
+		device_attribute_overrides = [
+			{
+				device_servicetags = ["` + DeviceSvcTag1 + `"]
+				attributes = {
+					"ServerTopology 1 Aisle Name" = "aisle-1"
+					"serverTopology 1 aisle name" = "aisle-2"
+				}
+			}
+		]
+	}
+`
+
+func testTemplateDeploymentAttributeOverrides(attributePath, serviceTag string) string {
+	return `
+	provider "ome" {
+		username = "` + omeUserName + `"
+		password = "` + omePassword + `"
+		host = "` + omeHost + `"
+		skipssl = true
+	}
+
+	resource "ome_deployment" "deploy-template-3" {
+		template_name = resource.ome_template.terraform-acceptance-test-1.name
+		device_servicetags = ["` + DeviceSvcTag1 + `"]
+		device_attribute_overrides = [
+			{
+				device_servicetags = ["` + serviceTag + `"]
+				attributes = {
+					"` + attributePath + `" = "aisle-1"
+				}
+			}
+		]
+	}
+`
+}
+
+var testTemplateDeploymentAttributesConflict = `
+	provider "ome" {
+		username = "` + omeUserName + `"
+		password = "` + omePassword + `"
+		host = "` + omeHost + `"
+		skipssl = true
+	}
+
+	resource "ome_deployment" "deploy-template-3" {
+		template_name = resource.ome_template.terraform-acceptance-test-1.name
+		device_servicetags = ["` + DeviceSvcTag1 + `"]
+		device_attributes = [
+			{
+				device_servicetags = ["` + DeviceSvcTag1 + `"]
+				attributes = [
+					{
+						attribute_id = 1197
+						display_name = "ServerTopology 1 Aisle Name"
+						value = "aisle-1"
+						is_ignored = false
+					}
+				]
+			}
+		]
+		device_attribute_overrides = [
+			{
+				device_servicetags = ["` + DeviceSvcTag1 + `"]
+				attributes = {
+					"ServerTopology 1 Aisle Name" = "aisle-1"
+				}
+			}
+		]
+	}
+`
+
+func testTemplateDeploymentBatches(schedule string) string {
+	return `
+	provider "ome" {
+		username = "` + omeUserName + `"
+		password = "` + omePassword + `"
+		host = "` + omeHost + `"
+		skipssl = true
+	}
+
+	resource "ome_deployment" "deploy-template-3" {
+		template_name = resource.ome_template.terraform-acceptance-test-1.name
+		device_servicetags = ["` + DeviceSvcTag1 + `", "` + DeviceSvcTag2 + `"]
+		batch_size = 1
+		max_failures = 0
+		pause_between_batches = 10
+		` + schedule + `
+	}
+`
+}
+
+func testTemplateDeploymentFailureBudget(options string) string {
+	return testProvider + `
+	resource "ome_deployment" "deploy-template-3" {
+		template_name = resource.ome_template.terraform-acceptance-test-1.name
+		device_servicetags = ["` + DeviceSvcTag1 + `", "` + DeviceSvcTag2 + `"]
+		batch_size = 1
+		` + options + `
+	}
+`
+}
+
+var testTemplateDeploymentPauseWithoutBatches = `
+	provider "ome" {
+		username = "` + omeUserName + `"
+		password = "` + omePassword + `"
+		host = "` + omeHost + `"
+		skipssl = true
+	}
+
+	resource "ome_deployment" "deploy-template-3" {
+		template_name = resource.ome_template.terraform-acceptance-test-1.name
+		device_servicetags = ["` + DeviceSvcTag1 + `"]
+		pause_between_batches = 10
+	}
+`
+
+func testTemplateDeploymentToDevice(serviceTag string) string {
+	return testProvider + `
+	resource "ome_deployment" "deploy-template-3" {
+		template_name = resource.ome_template.terraform-acceptance-test-1.name
+		device_servicetags = ["` + serviceTag + `"]
+	}
+`
+}
+
+func testTemplateDeploymentChassisOptions(templateName, serviceTag string) string {
+	return testProvider + `
+	resource "ome_deployment" "deploy-template-3" {
+		template_name = ` + templateName + `
+		device_servicetags = ["` + serviceTag + `"]
+		chassis_options = {
+			power_settings = {
+				"Power Cap" = "3000"
+			}
+		}
+	}
+`
+}
+
+func testTemplateDeploymentIOM(serviceTag string) string {
+	return testTemplateDeploymentIOMWithOptions(serviceTag, "")
+}
+
+func testTemplateDeploymentIOMWithOptions(serviceTag, options string) string {
+	return testProvider + testIOMTemplateForDeploy + `
+	resource "ome_deployment" "deploy-iom-template" {
+		template_name = resource.ome_template.terraform-acceptance-test-iom.name
+		device_servicetags = ["` + serviceTag + `"]
+		` + options + `
+	}
+`
+}
+
+var testIOMTemplateForDeploy = `
+	resource "ome_template" "terraform-acceptance-test-iom" {
+		name = "` + TestAccIOMTemplateName + `"
+		refdevice_servicetag = "` + IOMSvcTag1 + `"
+		device_type = "IO Module"
+	}
+`
+
+var testChassisTemplateForDeploy = `
+	resource "ome_template" "terraform-acceptance-test-chassis" {
+		name = "` + TestAccUpdateTemplateName + `"
+		refdevice_servicetag = "` + ChassisSvcTag1 + `"
+		device_type = "Chassis"
+	}
+`
@@ -0,0 +1,194 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/helper"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &deviceManagementResource{}
+	_ resource.ResourceWithConfigure      = &deviceManagementResource{}
+	_ resource.ResourceWithValidateConfig = &deviceManagementResource{}
+)
+
+// NewDeviceManagementResource is a helper function to simplify the provider implementation.
+func NewDeviceManagementResource() resource.Resource {
+	return &deviceManagementResource{}
+}
+
+// deviceManagementResource is the resource implementation.
+type deviceManagementResource struct {
+	p *omeProvider
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *deviceManagementResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.p = req.ProviderData.(*omeProvider)
+}
+
+// Metadata returns the resource type name.
+func (r *deviceManagementResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "device_management"
+}
+
+// Schema defines the schema for the resource.
+func (r *deviceManagementResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This terraform resource is used to manage the managed state of a device discovered on OME" +
+			" and the credentials OME uses to communicate with it." +
+			" An onboarding job is run when the managed state or the credentials change, then the managed state of the device is validated." +
+			" Destroying the resource does not change the device." +
+			" Import is not supported as the credentials of the device are not read back from OME.",
+		Version:    1,
+		Attributes: DeviceManagementSchema(),
+	}
+}
+
+// ValidateConfig validates the device management configuration.
+func (r *deviceManagementResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data models.OmeDeviceManagement
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if data.Redfish == nil && data.WSMAN == nil {
+		resp.Diagnostics.AddError(
+			"Attribute Error",
+			"at least one of redfish and wsman is required",
+		)
+	}
+}
+
+// Create runs the onboarding job of the device and sets the initial Terraform state.
+func (r *deviceManagementResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "resource_device_management create: started")
+	var plan models.OmeDeviceManagement
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_device_management Create")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	device, err := helper.GetManagedDevice(omeClient, plan.DeviceID.ValueInt64(), plan.ServiceTag.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrCreateDeviceManagement, err.Error())
+		return
+	}
+	connectionProfile := getConnectionProfile(ctx, models.OmeDiscoveryConfigTargets{Redfish: plan.Redfish, WSMAN: plan.WSMAN})
+	device, err = helper.ApplyDeviceManagement(omeClient, device.ID, plan.ManagedState.ValueString(), connectionProfile, plan.Timeout.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrCreateDeviceManagement, err.Error())
+		return
+	}
+	state := helper.NewDeviceManagementState(device, plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "resource_device_management create: finished")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *deviceManagementResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "resource_device_management read: started")
+	var state models.OmeDeviceManagement
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_device_management Read")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	device, err := helper.GetDeviceByID(omeClient, state.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrReadDeviceManagement, err.Error())
+		return
+	}
+	state = helper.NewDeviceManagementState(device, state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "resource_device_management read: finished")
+}
+
+// Update runs the onboarding job of the device with the new managed state or credentials.
+func (r *deviceManagementResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "resource_device_management update: started")
+	var state, plan models.OmeDeviceManagement
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_device_management Update")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	connectionProfile := getConnectionProfile(ctx, models.OmeDiscoveryConfigTargets{Redfish: plan.Redfish, WSMAN: plan.WSMAN})
+	stateProfile := getConnectionProfile(ctx, models.OmeDiscoveryConfigTargets{Redfish: state.Redfish, WSMAN: state.WSMAN})
+	if plan.ManagedState.Equal(state.ManagedState) && connectionProfile == stateProfile {
+		// only the timeout changed
+		state.Timeout = plan.Timeout
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
+	device, err := helper.ApplyDeviceManagement(omeClient, state.ID.ValueInt64(), plan.ManagedState.ValueString(), connectionProfile, plan.Timeout.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrUpdateDeviceManagement, err.Error())
+		return
+	}
+	state = helper.NewDeviceManagementState(device, plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "resource_device_management update: finished")
+}
+
+// Delete removes the Terraform state, the device is left untouched.
+func (r *deviceManagementResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "resource_device_management delete: started")
+	resp.State.RemoveResource(ctx)
+	tflog.Trace(ctx, "resource_device_management delete: finished")
+}
@@ -0,0 +1,102 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// DeviceManagementSchema returns the schema for the device management resource
+func DeviceManagementSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the device.",
+			Description:         "ID of the device.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"device_id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the device. Exactly one of `device_id` and `service_tag` is required." +
+				" If the value of `device_id` changes, Terraform will destroy and recreate the resource.",
+			Description: "ID of the device. Exactly one of 'device_id' and 'service_tag' is required." +
+				" If the value of 'device_id' changes, Terraform will destroy and recreate the resource.",
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplaceIfConfigured(),
+				int64planmodifier.UseStateForUnknown(),
+			},
+			Validators: []validator.Int64{
+				int64validator.ExactlyOneOf(path.MatchRoot("service_tag")),
+			},
+		},
+		"service_tag": schema.StringAttribute{
+			MarkdownDescription: "Service tag of the device. Exactly one of `device_id` and `service_tag` is required." +
+				" If the value of `service_tag` changes, Terraform will destroy and recreate the resource.",
+			Description: "Service tag of the device. Exactly one of 'device_id' and 'service_tag' is required." +
+				" If the value of 'service_tag' changes, Terraform will destroy and recreate the resource.",
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+				stringplanmodifier.UseStateForUnknown(),
+			},
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"managed_state": schema.StringAttribute{
+			MarkdownDescription: "Managed state of the device. Supported values are `managed` and `monitored`." +
+				" An onboarding job is run when the managed state or the credentials change.",
+			Description: "Managed state of the device. Supported values are 'managed' and 'monitored'." +
+				" An onboarding job is run when the managed state or the credentials change.",
+			Required: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("managed", "monitored"),
+			},
+		},
+		"redfish": schema.SingleNestedAttribute{
+			MarkdownDescription: "REDFISH credentials OME uses to communicate with the device. At least one of `redfish` and `wsman` is required.",
+			Description:         "REDFISH credentials OME uses to communicate with the device. At least one of 'redfish' and 'wsman' is required.",
+			Optional:            true,
+			Attributes:          RedfishSchema(),
+		},
+		"wsman": schema.SingleNestedAttribute{
+			MarkdownDescription: "WSMAN credentials OME uses to communicate with the device. At least one of `redfish` and `wsman` is required.",
+			Description:         "WSMAN credentials OME uses to communicate with the device. At least one of 'redfish' and 'wsman' is required.",
+			Optional:            true,
+			Attributes:          WSMANSchema(),
+		},
+		"timeout": schema.Int64Attribute{
+			MarkdownDescription: "Time in minutes to wait for the onboarding job. Default value is `20`.",
+			Description:         "Time in minutes to wait for the onboarding job. Default value is '20'.",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(20),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+	}
+}
@@ -0,0 +1,216 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/helper"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestDeviceManagementResource(t *testing.T) {
+	var managementTfName = "ome_device_management.manage"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDeviceManagementCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(managementTfName, "device_id"),
+					resource.TestCheckResourceAttr(managementTfName, "service_tag", DeviceSvcTag1),
+					resource.TestCheckResourceAttr(managementTfName, "managed_state", "managed"),
+				),
+			},
+			// only the timeout changes, no onboarding job is run
+			{
+				Config: testDeviceManagementTimeout,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(managementTfName, "timeout", "30"),
+				),
+			},
+		},
+	})
+}
+
+func TestDeviceManagementResourceValidationError(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testDeviceManagementNoCredentials,
+				ExpectError: regexp.MustCompile(`.*at least one of redfish and wsman is required.*`),
+			},
+			{
+				Config:      testDeviceManagementBothTargets,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Combination.*`),
+			},
+		},
+	})
+}
+
+func TestDeviceManagementResourceJobs(t *testing.T) {
+	var jobs []string
+	var managedStates []string
+	mockJobs := func() {
+		jobs, managedStates = nil, nil
+		FunctionMocker = Mock(helper.SetDeviceManagedState).To(func(_ *clients.Client, _ int64, managedState string, connectionProfile string, _ int64) error {
+			managedStates = append(managedStates, managedState)
+			jobs = append(jobs, connectionProfile)
+			return nil
+		}).Build()
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testDeviceManagementInvalidServiceTag,
+				ExpectError: regexp.MustCompile(`.*error setting device management.*`),
+			},
+			{
+				Config: testDeviceManagementCreate,
+			},
+			// only the timeout changes, no onboarding job is run
+			{
+				PreConfig: mockJobs,
+				Config:    testDeviceManagementTimeout,
+				Check: resource.ComposeTestCheckFunc(
+					func(_ *terraform.State) error {
+						FunctionMocker.UnPatch()
+						if len(jobs) != 0 {
+							return fmt.Errorf("expected no onboarding job, got %d", len(jobs))
+						}
+						return nil
+					},
+				),
+			},
+			// the rotated credentials are sent in the connection profile of the onboarding job
+			{
+				PreConfig: mockJobs,
+				Config:    testDeviceManagementRotate,
+				Check: resource.ComposeTestCheckFunc(
+					func(_ *terraform.State) error {
+						FunctionMocker.UnPatch()
+						if len(jobs) != 1 || managedStates[0] != "managed" {
+							return fmt.Errorf("expected one onboarding job as managed, got %v", managedStates)
+						}
+						if !strings.Contains(jobs[0], `"password":"rotated"`) || !strings.Contains(jobs[0], `"REDFISH"`) {
+							return fmt.Errorf("expected the rotated redfish credentials in the connection profile, got %s", jobs[0])
+						}
+						return nil
+					},
+				),
+			},
+			// the job succeeds but the device is still managed
+			{
+				PreConfig:   mockJobs,
+				Config:      testDeviceManagementMonitored,
+				ExpectError: regexp.MustCompile(`.*is managed after the onboarding job instead of monitored.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.UnPatch()
+					if len(managedStates) != 1 || managedStates[0] != "monitored" {
+						t.Errorf("expected one onboarding job as monitored, got %v", managedStates)
+					}
+				},
+				Config: testDeviceManagementCreate,
+			},
+		},
+	})
+}
+
+var testDeviceManagementCreate = testProvider + `
+resource "ome_device_management" "manage" {
+	service_tag   = "` + DeviceSvcTag1 + `"
+	managed_state = "managed"
+	redfish = {
+		username = "` + IdracUsername + `"
+		password = "` + IdracPassword + `"
+	}
+}
+`
+
+var testDeviceManagementTimeout = testProvider + `
+resource "ome_device_management" "manage" {
+	service_tag   = "` + DeviceSvcTag1 + `"
+	managed_state = "managed"
+	redfish = {
+		username = "` + IdracUsername + `"
+		password = "` + IdracPassword + `"
+	}
+	timeout = 30
+}
+`
+
+var testDeviceManagementRotate = testProvider + `
+resource "ome_device_management" "manage" {
+	service_tag   = "` + DeviceSvcTag1 + `"
+	managed_state = "managed"
+	redfish = {
+		username = "` + IdracUsername + `"
+		password = "rotated"
+	}
+}
+`
+
+var testDeviceManagementMonitored = testProvider + `
+resource "ome_device_management" "manage" {
+	service_tag   = "` + DeviceSvcTag1 + `"
+	managed_state = "monitored"
+	redfish = {
+		username = "` + IdracUsername + `"
+		password = "` + IdracPassword + `"
+	}
+}
+`
+
+var testDeviceManagementNoCredentials = testProvider + `
+resource "ome_device_management" "manage" {
+	service_tag   = "` + DeviceSvcTag1 + `"
+	managed_state = "managed"
+}
+`
+
+var testDeviceManagementBothTargets = testProvider + `
+resource "ome_device_management" "manage" {
+	device_id     = 1
+	service_tag   = "` + DeviceSvcTag1 + `"
+	managed_state = "managed"
+	redfish = {
+		username = "` + IdracUsername + `"
+		password = "` + IdracPassword + `"
+	}
+}
+`
+
+var testDeviceManagementInvalidServiceTag = testProvider + `
+resource "ome_device_management" "manage" {
+	service_tag   = "invalid"
+	managed_state = "managed"
+	redfish = {
+		username = "` + IdracUsername + `"
+		password = "` + IdracPassword + `"
+	}
+}
+`
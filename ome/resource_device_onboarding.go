@@ -0,0 +1,346 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/helper"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &deviceOnboardingResource{}
+	_ resource.ResourceWithConfigure      = &deviceOnboardingResource{}
+	_ resource.ResourceWithValidateConfig = &deviceOnboardingResource{}
+)
+
+// NewDeviceOnboardingResource is a helper function to simplify the provider implementation.
+func NewDeviceOnboardingResource() resource.Resource {
+	return &deviceOnboardingResource{}
+}
+
+// deviceOnboardingResource is the resource implementation.
+type deviceOnboardingResource struct {
+	p *omeProvider
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *deviceOnboardingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.p = req.ProviderData.(*omeProvider)
+}
+
+// Metadata returns the resource type name.
+func (r *deviceOnboardingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "device_onboarding"
+}
+
+// Schema defines the schema for the resource.
+func (r *deviceOnboardingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This terraform resource is used to onboard a single device on OME." +
+			" The device is discovered with its management IP and credentials, its ID and service tag are resolved," +
+			" then its managed state and group membership are set and the resource waits until its inventory is collected." +
+			" Import is not supported as the credentials of the device are not read back from OME.",
+		Version:    1,
+		Attributes: DeviceOnboardingSchema(),
+	}
+}
+
+// ValidateConfig validates the device onboarding configuration.
+func (r *deviceOnboardingResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data models.OmeDeviceOnboarding
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if data.Redfish == nil && data.WSMAN == nil {
+		resp.Diagnostics.AddError(
+			"Attribute Error",
+			"at least one of redfish and wsman is required",
+		)
+	}
+}
+
+// Create discovers the device and sets the initial Terraform state.
+func (r *deviceOnboardingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "resource_device_onboarding create: started")
+	var plan models.OmeDeviceOnboarding
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_device_onboarding Create")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	payload, connectionProfile := getOnboardingDiscoveryPayload(ctx, plan)
+	job, err := helper.CreateOnboardingDiscovery(omeClient, payload)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrCreateDeviceOnboarding, err.Error())
+		return
+	}
+	plan.DiscoveryJobID = types.Int64Value(int64(job.DiscoveryConfigGroupID))
+	if len(job.DiscoveryConfigTaskParam) != 1 {
+		err = fmt.Errorf("discovery job of %s is not started", plan.ManagementIP.ValueString())
+	} else {
+		var device models.Device
+		device, err = helper.DiscoverOnboardingDevice(ctx, omeClient, int64(job.DiscoveryConfigTaskParam[0].TaskID),
+			plan.ManagementIP.ValueString(), plan.Timeout.ValueInt64())
+		if err == nil {
+			state, d := applyDeviceOnboarding(ctx, omeClient, device, plan, nil, connectionProfile, true)
+			resp.Diagnostics.Append(d...)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			tflog.Trace(ctx, "resource_device_onboarding create: finished")
+			return
+		}
+	}
+
+	// the device is not discovered, the discovery job is removed so that a new one can be created
+	if errDelete := helper.DeleteOnboardingDiscovery(omeClient, plan.DiscoveryJobID.ValueInt64()); errDelete != nil {
+		tflog.Warn(ctx, "unable to delete the discovery job: "+errDelete.Error())
+	}
+	resp.Diagnostics.AddError(clients.ErrGnrCreateDeviceOnboarding, err.Error())
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *deviceOnboardingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "resource_device_onboarding read: started")
+	var state models.OmeDeviceOnboarding
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_device_onboarding Read")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	device, err := helper.GetDeviceByID(omeClient, state.ID.ValueInt64())
+	if errors.Is(err, clients.ErrItemNotFound) {
+		// the device has been removed from OME
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrReadDeviceOnboarding, err.Error())
+		return
+	}
+	groupIDs := []int64{}
+	if !state.GroupIDs.IsNull() {
+		resp.Diagnostics.Append(state.GroupIDs.ElementsAs(ctx, &groupIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	groupIDs, err = helper.GetDeviceGroupIDs(omeClient, device.ID, groupIDs)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrReadDeviceOnboarding, err.Error())
+		return
+	}
+	state, d = helper.NewDeviceOnboardingState(device, groupIDs, state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "resource_device_onboarding read: finished")
+}
+
+// Update updates the managed state and the groups of the device, the other changes recreate the resource.
+func (r *deviceOnboardingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "resource_device_onboarding update: started")
+	var state, plan models.OmeDeviceOnboarding
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_device_onboarding Update")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	device, err := helper.GetDeviceByID(omeClient, state.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrUpdateDeviceOnboarding, err.Error())
+		return
+	}
+	stateGroupIDs := []int64{}
+	if !state.GroupIDs.IsNull() {
+		resp.Diagnostics.Append(state.GroupIDs.ElementsAs(ctx, &stateGroupIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	_, connectionProfile := getOnboardingDiscoveryPayload(ctx, plan)
+	state, d = applyDeviceOnboarding(ctx, omeClient, device, plan, stateGroupIDs, connectionProfile, false)
+	resp.Diagnostics.Append(d...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "resource_device_onboarding update: finished")
+}
+
+// Delete removes the device and its discovery job from OME and removes the Terraform state on success.
+func (r *deviceOnboardingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "resource_device_onboarding delete: started")
+	var state models.OmeDeviceOnboarding
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_device_onboarding Delete")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	if state.RemoveOnDestroy.ValueBool() {
+		if err := omeClient.RemoveDevices([]int64{state.ID.ValueInt64()}); err != nil {
+			resp.Diagnostics.AddError(clients.ErrGnrDeleteDeviceOnboarding, err.Error())
+			return
+		}
+	}
+	if err := helper.DeleteOnboardingDiscovery(omeClient, state.DiscoveryJobID.ValueInt64()); err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrDeleteDeviceOnboarding, err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Trace(ctx, "resource_device_onboarding delete: finished")
+}
+
+// getOnboardingDiscoveryPayload builds the discovery job of the management ip and its connection profile
+func getOnboardingDiscoveryPayload(ctx context.Context, plan models.OmeDeviceOnboarding) (models.DiscoveryJob, string) {
+	target := models.OmeDiscoveryConfigTargets{
+		Redfish: plan.Redfish,
+		WSMAN:   plan.WSMAN,
+	}
+	connectionProfile := getConnectionProfile(ctx, target)
+	deviceMap := map[string]int{
+		"SERVER":         1000,
+		"NETWORK SWITCH": 7000,
+		"CHASSIS":        2000,
+		"STORAGE":        5000,
+	}
+	payload := models.DiscoveryJob{
+		DiscoveryConfigGroupName: "Onboarding " + plan.ManagementIP.ValueString(),
+		TrapDestination:          plan.TrapDestination.ValueBool(),
+		Schedule: models.ScheduleJob{
+			RunNow: true,
+			Cron:   "startnow",
+		},
+		DiscoveryConfigModels: []models.DiscoveryConfigModels{
+			{
+				DeviceType: []int{deviceMap[plan.DeviceType.ValueString()]},
+				DiscoveryConfigTargets: []models.DiscoveryConfigTargets{
+					{
+						NetworkAddressDetail: plan.ManagementIP.ValueString(),
+						AddressType:          30,
+					},
+				},
+				ConnectionProfile: connectionProfile,
+			},
+		},
+	}
+	return payload, connectionProfile
+}
+
+// applyDeviceOnboarding sets the managed state and the groups of the discovered device, optionally waits for its inventory,
+// then reads the device back. The state is returned even on error so that the completed steps are kept.
+func applyDeviceOnboarding(ctx context.Context, omeClient *clients.Client, device models.Device, plan models.OmeDeviceOnboarding,
+	stateGroupIDs []int64, connectionProfile string, waitInventory bool) (models.OmeDeviceOnboarding, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	summary := clients.ErrGnrUpdateDeviceOnboarding
+	if waitInventory {
+		summary = clients.ErrGnrCreateDeviceOnboarding
+	}
+	planGroupIDs := []int64{}
+	if !plan.GroupIDs.IsNull() {
+		diags.Append(plan.GroupIDs.ElementsAs(ctx, &planGroupIDs, false)...)
+		if diags.HasError() {
+			return plan, diags
+		}
+	}
+
+	var err error
+	managedState := plan.ManagedState.ValueString()
+	if managedState != "" && managedState != helper.DeviceManagedStateName(device) {
+		err = helper.SetDeviceManagedState(omeClient, device.ID, managedState, connectionProfile, plan.Timeout.ValueInt64())
+	}
+	if err == nil {
+		err = helper.UpdateDeviceGroups(omeClient, device.ID, planGroupIDs, stateGroupIDs)
+	}
+	if err == nil && waitInventory {
+		err = helper.WaitForDeviceInventory(omeClient, device.ID, plan.Timeout.ValueInt64())
+	}
+	if err != nil {
+		diags.AddError(summary, err.Error())
+		// the groups which were not updated are read back from OME
+		for _, id := range stateGroupIDs {
+			if !slices.Contains(planGroupIDs, id) {
+				planGroupIDs = append(planGroupIDs, id)
+			}
+		}
+	}
+
+	if current, errRead := helper.GetDeviceByID(omeClient, device.ID); errRead == nil {
+		device = current
+	} else {
+		diags.AddError(summary, errRead.Error())
+	}
+	groupIDs, errRead := helper.GetDeviceGroupIDs(omeClient, device.ID, planGroupIDs)
+	if errRead != nil {
+		diags.AddError(summary, errRead.Error())
+	}
+	state, d := helper.NewDeviceOnboardingState(device, groupIDs, plan)
+	diags.Append(d...)
+	return state, diags
+}
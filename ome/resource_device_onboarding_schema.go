@@ -0,0 +1,172 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DeviceOnboardingSchema returns the schema for the device onboarding resource
+func DeviceOnboardingSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the onboarded device.",
+			Description:         "ID of the onboarded device.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"management_ip": schema.StringAttribute{
+			MarkdownDescription: "Management IP address of the device to discover, for example the iDRAC IP of a server." +
+				" If the value of `management_ip` changes, Terraform will destroy and recreate the resource.",
+			Description: "Management IP address of the device to discover, for example the iDRAC IP of a server." +
+				" If the value of 'management_ip' changes, Terraform will destroy and recreate the resource.",
+			Required: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"device_type": schema.StringAttribute{
+			MarkdownDescription: "Type of the device to discover. Supported values are `SERVER`, `CHASSIS`, `NETWORK SWITCH` and `STORAGE`." +
+				" Default value is `SERVER`." +
+				" If the value of `device_type` changes, Terraform will destroy and recreate the resource.",
+			Description: "Type of the device to discover. Supported values are 'SERVER', 'CHASSIS', 'NETWORK SWITCH' and 'STORAGE'." +
+				" Default value is 'SERVER'." +
+				" If the value of 'device_type' changes, Terraform will destroy and recreate the resource.",
+			Optional: true,
+			Computed: true,
+			Default:  stringdefault.StaticString("SERVER"),
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.OneOf("SERVER", "CHASSIS", "NETWORK SWITCH", "STORAGE"),
+			},
+		},
+		"redfish": schema.SingleNestedAttribute{
+			MarkdownDescription: "REDFISH credentials of the device. At least one of `redfish` and `wsman` is required." +
+				" The credentials are only used to discover and onboard the device, changing them does not rediscover the device.",
+			Description: "REDFISH credentials of the device. At least one of 'redfish' and 'wsman' is required." +
+				" The credentials are only used to discover and onboard the device, changing them does not rediscover the device.",
+			Optional:   true,
+			Attributes: RedfishSchema(),
+		},
+		"wsman": schema.SingleNestedAttribute{
+			MarkdownDescription: "WSMAN credentials of the device. At least one of `redfish` and `wsman` is required." +
+				" The credentials are only used to discover and onboard the device, changing them does not rediscover the device.",
+			Description: "WSMAN credentials of the device. At least one of 'redfish' and 'wsman' is required." +
+				" The credentials are only used to discover and onboard the device, changing them does not rediscover the device.",
+			Optional:   true,
+			Attributes: WSMANSchema(),
+		},
+		"trap_destination": schema.BoolAttribute{
+			MarkdownDescription: "Enable OME to receive the traps of the device. Default value is `false`." +
+				" If the value of `trap_destination` changes, Terraform will destroy and recreate the resource.",
+			Description: "Enable OME to receive the traps of the device. Default value is 'false'." +
+				" If the value of 'trap_destination' changes, Terraform will destroy and recreate the resource.",
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(false),
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.RequiresReplace(),
+			},
+		},
+		"managed_state": schema.StringAttribute{
+			MarkdownDescription: "Managed state of the device. Supported values are `managed` and `monitored`." +
+				" When set and different from the state of the discovered device, an onboarding job is run with the credentials." +
+				" When not set, the managed state resulting from the discovery is kept.",
+			Description: "Managed state of the device. Supported values are 'managed' and 'monitored'." +
+				" When set and different from the state of the discovered device, an onboarding job is run with the credentials." +
+				" When not set, the managed state resulting from the discovery is kept.",
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+			Validators: []validator.String{
+				stringvalidator.OneOf("managed", "monitored"),
+			},
+		},
+		"group_ids": schema.SetAttribute{
+			MarkdownDescription: "IDs of the static groups the device is added to." +
+				" The device is removed from the groups which are removed from `group_ids`.",
+			Description: "IDs of the static groups the device is added to." +
+				" The device is removed from the groups which are removed from 'group_ids'.",
+			Optional:    true,
+			ElementType: types.Int64Type,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+			},
+		},
+		"timeout": schema.Int64Attribute{
+			MarkdownDescription: "Time in minutes to wait for each of the discovery, the onboarding and the inventory collection of the device." +
+				" Default value is `20`.",
+			Description: "Time in minutes to wait for each of the discovery, the onboarding and the inventory collection of the device." +
+				" Default value is '20'.",
+			Optional: true,
+			Computed: true,
+			Default:  int64default.StaticInt64(20),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"remove_on_destroy": schema.BoolAttribute{
+			MarkdownDescription: "Remove the device from OME when the resource is destroyed. Default value is `true`.",
+			Description:         "Remove the device from OME when the resource is destroyed. Default value is 'true'.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+		},
+		"service_tag": schema.StringAttribute{
+			MarkdownDescription: "Service tag of the onboarded device.",
+			Description:         "Service tag of the onboarded device.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"device_name": schema.StringAttribute{
+			MarkdownDescription: "Name of the onboarded device.",
+			Description:         "Name of the onboarded device.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"discovery_job_id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the discovery job which discovered the device.",
+			Description:         "ID of the discovery job which discovered the device.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
@@ -0,0 +1,197 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/helper"
+	"terraform-provider-ome/models"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestDeviceOnboardingResource(t *testing.T) {
+	var onboardingTfName = "ome_device_onboarding.onboard"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDeviceOnboardingCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(onboardingTfName, "id"),
+					resource.TestCheckResourceAttrSet(onboardingTfName, "service_tag"),
+					resource.TestCheckResourceAttrSet(onboardingTfName, "discovery_job_id"),
+					resource.TestCheckResourceAttr(onboardingTfName, "managed_state", "managed"),
+					resource.TestCheckResourceAttr(onboardingTfName, "group_ids.#", "1"),
+				),
+			},
+			// remove the device from the group
+			{
+				Config: testDeviceOnboardingUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(onboardingTfName, "group_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestDeviceOnboardingResourceValidationError(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testDeviceOnboardingNoCredentials,
+				ExpectError: regexp.MustCompile(`.*at least one of redfish and wsman is required.*`),
+			},
+			{
+				Config:      testDeviceOnboardingInvalidState,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Value Match.*`),
+			},
+		},
+	})
+}
+
+func TestDeviceOnboardingResourceSteps(t *testing.T) {
+	var (
+		onboardingTfName   = "ome_device_onboarding.onboard"
+		payload            models.DiscoveryJob
+		deletedJobID       int64
+		removeGroupMembers func(*clients.Client, models.GroupMemberPayload) error
+		removed            []models.GroupMemberPayload
+	)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// the discovery job of a device which is not discovered is deleted so that the next apply can create it again
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock(helper.CreateOnboardingDiscovery).To(func(_ *clients.Client, p models.DiscoveryJob) (models.DiscoveryJob, error) {
+						payload = p
+						return models.DiscoveryJob{
+							DiscoveryConfigGroupID:   42,
+							DiscoveryConfigTaskParam: []models.DiscoveryConfigTaskParam{{TaskID: 7}},
+						}, nil
+					}).Build()
+					localMocker = Mock(helper.DiscoverOnboardingDevice).Return(models.Device{}, fmt.Errorf("mock error")).Build()
+					localMocker2 = Mock(helper.DeleteOnboardingDiscovery).To(func(_ *clients.Client, discoveryJobID int64) error {
+						deletedJobID = discoveryJobID
+						return nil
+					}).Build()
+				},
+				Config:      testDeviceOnboardingCreate,
+				ExpectError: regexp.MustCompile(`.*error onboarding device.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.UnPatch()
+					localMocker.UnPatch()
+					localMocker2.UnPatch()
+					if deletedJobID != 42 {
+						t.Errorf("expected the discovery job 42 to be deleted, deleted %d", deletedJobID)
+					}
+					targets := payload.DiscoveryConfigModels[0].DiscoveryConfigTargets
+					if len(targets) != 1 || targets[0].NetworkAddressDetail != DeviceIP1 || !payload.Schedule.RunNow {
+						t.Errorf("expected a discovery job of %s run now, got %+v", DeviceIP1, payload)
+					}
+					if !strings.Contains(payload.DiscoveryConfigModels[0].ConnectionProfile, "REDFISH") {
+						t.Errorf("expected a redfish connection profile, got %s", payload.DiscoveryConfigModels[0].ConnectionProfile)
+					}
+				},
+				Config: testDeviceOnboardingCreate,
+			},
+			// the device is only removed from the group removed from the configuration
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock((*clients.Client).RemoveGroupMembers).To(func(c *clients.Client, p models.GroupMemberPayload) error {
+						removed = append(removed, p)
+						return removeGroupMembers(c, p)
+					}).Origin(&removeGroupMembers).Build()
+				},
+				Config: testDeviceOnboardingUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					func(s *terraform.State) error {
+						FunctionMocker.UnPatch()
+						deviceID := s.RootModule().Resources[onboardingTfName].Primary.ID
+						groupID := s.RootModule().Resources["ome_static_group.onboarding"].Primary.ID
+						if len(removed) != 1 || fmt.Sprint(removed[0].GroupID) != groupID || fmt.Sprint(removed[0].DeviceIds) != "["+deviceID+"]" {
+							return fmt.Errorf("expected device %s to be removed from group %s, got %+v", deviceID, groupID, removed)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+var testDeviceOnboardingGroup = testProvider + `
+data "ome_groupdevices_info" "static_groups" {
+	device_group_names = ["Static Groups"]
+}
+
+resource "ome_static_group" "onboarding" {
+	name      = "tfacc_onboarding"
+	parent_id = data.ome_groupdevices_info.static_groups.device_groups["Static Groups"].id
+}
+`
+
+var testDeviceOnboardingCreate = testDeviceOnboardingGroup + `
+resource "ome_device_onboarding" "onboard" {
+	management_ip = "` + DeviceIP1 + `"
+	redfish = {
+		username = "` + IdracUsername + `"
+		password = "` + IdracPassword + `"
+	}
+	managed_state = "managed"
+	group_ids     = [ome_static_group.onboarding.id]
+}
+`
+
+var testDeviceOnboardingUpdate = testDeviceOnboardingGroup + `
+resource "ome_device_onboarding" "onboard" {
+	management_ip = "` + DeviceIP1 + `"
+	redfish = {
+		username = "` + IdracUsername + `"
+		password = "` + IdracPassword + `"
+	}
+	managed_state = "managed"
+}
+`
+
+var testDeviceOnboardingNoCredentials = testProvider + `
+resource "ome_device_onboarding" "onboard" {
+	management_ip = "` + DeviceIP1 + `"
+}
+`
+
+var testDeviceOnboardingInvalidState = testProvider + `
+resource "ome_device_onboarding" "onboard" {
+	management_ip = "` + DeviceIP1 + `"
+	redfish = {
+		username = "` + IdracUsername + `"
+		password = "` + IdracPassword + `"
+	}
+	managed_state = "proxied"
+}
+`
@@ -0,0 +1,204 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/helper"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &devicePropertiesResource{}
+	_ resource.ResourceWithConfigure   = &devicePropertiesResource{}
+	_ resource.ResourceWithImportState = &devicePropertiesResource{}
+)
+
+// NewDevicePropertiesResource is a helper function to simplify the provider implementation.
+func NewDevicePropertiesResource() resource.Resource {
+	return &devicePropertiesResource{}
+}
+
+// devicePropertiesResource is the resource implementation.
+type devicePropertiesResource struct {
+	p *omeProvider
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *devicePropertiesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.p = req.ProviderData.(*omeProvider)
+}
+
+// Metadata returns the resource type name.
+func (r *devicePropertiesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "device_properties"
+}
+
+// Schema defines the schema for the resource.
+func (r *devicePropertiesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This terraform resource is used to manage the display name, the asset tag and the physical location of a device on OME." +
+			" Only the properties which are set are managed, their drift is detected on refresh." +
+			" Destroying the resource does not revert the properties of the device." +
+			" We can Create, Update and Import the device properties using this resource.",
+		Version:    1,
+		Attributes: DevicePropertiesSchema(),
+	}
+}
+
+// Create sets the properties of the device and sets the initial Terraform state.
+func (r *devicePropertiesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "resource_device_properties create: started")
+	var plan models.OmeDeviceProperties
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_device_properties Create")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	deviceID, err := helper.GetDeviceIDByServiceTag(omeClient, plan.ServiceTag.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrCreateDeviceProperties, err.Error())
+		return
+	}
+	if err := helper.UpdateDeviceProperties(omeClient, deviceID, plan); err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrCreateDeviceProperties, err.Error())
+		return
+	}
+	props, location, err := helper.ReadDeviceProperties(omeClient, deviceID)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrCreateDeviceProperties, err.Error())
+		return
+	}
+	state := helper.NewDevicePropertiesState(deviceID, props, location, plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "resource_device_properties create: finished")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *devicePropertiesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "resource_device_properties read: started")
+	var state models.OmeDeviceProperties
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_device_properties Read")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	props, location, err := helper.ReadDeviceProperties(omeClient, state.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrReadDeviceProperties, err.Error())
+		return
+	}
+	state = helper.NewDevicePropertiesState(state.ID.ValueInt64(), props, location, state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "resource_device_properties read: finished")
+}
+
+// Update updates the properties of the device.
+func (r *devicePropertiesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "resource_device_properties update: started")
+	var state, plan models.OmeDeviceProperties
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_device_properties Update")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	deviceID := state.ID.ValueInt64()
+	if err := helper.UpdateDeviceProperties(omeClient, deviceID, plan); err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrUpdateDeviceProperties, err.Error())
+		return
+	}
+	props, location, err := helper.ReadDeviceProperties(omeClient, deviceID)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrUpdateDeviceProperties, err.Error())
+		return
+	}
+	state = helper.NewDevicePropertiesState(deviceID, props, location, plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "resource_device_properties update: finished")
+}
+
+// Delete removes the Terraform state, the properties of the device are left untouched.
+func (r *devicePropertiesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "resource_device_properties delete: started")
+	resp.State.RemoveResource(ctx)
+	tflog.Trace(ctx, "resource_device_properties delete: finished")
+}
+
+// ImportState imports the properties of a device by service tag.
+func (r *devicePropertiesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Trace(ctx, "resource_device_properties import: started")
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_device_properties ImportState")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	deviceID, err := helper.GetDeviceIDByServiceTag(omeClient, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrImportDeviceProperties, err.Error())
+		return
+	}
+	props, location, err := helper.ReadDeviceProperties(omeClient, deviceID)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrImportDeviceProperties, err.Error())
+		return
+	}
+	state := helper.NewDevicePropertiesState(deviceID, props, location, helper.ImportStateDeviceProperties(req.ID))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "resource_device_properties import: finished")
+}
@@ -0,0 +1,99 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// DevicePropertiesSchema returns the schema for the device properties resource
+func DevicePropertiesSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the device.",
+			Description:         "ID of the device.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"service_tag": schema.StringAttribute{
+			MarkdownDescription: "Service tag of the device." +
+				" If the value of `service_tag` changes, Terraform will destroy and recreate the resource.",
+			Description: "Service tag of the device." +
+				" If the value of 'service_tag' changes, Terraform will destroy and recreate the resource.",
+			Required: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Display name of the device. When not set, the name of the device is not managed.",
+			Description:         "Display name of the device. When not set, the name of the device is not managed.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"asset_tag": schema.StringAttribute{
+			MarkdownDescription: "Asset tag of the device. When not set, the asset tag of the device is not managed.",
+			Description:         "Asset tag of the device. When not set, the asset tag of the device is not managed.",
+			Optional:            true,
+		},
+		"location": schema.SingleNestedAttribute{
+			MarkdownDescription: "Physical location of the device. When not set, the location of the device is not managed." +
+				" The attributes which are not set in `location` are cleared.",
+			Description: "Physical location of the device. When not set, the location of the device is not managed." +
+				" The attributes which are not set in 'location' are cleared.",
+			Optional: true,
+			Attributes: map[string]schema.Attribute{
+				"datacenter": devicePropertiesLocationSchema("Datacenter of the device."),
+				"room":       devicePropertiesLocationSchema("Room of the device."),
+				"aisle":      devicePropertiesLocationSchema("Aisle of the device."),
+				"rack":       devicePropertiesLocationSchema("Rack of the device."),
+				"rack_slot": schema.Int64Attribute{
+					MarkdownDescription: "Slot of the device in the rack.",
+					Description:         "Slot of the device in the rack.",
+					Optional:            true,
+					Computed:            true,
+					Default:             int64default.StaticInt64(0),
+					Validators: []validator.Int64{
+						int64validator.AtLeast(0),
+					},
+				},
+			},
+		},
+	}
+}
+
+func devicePropertiesLocationSchema(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: description,
+		Description:         description,
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString(""),
+	}
+}
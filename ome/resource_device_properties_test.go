@@ -0,0 +1,188 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/helper"
+	"terraform-provider-ome/models"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestDevicePropertiesResource(t *testing.T) {
+	var propertiesTfName = "ome_device_properties.props"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDevicePropertiesCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(propertiesTfName, "id"),
+					resource.TestCheckResourceAttr(propertiesTfName, "asset_tag", "tfacc-asset"),
+					resource.TestCheckResourceAttr(propertiesTfName, "location.datacenter", "tfacc-dc"),
+					resource.TestCheckResourceAttr(propertiesTfName, "location.rack_slot", "4"),
+				),
+			},
+			{
+				Config: testDevicePropertiesUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(propertiesTfName, "asset_tag", "tfacc-asset-2"),
+					resource.TestCheckResourceAttr(propertiesTfName, "location.room", ""),
+					resource.TestCheckResourceAttr(propertiesTfName, "location.rack_slot", "5"),
+				),
+			},
+			{
+				ResourceName:      propertiesTfName,
+				ImportState:       true,
+				ImportStateId:     DeviceSvcTag1,
+				ImportStateVerify: true,
+				// the name is not managed by the configuration
+				ImportStateVerifyIgnore: []string{"name"},
+			},
+		},
+	})
+}
+
+func TestDevicePropertiesResourceChanges(t *testing.T) {
+	var propertiesTfName = "ome_device_properties.props"
+	var updatedProps []models.DeviceProperties
+	var updatedLocations []models.DeviceLocationSetting
+	var updateProperties func(*clients.Client, models.DeviceProperties) error
+	var updateLocation func(*clients.Client, int64, models.DeviceLocationSetting) error
+	spy := func() {
+		updatedProps, updatedLocations = nil, nil
+		FunctionMocker = Mock((*clients.Client).UpdateDeviceProperties).To(func(c *clients.Client, props models.DeviceProperties) error {
+			updatedProps = append(updatedProps, props)
+			return updateProperties(c, props)
+		}).Origin(&updateProperties).Build()
+		localMocker = Mock((*clients.Client).UpdateDeviceLocation).To(func(c *clients.Client, deviceID int64, location models.DeviceLocationSetting) error {
+			updatedLocations = append(updatedLocations, location)
+			return updateLocation(c, deviceID, location)
+		}).Origin(&updateLocation).Build()
+	}
+	unPatch := func() {
+		FunctionMocker.UnPatch()
+		localMocker.UnPatch()
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testDevicePropertiesInvalidServiceTag,
+				ExpectError: regexp.MustCompile(`.*error setting device properties.*`),
+			},
+			{
+				PreConfig: spy,
+				Config:    testDevicePropertiesCreate,
+				Check: resource.ComposeTestCheckFunc(
+					func(s *terraform.State) error {
+						unPatch()
+						// the name is not in the configuration, it must be sent back unchanged
+						name := s.RootModule().Resources[propertiesTfName].Primary.Attributes["name"]
+						if len(updatedProps) > 1 || (len(updatedProps) == 1 && (updatedProps[0].AssetTag != "tfacc-asset" || (name != "" && updatedProps[0].DeviceName != name))) {
+							return fmt.Errorf("unexpected properties update %+v", updatedProps)
+						}
+						if len(updatedLocations) > 1 || (len(updatedLocations) == 1 && (updatedLocations[0].Room != "tfacc-room" || updatedLocations[0].RackSlot != 4)) {
+							return fmt.Errorf("unexpected location update %+v", updatedLocations)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// only the location changes, the properties of the device are not written
+				PreConfig: spy,
+				Config:    testDevicePropertiesLocationOnly,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(propertiesTfName, "location.rack_slot", "6"),
+					func(_ *terraform.State) error {
+						unPatch()
+						if len(updatedProps) != 0 {
+							return fmt.Errorf("expected no properties update, got %+v", updatedProps)
+						}
+						if len(updatedLocations) != 1 || updatedLocations[0].RackSlot != 6 || updatedLocations[0].Room != "" || updatedLocations[0].DataCenter != "tfacc-dc" {
+							return fmt.Errorf("expected one location update to rack slot 6 without a room, got %+v", updatedLocations)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock(helper.ReadDeviceProperties).Return(models.DeviceProperties{}, models.DeviceLocationSetting{}, fmt.Errorf("mock error")).Build()
+				},
+				Config:      testDevicePropertiesUpdate,
+				ExpectError: regexp.MustCompile(`.*error reading device properties.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.UnPatch()
+				},
+				Config: testDevicePropertiesUpdate,
+			},
+		},
+	})
+}
+
+var testDevicePropertiesCreate = testProvider + `
+resource "ome_device_properties" "props" {
+	service_tag = "` + DeviceSvcTag1 + `"
+	asset_tag   = "tfacc-asset"
+	location = {
+		datacenter = "tfacc-dc"
+		room       = "tfacc-room"
+		rack       = "tfacc-rack"
+		rack_slot  = 4
+	}
+}
+`
+
+var testDevicePropertiesUpdate = testProvider + `
+resource "ome_device_properties" "props" {
+	service_tag = "` + DeviceSvcTag1 + `"
+	asset_tag   = "tfacc-asset-2"
+	location = {
+		datacenter = "tfacc-dc"
+		rack       = "tfacc-rack"
+		rack_slot  = 5
+	}
+}
+`
+
+var testDevicePropertiesLocationOnly = testProvider + `
+resource "ome_device_properties" "props" {
+	service_tag = "` + DeviceSvcTag1 + `"
+	asset_tag   = "tfacc-asset"
+	location = {
+		datacenter = "tfacc-dc"
+		rack       = "tfacc-rack"
+		rack_slot  = 6
+	}
+}
+`
+
+var testDevicePropertiesInvalidServiceTag = testProvider + `
+resource "ome_device_properties" "props" {
+	service_tag = "invalid"
+	asset_tag   = "tfacc-asset"
+}
+`
@@ -20,6 +20,7 @@ import (
 	"terraform-provider-ome/clients"
 	"terraform-provider-ome/models"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -83,6 +84,28 @@ func (r resourceDevices) Schema(_ context.Context, _ resource.SchemaRequest, res
 					Attributes: r.singleDeviceSchema(),
 				},
 			},
+			"management_ip_filter": schema.ListAttribute{
+				MarkdownDescription: "Management IPs of the devices to be managed by this resource when `devices` is not configured." +
+					" The devices that no longer match the filter, for example after a change of the filter or of the DNS records" +
+					" of a host name, are no longer managed by this resource and are kept on OME." +
+					" Supported expressions are IPv4 and IPv6 addresses, CIDRs, IP ranges, IPv4 wildcards like `192.35.0.*`" +
+					" and host names, which are resolved to their IPs with DNS.",
+				Description: "Management IPs of the devices to be managed by this resource when 'devices' is not configured." +
+					" The devices that no longer match the filter, for example after a change of the filter or of the DNS records" +
+					" of a host name, are no longer managed by this resource and are kept on OME." +
+					" Supported expressions are IPv4 and IPv6 addresses, CIDRs, IP ranges, IPv4 wildcards like '192.35.0.*'" +
+					" and host names, which are resolved to their IPs with DNS.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.ConflictsWith(path.MatchRoot("devices")),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(
+						stringvalidator.LengthAtLeast(1),
+						networkExpressionValidator{allowWildcard: true},
+					),
+				},
+			},
 		},
 	}
 }
@@ -135,16 +158,17 @@ func (r resourceDevices) Create(ctx context.Context, req resource.CreateRequest,
 
 	tflog.Info(ctx, "resource_devices getting current infrastructure state")
 
-	state, dgs := r.getState(ctx, plan.Devices)
+	state, dgs := r.getState(ctx, plan.Devices, plan.ManagementIPFilter)
 	resp.Diagnostics.Append(dgs...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	state.ManagementIPFilter = plan.ManagementIPFilter
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
 
-func (r resourceDevices) getState(ctx context.Context, tfDevices types.List) (
+func (r resourceDevices) getState(ctx context.Context, tfDevices types.List, mgmtIPFilter types.List) (
 	models.DevicesResModel, diag.Diagnostics) {
 	var (
 		state models.DevicesResModel
@@ -165,6 +189,11 @@ func (r resourceDevices) getState(ctx context.Context, tfDevices types.List) (
 	if pdevs == nil {
 		devM, err := r.c.GetAllDevices(nil)
 		devs = devM.Value
+		if err == nil && !mgmtIPFilter.IsNull() {
+			networks := make([]string, 0)
+			_ = mgmtIPFilter.ElementsAs(ctx, &networks, false)
+			devs, err = clients.FilterDeviceByIps(devs, networks)
+		}
 		if err != nil {
 			dgs.AddError(
 				"Error getting devices.",
@@ -225,11 +254,13 @@ func (r resourceDevices) Read(ctx context.Context, req resource.ReadRequest, res
 
 	tflog.Info(ctx, "resource_devices getting current infrastructure state")
 
-	state, dgs := r.getState(ctx, state.Devices)
+	mgmtIPFilter := state.ManagementIPFilter
+	state, dgs := r.getState(ctx, state.Devices, mgmtIPFilter)
 	resp.Diagnostics.Append(dgs...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	state.ManagementIPFilter = mgmtIPFilter
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
@@ -263,11 +294,12 @@ func (r resourceDevices) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	state, dgs := r.getState(ctx, plan.Devices)
+	state, dgs := r.getState(ctx, plan.Devices, plan.ManagementIPFilter)
 	resp.Diagnostics.Append(dgs...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	state.ManagementIPFilter = plan.ManagementIPFilter
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
@@ -280,6 +312,7 @@ func (r resourceDevices) getDevIDsToRmv(ctx context.Context, state, plan models.
 	)
 
 	if plan.Devices.IsUnknown() {
+		// the devices selected by management_ip_filter that no longer match it are only dropped from the state
 		return idsToRmv, dgs
 	}
 	pdevs := make([]models.DeviceItemModel, 0)
@@ -345,7 +378,7 @@ func (r resourceDevices) ImportState(ctx context.Context, req resource.ImportSta
 	r.c = omeClient
 	defer omeClient.RemoveSession()
 
-	state, dgs := r.getState(ctx, planDevs)
+	state, dgs := r.getState(ctx, planDevs, types.ListNull(types.StringType))
 	resp.Diagnostics.Append(dgs...)
 	if resp.Diagnostics.HasError() {
 		return
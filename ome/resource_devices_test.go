@@ -20,6 +20,7 @@ import (
 	"regexp"
 	"testing"
 
+	. "github.com/bytedance/mockey"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
@@ -241,3 +242,69 @@ func TestAccDevicesResUnk(t *testing.T) {
 	})
 
 }
+
+func TestAccDevicesResMgmtIPFilter(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+	testAccCreateDevicesResMgmtIP := testProvider + `
+	resource "ome_devices" "code_4" {
+		management_ip_filter = ["` + DeviceIP1 + `"]
+	}
+	`
+	testAccCreateDevicesResMgmtIPs := testProvider + `
+	resource "ome_devices" "code_4" {
+		management_ip_filter = ["` + DeviceIP1 + `", "` + DeviceIP2 + `"]
+	}
+	`
+	testAccCreateDevicesResInvMgmtIP := testProvider + `
+	resource "ome_devices" "code_4" {
+		management_ip_filter = ["192.35.*.1"]
+	}
+	`
+	var removedIDs []int64
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCreateDevicesResInvMgmtIP,
+				ExpectError: regexp.MustCompile(".*" + clients.ErrInvalidNetworkExpression + ".*"),
+			},
+			{
+				Config: testAccCreateDevicesResMgmtIP,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ome_devices.code_4", "devices.#", "1"),
+					resource.TestCheckResourceAttr("ome_devices.code_4", "devices.0.id", DeviceID1),
+				),
+			},
+			{
+				Config: testAccCreateDevicesResMgmtIPs,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ome_devices.code_4", "devices.#", "2"),
+				),
+			},
+			// the device that no longer matches the filter is dropped from the state and kept on OME
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock((*clients.Client).RemoveDevices).To(func(_ *clients.Client, ids []int64) error {
+						removedIDs = ids
+						return nil
+					}).Build()
+				},
+				Config: testAccCreateDevicesResMgmtIP,
+				Check: resource.ComposeTestCheckFunc(
+					func(*terraform.State) error {
+						FunctionMocker.UnPatch()
+						if len(removedIDs) != 0 {
+							return fmt.Errorf("expected no device to be removed, removed %v", removedIDs)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr("ome_devices.code_4", "devices.#", "1"),
+					resource.TestCheckResourceAttr("ome_devices.code_4", "devices.0.id", DeviceID1),
+				),
+			},
+		},
+	})
+}
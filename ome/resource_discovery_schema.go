@@ -218,6 +218,9 @@ func DiscoveryConfigTargetsSchema() map[string]schema.Attribute {
 			ElementType: types.StringType,
 			Validators: []validator.List{
 				listvalidator.SizeAtLeast(1),
+				listvalidator.ValueStringsAre(
+					networkExpressionValidator{},
+				),
 			},
 		},
 
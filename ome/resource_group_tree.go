@@ -0,0 +1,220 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/helper"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &groupTreeResource{}
+	_ resource.ResourceWithConfigure      = &groupTreeResource{}
+	_ resource.ResourceWithValidateConfig = &groupTreeResource{}
+)
+
+// NewGroupTreeResource is a helper function to simplify the provider implementation.
+func NewGroupTreeResource() resource.Resource {
+	return &groupTreeResource{}
+}
+
+// groupTreeResource is the resource implementation.
+type groupTreeResource struct {
+	p *omeProvider
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *groupTreeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.p = req.ProviderData.(*omeProvider)
+}
+
+// Metadata returns the resource type name.
+func (r *groupTreeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "group_tree"
+}
+
+// Schema defines the schema for the resource.
+func (r *groupTreeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This terraform resource is used to manage a tree of Static Device Groups on OME from a single definition." +
+			" Each group references its parent by name, so a datacenter, room and rack layout can be declared in one place." +
+			" We can Create, Update and Delete the groups of the tree using this resource.",
+		Version:    1,
+		Attributes: GroupTreeSchema(),
+	}
+}
+
+// ValidateConfig validates that the parents of the groups are in the tree and do not form a cycle.
+func (r *groupTreeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var groups types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("groups"), &groups)...)
+	if resp.Diagnostics.HasError() || groups.IsNull() || groups.IsUnknown() {
+		return
+	}
+	nodes := map[string]models.OmeGroupTreeNode{}
+	resp.Diagnostics.Append(groups.ElementsAs(ctx, &nodes, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if _, err := helper.SortGroupTree(nodes); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("groups"),
+			"Attribute Error",
+			err.Error(),
+		)
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *groupTreeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "resource_group_tree create: started")
+	var plan models.OmeGroupTree
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_group_tree Create")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	tree, err := helper.UpdateGroupTree(ctx, omeClient, plan, models.OmeGroupTree{})
+	if err != nil {
+		// remove the groups already created, so that a new apply starts from scratch
+		if delErr := helper.DeleteGroupTree(omeClient, tree); delErr != nil {
+			resp.Diagnostics.AddWarning(clients.ErrGnrDeleteGroupTree, delErr.Error())
+		}
+		resp.Diagnostics.AddError(clients.ErrGnrCreateGroupTree, err.Error())
+		return
+	}
+
+	state, err := helper.ReadGroupTree(omeClient, tree)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrCreateGroupTree, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "resource_group_tree create: finished")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *groupTreeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "resource_group_tree read: started")
+	var state models.OmeGroupTree
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_group_tree Read")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	state, err := helper.ReadGroupTree(omeClient, state)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrReadGroupTree, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "resource_group_tree read: finished")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *groupTreeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "resource_group_tree update: started")
+	var state, plan models.OmeGroupTree
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_group_tree Update")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	tree, err := helper.UpdateGroupTree(ctx, omeClient, plan, state)
+	if err != nil {
+		// keep track of the groups already created or not deleted yet
+		resp.Diagnostics.Append(resp.State.Set(ctx, &tree)...)
+		resp.Diagnostics.AddError(clients.ErrGnrUpdateGroupTree, err.Error())
+		return
+	}
+
+	state, err = helper.ReadGroupTree(omeClient, tree)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrUpdateGroupTree, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "resource_group_tree update: finished")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *groupTreeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "resource_group_tree delete: started")
+	var state models.OmeGroupTree
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_group_tree Delete")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	if err := helper.DeleteGroupTree(omeClient, state); err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrDeleteGroupTree, err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Trace(ctx, "resource_group_tree delete: finished")
+}
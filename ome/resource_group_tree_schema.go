@@ -0,0 +1,102 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// GroupTreeSchema returns the schema for the group tree resource
+func GroupTreeSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the group tree resource.",
+			Description:         "ID of the group tree resource.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"parent_id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the group under which the tree is created, usually the `Static Groups` group." +
+				" If the value of `parent_id` changes, Terraform will destroy and recreate the resource.",
+			Description: "ID of the group under which the tree is created, usually the 'Static Groups' group." +
+				" If the value of 'parent_id' changes, Terraform will destroy and recreate the resource.",
+			Required: true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"groups": schema.MapNestedAttribute{
+			MarkdownDescription: "Static groups of the tree keyed by their name." +
+				" The groups are created parents first and deleted sub groups first." +
+				" Renaming a group deletes it and creates a new group.",
+			Description: "Static groups of the tree keyed by their name." +
+				" The groups are created parents first and deleted sub groups first." +
+				" Renaming a group deletes it and creates a new group.",
+			Required: true,
+			Validators: []validator.Map{
+				mapvalidator.SizeAtLeast(1),
+				mapvalidator.KeysAre(stringvalidator.LengthAtLeast(1)),
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.Int64Attribute{
+						MarkdownDescription: "ID of the group.",
+						Description:         "ID of the group.",
+						Computed:            true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+					"parent": schema.StringAttribute{
+						MarkdownDescription: "Name of the parent group in `groups`. The group is created under `parent_id` when it is empty.",
+						Description:         "Name of the parent group in 'groups'. The group is created under 'parent_id' when it is empty.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString(""),
+					},
+					"description": schema.StringAttribute{
+						MarkdownDescription: "Description of the group.",
+						Description:         "Description of the group.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString(""),
+					},
+					"device_ids": schema.SetAttribute{
+						MarkdownDescription: "IDs of the devices which are direct members of the group." +
+							" The members of the group are not managed when it is not set.",
+						Description: "IDs of the devices which are direct members of the group." +
+							" The members of the group are not managed when it is not set.",
+						Optional:    true,
+						Computed:    true,
+						ElementType: types.Int64Type,
+						PlanModifiers: []planmodifier.Set{
+							setplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,314 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestGroupTreeResource(t *testing.T) {
+	var groupTreeTfName = "ome_group_tree.tree"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testGroupTreeCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(groupTreeTfName, "groups.%", "3"),
+					resource.TestCheckResourceAttr(groupTreeTfName, "groups.tfacc_room.parent", "tfacc_dc"),
+					resource.TestCheckResourceAttr(groupTreeTfName, "groups.tfacc_rack1.parent", "tfacc_room"),
+					resource.TestCheckResourceAttr(groupTreeTfName, "groups.tfacc_rack1.device_ids.#", "1"),
+					resource.TestCheckResourceAttrSet(groupTreeTfName, "groups.tfacc_dc.id"),
+				),
+			},
+			// add a rack, move the first rack under the datacenter and remove its devices
+			{
+				Config: testGroupTreeUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(groupTreeTfName, "groups.%", "4"),
+					resource.TestCheckResourceAttr(groupTreeTfName, "groups.tfacc_rack1.parent", "tfacc_dc"),
+					resource.TestCheckResourceAttr(groupTreeTfName, "groups.tfacc_rack1.device_ids.#", "0"),
+					resource.TestCheckResourceAttr(groupTreeTfName, "groups.tfacc_rack2.description", "second rack"),
+				),
+			},
+			// remove the racks
+			{
+				Config: testGroupTreeShrink,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(groupTreeTfName, "groups.%", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestGroupTreeResourceValidationError(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testGroupTreeUnknownParent,
+				ExpectError: regexp.MustCompile(`.*parent tfacc_unknown of group tfacc_room is not defined in groups.*`),
+			},
+			{
+				Config:      testGroupTreeCycle,
+				ExpectError: regexp.MustCompile(`.*cycle detected in the parents of the groups.*`),
+			},
+			{
+				Config:      testGroupTreeLongCycle,
+				ExpectError: regexp.MustCompile(`.*cycle detected in the parents of the groups: tfacc_a > tfacc_c > tfacc_b\s*> tfacc_a.*`),
+			},
+			{
+				Config:      testGroupTreeSelfParent,
+				ExpectError: regexp.MustCompile(`.*cycle detected in the parents of the groups: tfacc_room > tfacc_room.*`),
+			},
+		},
+	})
+}
+
+func TestGroupTreeResourceOrder(t *testing.T) {
+	var (
+		groupTreeTfName = "ome_group_tree.tree"
+		createGroup     func(*clients.Client, models.Group) (int64, error)
+		deleteGroup     func(*clients.Client, int64) error
+		created         []models.Group
+		deleted         []int64
+		groups          map[string]groupTreeTestNode
+		checked         int
+	)
+	// saveGroups keeps the ids and parents of the groups to check the deletion order once the tree is destroyed
+	saveGroups := func(s *terraform.State) error {
+		groups = map[string]groupTreeTestNode{}
+		attrs := s.RootModule().Resources[groupTreeTfName].Primary.Attributes
+		for key, val := range attrs {
+			name, ok := strings.CutSuffix(strings.TrimPrefix(key, "groups."), ".id")
+			if !ok || strings.Contains(name, ".") {
+				continue
+			}
+			id, _ := strconv.ParseInt(val, 10, 64)
+			groups[name] = groupTreeTestNode{id: id, parent: attrs["groups."+name+".parent"]}
+		}
+		return nil
+	}
+	checkCreated := func(names ...string) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			if err := saveGroups(s); err != nil {
+				return err
+			}
+			if len(created) != len(names) {
+				return fmt.Errorf("expected the groups %v to be created, got %+v", names, created)
+			}
+			for i, name := range names {
+				if created[i].Name != name {
+					return fmt.Errorf("expected the groups %v to be created in this order, got %+v", names, created)
+				}
+				// the groups created by the previous steps may have been moved since
+				parentID := created[i].ParentID
+				if parent := groups[name].parent; i >= checked && parent != "" && parentID != groups[parent].id {
+					return fmt.Errorf("expected group %s to be created under %s, got parent id %d", name, parent, parentID)
+				}
+			}
+			checked = len(names)
+			return nil
+		}
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		// the sub groups are deleted before their parents
+		CheckDestroy: func(*terraform.State) error {
+			position := map[int64]int{}
+			for i, id := range deleted {
+				position[id] = i
+			}
+			for name, group := range groups {
+				i, ok := position[group.id]
+				if !ok {
+					return fmt.Errorf("expected group %s to be deleted, deleted %v", name, deleted)
+				}
+				if group.parent != "" && position[groups[group.parent].id] < i {
+					return fmt.Errorf("expected group %s to be deleted before its parent %s, deleted %v", name, group.parent, deleted)
+				}
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// the parents are created before their sub groups
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock((*clients.Client).CreateGroup).To(func(c *clients.Client, group models.Group) (int64, error) {
+						created = append(created, group)
+						return createGroup(c, group)
+					}).Origin(&createGroup).Build()
+					localMocker = Mock((*clients.Client).DeleteGroup).To(func(c *clients.Client, id int64) error {
+						deleted = append(deleted, id)
+						return deleteGroup(c, id)
+					}).Origin(&deleteGroup).Build()
+				},
+				Config: testGroupTreeCreate,
+				Check:  checkCreated("tfacc_dc", "tfacc_room", "tfacc_rack1"),
+			},
+			// only the new group is created, the moved group keeps its id
+			{
+				Config: testGroupTreeUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					checkCreated("tfacc_dc", "tfacc_room", "tfacc_rack1", "tfacc_rack2"),
+					func(*terraform.State) error {
+						if len(deleted) != 0 {
+							return fmt.Errorf("expected no group to be deleted, deleted %v", deleted)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+// groupTreeTestNode is a group of the tree as saved in the state
+type groupTreeTestNode struct {
+	id     int64
+	parent string
+}
+
+var testGroupTreeParent = testProvider + `
+data "ome_groupdevices_info" "static_groups" {
+	device_group_names = ["Static Groups"]
+}
+
+data "ome_device" "devs" {
+	filters = {
+		device_service_tags = ["` + DeviceSvcTag1 + `"]
+	}
+}
+`
+
+var testGroupTreeCreate = testGroupTreeParent + `
+resource "ome_group_tree" "tree" {
+	parent_id = data.ome_groupdevices_info.static_groups.device_groups["Static Groups"].id
+	groups = {
+		tfacc_dc = {
+			description = "datacenter"
+		}
+		tfacc_room = {
+			parent = "tfacc_dc"
+		}
+		tfacc_rack1 = {
+			parent     = "tfacc_room"
+			device_ids = [data.ome_device.devs.devices[0].id]
+		}
+	}
+}
+`
+
+var testGroupTreeUpdate = testGroupTreeParent + `
+resource "ome_group_tree" "tree" {
+	parent_id = data.ome_groupdevices_info.static_groups.device_groups["Static Groups"].id
+	groups = {
+		tfacc_dc = {
+			description = "datacenter"
+		}
+		tfacc_room = {
+			parent = "tfacc_dc"
+		}
+		tfacc_rack1 = {
+			parent     = "tfacc_dc"
+			device_ids = []
+		}
+		tfacc_rack2 = {
+			parent      = "tfacc_room"
+			description = "second rack"
+		}
+	}
+}
+`
+
+var testGroupTreeShrink = testGroupTreeParent + `
+resource "ome_group_tree" "tree" {
+	parent_id = data.ome_groupdevices_info.static_groups.device_groups["Static Groups"].id
+	groups = {
+		tfacc_dc = {
+			description = "datacenter"
+		}
+		tfacc_room = {
+			parent = "tfacc_dc"
+		}
+	}
+}
+`
+
+var testGroupTreeUnknownParent = testProvider + `
+resource "ome_group_tree" "tree" {
+	parent_id = 1
+	groups = {
+		tfacc_room = {
+			parent = "tfacc_unknown"
+		}
+	}
+}
+`
+
+var testGroupTreeCycle = testProvider + `
+resource "ome_group_tree" "tree" {
+	parent_id = 1
+	groups = {
+		tfacc_room = {
+			parent = "tfacc_rack"
+		}
+		tfacc_rack = {
+			parent = "tfacc_room"
+		}
+	}
+}
+`
+
+var testGroupTreeLongCycle = testProvider + `
+resource "ome_group_tree" "tree" {
+	parent_id = 1
+	groups = {
+		tfacc_a = {
+			parent = "tfacc_c"
+		}
+		tfacc_b = {
+			parent = "tfacc_a"
+		}
+		tfacc_c = {
+			parent = "tfacc_b"
+		}
+	}
+}
+`
+
+var testGroupTreeSelfParent = testProvider + `
+resource "ome_group_tree" "tree" {
+	parent_id = 1
+	groups = {
+		tfacc_room = {
+			parent = "tfacc_room"
+		}
+	}
+}
+`
@@ -0,0 +1,259 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/helper"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &identityPoolResource{}
+	_ resource.ResourceWithConfigure      = &identityPoolResource{}
+	_ resource.ResourceWithImportState    = &identityPoolResource{}
+	_ resource.ResourceWithValidateConfig = &identityPoolResource{}
+)
+
+// NewIdentityPoolResource is a helper function to simplify the provider implementation.
+func NewIdentityPoolResource() resource.Resource {
+	return &identityPoolResource{}
+}
+
+// identityPoolResource is the resource implementation.
+type identityPoolResource struct {
+	p *omeProvider
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *identityPoolResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.p = req.ProviderData.(*omeProvider)
+}
+
+// Metadata returns the resource type name.
+func (r *identityPoolResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "identity_pool"
+}
+
+// Schema defines the schema for the resource.
+func (r *identityPoolResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This terraform resource is used to manage the identity pools of virtual MAC addresses, IQNs and world wide names on OME." +
+			" The identities of a pool are assigned to the servers deployed with a template attached to the pool." +
+			" We can Create, Update and Delete OME identity pools using this resource. We can also 'Import' an existing 'identity pool' from OME.",
+		Version:    1,
+		Attributes: IdentityPoolSchema(),
+	}
+}
+
+// ValidateConfig validates the identity pool configuration.
+func (r *identityPoolResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data models.OmeIdentityPool
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := helper.ValidateIdentityPool(data); err != nil {
+		resp.Diagnostics.AddError(
+			"Attribute Error",
+			err.Error(),
+		)
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *identityPoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "resource_identity_pool create: started")
+	var plan models.OmeIdentityPool
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_identity_pool Create")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	pool, err := helper.CreateIdentityPool(omeClient, helper.MakeIdentityPoolPayload(plan, 0))
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrCreateIdentityPool, err.Error())
+		return
+	}
+
+	state, d := r.newState(omeClient, pool, plan, clients.ErrGnrCreateIdentityPool)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "resource_identity_pool create: finished")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *identityPoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "resource_identity_pool read: started")
+	var state models.OmeIdentityPool
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_identity_pool Read")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	pool, err := helper.GetIdentityPool(omeClient, state.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrReadIdentityPool, err.Error())
+		return
+	}
+
+	state, d = r.newState(omeClient, pool, state, clients.ErrGnrReadIdentityPool)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "resource_identity_pool read: finished")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *identityPoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "resource_identity_pool update: started")
+	var state, plan models.OmeIdentityPool
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_identity_pool Update")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	pool, err := helper.UpdateIdentityPool(omeClient, helper.MakeIdentityPoolPayload(plan, state.ID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrUpdateIdentityPool, err.Error())
+		return
+	}
+
+	state, d = r.newState(omeClient, pool, plan, clients.ErrGnrUpdateIdentityPool)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "resource_identity_pool update: finished")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *identityPoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "resource_identity_pool delete: started")
+	var state models.OmeIdentityPool
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_identity_pool Delete")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	if err := helper.DeleteIdentityPool(omeClient, state.ID.ValueInt64()); err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrDeleteIdentityPool, err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Trace(ctx, "resource_identity_pool delete: finished")
+}
+
+// ImportState imports an existing identity pool by id.
+func (r *identityPoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Trace(ctx, "resource_identity_pool import: started")
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrImportIdentityPool, fmt.Sprintf("invalid identity pool id %s: %s", req.ID, err.Error()))
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_identity_pool ImportState")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	pool, err := helper.GetIdentityPool(omeClient, id)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrImportIdentityPool, err.Error())
+		return
+	}
+
+	state, d := r.newState(omeClient, pool, models.OmeIdentityPool{}, clients.ErrGnrImportIdentityPool)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "resource_identity_pool import: finished")
+}
+
+// newState reads the usage of the identity pool and maps the pool into the terraform state
+func (r *identityPoolResource) newState(client *clients.Client, pool models.IdentityPool, prior models.OmeIdentityPool, summary string) (models.OmeIdentityPool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	usage, err := helper.GetIdentityPoolUsage(client, pool.ID)
+	if err != nil {
+		diags.AddError(summary, err.Error())
+		return prior, diags
+	}
+	return helper.SetStateIdentityPool(pool, usage, prior)
+}
@@ -0,0 +1,220 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// macAddressRegex matches the 48 bits mac addresses separated with colons or hyphens
+var macAddressRegex = regexp.MustCompile(`^([0-9A-Fa-f]{2}[:-]){5}[0-9A-Fa-f]{2}$`)
+
+// IdentityPoolSchema returns the schema for the identity pool resource
+func IdentityPoolSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the identity pool.",
+			Description:         "ID of the identity pool.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the identity pool.",
+			Description:         "Name of the identity pool.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Description of the identity pool.",
+			Description:         "Description of the identity pool.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(""),
+		},
+		"ethernet_settings": identityPoolMacSettingsSchema("Ethernet"),
+		"iscsi_settings": schema.SingleNestedAttribute{
+			MarkdownDescription: "iSCSI MAC addresses, initiator and initiator IP addresses of the identity pool." +
+				" If `iscsi_settings` is removed, Terraform will destroy and recreate the resource.",
+			Description: "iSCSI MAC addresses, initiator and initiator IP addresses of the identity pool." +
+				" If 'iscsi_settings' is removed, Terraform will destroy and recreate the resource.",
+			Optional:      true,
+			PlanModifiers: identityPoolSettingsPlanModifiers(),
+			Attributes: map[string]schema.Attribute{
+				"starting_mac_address": identityPoolMacAddressSchema(),
+				"identity_count":       identityPoolCountSchema(),
+				"iqn_prefix": schema.StringAttribute{
+					MarkdownDescription: "Prefix of the IQNs of the iSCSI initiators, for example `iqn.2009-05.com.example`.",
+					Description:         "Prefix of the IQNs of the iSCSI initiators, for example 'iqn.2009-05.com.example'.",
+					Optional:            true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+				"initiator_ip_pool": schema.SingleNestedAttribute{
+					MarkdownDescription: "IPv4 addresses of the iSCSI initiators.",
+					Description:         "IPv4 addresses of the iSCSI initiators.",
+					Optional:            true,
+					Attributes: map[string]schema.Attribute{
+						"ip_range": schema.StringAttribute{
+							MarkdownDescription: "Range of the IP addresses, for example `10.33.0.1-10.33.0.255` or `10.33.0.0/24`.",
+							Description:         "Range of the IP addresses, for example '10.33.0.1-10.33.0.255' or '10.33.0.0/24'.",
+							Required:            true,
+						},
+						"subnet_mask": schema.StringAttribute{
+							MarkdownDescription: "Subnet mask of the IP addresses.",
+							Description:         "Subnet mask of the IP addresses.",
+							Required:            true,
+						},
+						"gateway": schema.StringAttribute{
+							MarkdownDescription: "Gateway of the IP addresses.",
+							Description:         "Gateway of the IP addresses.",
+							Optional:            true,
+						},
+						"primary_dns_server": schema.StringAttribute{
+							MarkdownDescription: "Primary DNS server of the IP addresses.",
+							Description:         "Primary DNS server of the IP addresses.",
+							Optional:            true,
+						},
+						"secondary_dns_server": schema.StringAttribute{
+							MarkdownDescription: "Secondary DNS server of the IP addresses.",
+							Description:         "Secondary DNS server of the IP addresses.",
+							Optional:            true,
+						},
+					},
+				},
+			},
+		},
+		"fcoe_settings": identityPoolMacSettingsSchema("FCoE"),
+		"fc_settings": schema.SingleNestedAttribute{
+			MarkdownDescription: "Fibre Channel world wide names of the identity pool." +
+				" The WWNNs and the WWPNs are the starting address prefixed with `20:00` and `20:01`." +
+				" If `fc_settings` is removed, Terraform will destroy and recreate the resource.",
+			Description: "Fibre Channel world wide names of the identity pool." +
+				" The WWNNs and the WWPNs are the starting address prefixed with '20:00' and '20:01'." +
+				" If 'fc_settings' is removed, Terraform will destroy and recreate the resource.",
+			Optional:      true,
+			PlanModifiers: identityPoolSettingsPlanModifiers(),
+			Attributes: map[string]schema.Attribute{
+				"starting_address": schema.StringAttribute{
+					MarkdownDescription: "Starting address of the world wide names, without the `20:00` or `20:01` prefix, for example `AA:BB:CC:DD:EE:00`.",
+					Description:         "Starting address of the world wide names, without the '20:00' or '20:01' prefix, for example 'AA:BB:CC:DD:EE:00'.",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.RegexMatches(macAddressRegex, "must be a 48 bits address like AA:BB:CC:DD:EE:00"),
+					},
+				},
+				"identity_count": identityPoolCountSchema(),
+			},
+		},
+		"usage": schema.ListNestedAttribute{
+			MarkdownDescription: "Usage of the identities of the identity pool by identity type.",
+			Description:         "Usage of the identities of the identity pool by identity type.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"identity_type": schema.StringAttribute{
+						MarkdownDescription: "Identity type, one of `ethernet`, `iscsi`, `fcoe` and `fc`.",
+						Description:         "Identity type, one of 'ethernet', 'iscsi', 'fcoe' and 'fc'.",
+						Computed:            true,
+					},
+					"total": schema.Int64Attribute{
+						MarkdownDescription: "Number of identities of the identity type.",
+						Description:         "Number of identities of the identity type.",
+						Computed:            true,
+					},
+					"assigned": schema.Int64Attribute{
+						MarkdownDescription: "Number of identities assigned to deployed servers.",
+						Description:         "Number of identities assigned to deployed servers.",
+						Computed:            true,
+					},
+					"reserved": schema.Int64Attribute{
+						MarkdownDescription: "Number of identities reserved for the servers being deployed.",
+						Description:         "Number of identities reserved for the servers being deployed.",
+						Computed:            true,
+					},
+					"free": schema.Int64Attribute{
+						MarkdownDescription: "Number of identities neither assigned nor reserved.",
+						Description:         "Number of identities neither assigned nor reserved.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func identityPoolMacSettingsSchema(identityType string) schema.SingleNestedAttribute {
+	name := map[string]string{"Ethernet": "ethernet_settings", "FCoE": "fcoe_settings"}[identityType]
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: identityType + " MAC addresses of the identity pool." +
+			" If `" + name + "` is removed, Terraform will destroy and recreate the resource.",
+		Description: identityType + " MAC addresses of the identity pool." +
+			" If '" + name + "' is removed, Terraform will destroy and recreate the resource.",
+		Optional:      true,
+		PlanModifiers: identityPoolSettingsPlanModifiers(),
+		Attributes: map[string]schema.Attribute{
+			"starting_mac_address": identityPoolMacAddressSchema(),
+			"identity_count":       identityPoolCountSchema(),
+		},
+	}
+}
+
+func identityPoolMacAddressSchema() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "Starting MAC address, for example `AA:BB:CC:DD:EE:00`.",
+		Description:         "Starting MAC address, for example 'AA:BB:CC:DD:EE:00'.",
+		Required:            true,
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(macAddressRegex, "must be a MAC address like AA:BB:CC:DD:EE:00"),
+		},
+	}
+}
+
+func identityPoolCountSchema() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		MarkdownDescription: "Number of identities, from `1` to `50000`.",
+		Description:         "Number of identities, from '1' to '50000'.",
+		Required:            true,
+		Validators: []validator.Int64{
+			int64validator.Between(1, 50000),
+		},
+	}
+}
+
+// identityPoolSettingsPlanModifiers recreates the identity pool when settings are removed, the appliance keeps the removed settings
+func identityPoolSettingsPlanModifiers() []planmodifier.Object {
+	return []planmodifier.Object{
+		objectplanmodifier.RequiresReplaceIf(
+			func(_ context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+				resp.RequiresReplace = req.ConfigValue.IsNull() && !req.StateValue.IsNull()
+			},
+			"The identity pool is recreated when the settings are removed.",
+			"The identity pool is recreated when the settings are removed.",
+		),
+	}
+}
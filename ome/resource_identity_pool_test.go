@@ -0,0 +1,367 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestIdentityPoolResource(t *testing.T) {
+	var identityPoolTfName = "ome_identity_pool.pool"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testIdentityPoolCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(identityPoolTfName, "name", "tfacc_identity_pool"),
+					resource.TestCheckResourceAttr(identityPoolTfName, "ethernet_settings.starting_mac_address", "aa:bb:cc:dd:ee:00"),
+					resource.TestCheckResourceAttr(identityPoolTfName, "ethernet_settings.identity_count", "50"),
+					resource.TestCheckResourceAttr(identityPoolTfName, "usage.#", "1"),
+					resource.TestCheckResourceAttr(identityPoolTfName, "usage.0.identity_type", "ethernet"),
+					resource.TestCheckResourceAttr(identityPoolTfName, "usage.0.total", "50"),
+				),
+			},
+			{
+				Config: testIdentityPoolUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(identityPoolTfName, "description", "tfacc identity pool"),
+					resource.TestCheckResourceAttr(identityPoolTfName, "ethernet_settings.identity_count", "100"),
+					resource.TestCheckResourceAttr(identityPoolTfName, "iscsi_settings.iqn_prefix", "iqn.2009-05.com.example"),
+					resource.TestCheckResourceAttr(identityPoolTfName, "iscsi_settings.initiator_ip_pool.ip_range", "10.33.0.1-10.33.0.255"),
+					resource.TestCheckResourceAttr(identityPoolTfName, "fc_settings.starting_address", "AA:BB:CC:DD:F0:00"),
+					resource.TestCheckResourceAttr(identityPoolTfName, "usage.#", "3"),
+				),
+			},
+			// Import testing
+			{
+				ResourceName:      identityPoolTfName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestIdentityPoolResourceValidationError(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testIdentityPoolInvalidMac,
+				ExpectError: regexp.MustCompile(`.*must be a MAC address.*`),
+			},
+			{
+				Config:      testIdentityPoolInvalidCount,
+				ExpectError: regexp.MustCompile(`.*value must be between 1 and 50000.*`),
+			},
+			{
+				Config:      testIdentityPoolOverflow,
+				ExpectError: regexp.MustCompile(`.*exceed the last address.*`),
+			},
+			{
+				Config:      testIdentityPoolOverlap,
+				ExpectError: regexp.MustCompile(`.*mac address ranges of ethernet_settings and fcoe_settings overlap.*`),
+			},
+			{
+				Config:      testIdentityPoolInvalidIPRange,
+				ExpectError: regexp.MustCompile(`.*is not a valid IP range or CIDR.*`),
+			},
+		},
+	})
+}
+
+func TestIdentityPoolResourceRanges(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// adjacent mac address ranges do not overlap
+			{
+				Config:             testIdentityPoolAdjacent,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// the world wide names of the fibre channel identities are not in the mac address space
+			{
+				Config:             testIdentityPoolFcSameAddress,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// the last identity may be the last address
+			{
+				Config:             testIdentityPoolLastAddress,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config:      testIdentityPoolIscsiFcoeOverlap,
+				ExpectError: regexp.MustCompile(`.*mac address ranges of iscsi_settings and fcoe_settings overlap.*`),
+			},
+			{
+				Config:      testIdentityPoolInvalidGateway,
+				ExpectError: regexp.MustCompile(`.*gateway: 10.33.0 is not a valid IPv4.*`),
+			},
+		},
+	})
+}
+
+func TestIdentityPoolResourcePayload(t *testing.T) {
+	var identityPoolTfName = "ome_identity_pool.pool"
+	var payload models.IdentityPool
+	var createIdentityPool func(*clients.Client, models.IdentityPool) (models.IdentityPool, error)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// the addresses are sent in base64, the fibre channel ones with the wwnn and wwpn prefixes,
+			// the usage is counted by identity type whatever the case of the statuses
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock((*clients.Client).CreateIdentityPool).To(func(c *clients.Client, pool models.IdentityPool) (models.IdentityPool, error) {
+						payload = pool
+						return createIdentityPool(c, pool)
+					}).Origin(&createIdentityPool).Build()
+					localMocker = Mock((*clients.Client).GetIdentitySets).Return([]models.IdentitySet{
+						{ID: 1, Name: "Ethernet"},
+						{ID: 2, Name: "iSCSI"},
+						{ID: 3, Name: "FCoE"},
+						{ID: 4, Name: "FC"},
+					}, nil).Build()
+					localMocker2 = Mock((*clients.Client).GetIdentitySetUsage).To(func(_ *clients.Client, _, setID int64) ([]models.IdentityUsage, error) {
+						return map[int64][]models.IdentityUsage{
+							1: {{Status: "Assigned"}, {Status: "Assigned"}, {Status: "Reserved"}},
+							2: {{Status: "ASSIGNED"}},
+							3: {{Status: "Assigned"}, {Status: "Assigned"}, {Status: "Assigned"}},
+							4: {{Status: "reserved"}},
+						}[setID], nil
+					}).Build()
+				},
+				Config: testIdentityPoolUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(identityPoolTfName, "usage.#", "3"),
+					resource.TestCheckResourceAttr(identityPoolTfName, "usage.0.identity_type", "ethernet"),
+					resource.TestCheckResourceAttr(identityPoolTfName, "usage.0.assigned", "2"),
+					resource.TestCheckResourceAttr(identityPoolTfName, "usage.0.reserved", "1"),
+					resource.TestCheckResourceAttr(identityPoolTfName, "usage.0.free", "97"),
+					resource.TestCheckResourceAttr(identityPoolTfName, "usage.1.identity_type", "iscsi"),
+					resource.TestCheckResourceAttr(identityPoolTfName, "usage.1.assigned", "1"),
+					resource.TestCheckResourceAttr(identityPoolTfName, "usage.1.free", "49"),
+					resource.TestCheckResourceAttr(identityPoolTfName, "usage.2.identity_type", "fc"),
+					resource.TestCheckResourceAttr(identityPoolTfName, "usage.2.assigned", "0"),
+					resource.TestCheckResourceAttr(identityPoolTfName, "usage.2.reserved", "1"),
+					// the address is kept as configured
+					resource.TestCheckResourceAttr(identityPoolTfName, "iscsi_settings.starting_mac_address", "AA:BB:CC:DD:EF:00"),
+					func(_ *terraform.State) error {
+						FunctionMocker.UnPatch()
+						localMocker.UnPatch()
+						localMocker2.UnPatch()
+						if payload.EthernetSettings == nil || payload.EthernetSettings.Mac.StartingMacAddress != "qrvM3e4A" || payload.EthernetSettings.Mac.IdentityCount != 100 {
+							return fmt.Errorf("unexpected ethernet settings %+v", payload.EthernetSettings)
+						}
+						iscsi := payload.IscsiSettings
+						if iscsi == nil || iscsi.Mac.StartingMacAddress != "qrvM3e8A" || iscsi.InitiatorConfig == nil || iscsi.InitiatorConfig.IqnPrefix != "iqn.2009-05.com.example" {
+							return fmt.Errorf("unexpected iscsi settings %+v", iscsi)
+						}
+						if iscsi.InitiatorIPPoolSettings == nil || iscsi.InitiatorIPPoolSettings.IPRange != "10.33.0.1-10.33.0.255" || iscsi.InitiatorIPPoolSettings.PrimaryDNSServer != "" {
+							return fmt.Errorf("unexpected initiator ip pool %+v", iscsi.InitiatorIPPoolSettings)
+						}
+						if payload.FcoeSettings != nil {
+							return fmt.Errorf("expected no fcoe settings, got %+v", payload.FcoeSettings)
+						}
+						fc := payload.FcSettings
+						if fc == nil || fc.Wwnn.StartingAddress != "IACqu8zd8AA=" || fc.Wwpn.StartingAddress != "IAGqu8zd8AA=" || fc.Wwpn.IdentityCount != 50 {
+							return fmt.Errorf("unexpected fc settings %+v", fc)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+var testIdentityPoolCreate = testProvider + `
+resource "ome_identity_pool" "pool" {
+	name = "tfacc_identity_pool"
+	ethernet_settings = {
+		starting_mac_address = "aa:bb:cc:dd:ee:00"
+		identity_count       = 50
+	}
+}
+`
+
+var testIdentityPoolUpdate = testProvider + `
+resource "ome_identity_pool" "pool" {
+	name        = "tfacc_identity_pool"
+	description = "tfacc identity pool"
+	ethernet_settings = {
+		starting_mac_address = "aa:bb:cc:dd:ee:00"
+		identity_count       = 100
+	}
+	iscsi_settings = {
+		starting_mac_address = "AA:BB:CC:DD:EF:00"
+		identity_count       = 50
+		iqn_prefix           = "iqn.2009-05.com.example"
+		initiator_ip_pool = {
+			ip_range    = "10.33.0.1-10.33.0.255"
+			subnet_mask = "255.255.255.0"
+			gateway     = "10.33.0.254"
+		}
+	}
+	fc_settings = {
+		starting_address = "AA:BB:CC:DD:F0:00"
+		identity_count   = 50
+	}
+}
+`
+
+var testIdentityPoolInvalidMac = testProvider + `
+resource "ome_identity_pool" "pool" {
+	name = "tfacc_identity_pool"
+	ethernet_settings = {
+		starting_mac_address = "aa:bb:cc:dd:ee"
+		identity_count       = 50
+	}
+}
+`
+
+var testIdentityPoolInvalidCount = testProvider + `
+resource "ome_identity_pool" "pool" {
+	name = "tfacc_identity_pool"
+	ethernet_settings = {
+		starting_mac_address = "aa:bb:cc:dd:ee:00"
+		identity_count       = 0
+	}
+}
+`
+
+var testIdentityPoolOverflow = testProvider + `
+resource "ome_identity_pool" "pool" {
+	name = "tfacc_identity_pool"
+	ethernet_settings = {
+		starting_mac_address = "ff:ff:ff:ff:ff:f0"
+		identity_count       = 50
+	}
+}
+`
+
+var testIdentityPoolOverlap = testProvider + `
+resource "ome_identity_pool" "pool" {
+	name = "tfacc_identity_pool"
+	ethernet_settings = {
+		starting_mac_address = "aa:bb:cc:dd:ee:00"
+		identity_count       = 50
+	}
+	fcoe_settings = {
+		starting_mac_address = "aa:bb:cc:dd:ee:10"
+		identity_count       = 50
+	}
+}
+`
+
+var testIdentityPoolInvalidIPRange = testProvider + `
+resource "ome_identity_pool" "pool" {
+	name = "tfacc_identity_pool"
+	iscsi_settings = {
+		starting_mac_address = "aa:bb:cc:dd:ee:00"
+		identity_count       = 50
+		initiator_ip_pool = {
+			ip_range    = "10.33.0.*"
+			subnet_mask = "255.255.255.0"
+		}
+	}
+}
+`
+
+var testIdentityPoolAdjacent = testProvider + `
+resource "ome_identity_pool" "pool" {
+	name = "tfacc_identity_pool"
+	ethernet_settings = {
+		starting_mac_address = "aa:bb:cc:dd:ee:00"
+		identity_count       = 16
+	}
+	fcoe_settings = {
+		starting_mac_address = "aa:bb:cc:dd:ee:10"
+		identity_count       = 16
+	}
+}
+`
+
+var testIdentityPoolFcSameAddress = testProvider + `
+resource "ome_identity_pool" "pool" {
+	name = "tfacc_identity_pool"
+	ethernet_settings = {
+		starting_mac_address = "aa:bb:cc:dd:ee:00"
+		identity_count       = 50
+	}
+	fc_settings = {
+		starting_address = "aa:bb:cc:dd:ee:00"
+		identity_count   = 50
+	}
+}
+`
+
+var testIdentityPoolLastAddress = testProvider + `
+resource "ome_identity_pool" "pool" {
+	name = "tfacc_identity_pool"
+	ethernet_settings = {
+		starting_mac_address = "ff:ff:ff:ff:ff:ce"
+		identity_count       = 50
+	}
+}
+`
+
+var testIdentityPoolIscsiFcoeOverlap = testProvider + `
+resource "ome_identity_pool" "pool" {
+	name = "tfacc_identity_pool"
+	ethernet_settings = {
+		starting_mac_address = "aa:bb:cc:dd:ee:00"
+		identity_count       = 16
+	}
+	iscsi_settings = {
+		starting_mac_address = "aa:bb:cc:dd:ef:00"
+		identity_count       = 16
+	}
+	fcoe_settings = {
+		starting_mac_address = "AA:BB:CC:DD:EF:0F"
+		identity_count       = 16
+	}
+}
+`
+
+var testIdentityPoolInvalidGateway = testProvider + `
+resource "ome_identity_pool" "pool" {
+	name = "tfacc_identity_pool"
+	iscsi_settings = {
+		starting_mac_address = "aa:bb:cc:dd:ee:00"
+		identity_count       = 50
+		initiator_ip_pool = {
+			ip_range    = "10.33.0.0/24"
+			subnet_mask = "255.255.255.0"
+			gateway     = "10.33.0"
+		}
+	}
+}
+`
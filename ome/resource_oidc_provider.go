@@ -0,0 +1,230 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/helper"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &oidcProviderResource{}
+	_ resource.ResourceWithConfigure      = &oidcProviderResource{}
+	_ resource.ResourceWithImportState    = &oidcProviderResource{}
+	_ resource.ResourceWithValidateConfig = &oidcProviderResource{}
+)
+
+// NewOIDCProviderResource is a helper function to simplify the provider implementation.
+func NewOIDCProviderResource() resource.Resource {
+	return &oidcProviderResource{}
+}
+
+// oidcProviderResource is the resource implementation.
+type oidcProviderResource struct {
+	p *omeProvider
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *oidcProviderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.p = req.ProviderData.(*omeProvider)
+}
+
+// Metadata returns the resource type name.
+func (r *oidcProviderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "oidc_provider"
+}
+
+// Schema defines the schema for the resource.
+func (r *oidcProviderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This terraform resource is used to manage the OpenID Connect providers used for console logins on OME." +
+			" We can Create, Update and Delete OME OpenID Connect providers using this resource. We can also 'Import' an existing 'OpenID Connect provider' from OME.",
+		Version:    1,
+		Attributes: OIDCProviderSchema(),
+	}
+}
+
+// ValidateConfig validates the OpenID Connect provider configuration.
+func (r *oidcProviderResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data models.OmeOIDCProvider
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := helper.ValidateOIDCProvider(data); err != nil {
+		resp.Diagnostics.AddError(
+			"Attribute Error",
+			err.Error(),
+		)
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *oidcProviderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "resource_oidc_provider create: started")
+	var plan models.OmeOIDCProvider
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_oidc_provider Create")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	provider, err := helper.CreateOIDCProvider(omeClient, helper.MakeOIDCProviderPayload(plan, 0))
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrCreateOIDCProvider, err.Error())
+		return
+	}
+
+	state := helper.SetStateOIDCProvider(provider, plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "resource_oidc_provider create: finished")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *oidcProviderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "resource_oidc_provider read: started")
+	var state models.OmeOIDCProvider
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_oidc_provider Read")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	provider, err := helper.GetOIDCProvider(omeClient, state.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrReadOIDCProvider, err.Error())
+		return
+	}
+
+	state = helper.SetStateOIDCProvider(provider, state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "resource_oidc_provider read: finished")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *oidcProviderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "resource_oidc_provider update: started")
+	var state, plan models.OmeOIDCProvider
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_oidc_provider Update")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	provider, err := helper.UpdateOIDCProvider(omeClient, helper.MakeOIDCProviderPayload(plan, state.ID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrUpdateOIDCProvider, err.Error())
+		return
+	}
+
+	state = helper.SetStateOIDCProvider(provider, plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "resource_oidc_provider update: finished")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *oidcProviderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "resource_oidc_provider delete: started")
+	var state models.OmeOIDCProvider
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_oidc_provider Delete")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	if err := helper.DeleteOIDCProvider(omeClient, state.ID.ValueInt64()); err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrDeleteOIDCProvider, err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Trace(ctx, "resource_oidc_provider delete: finished")
+}
+
+// ImportState imports an existing OpenID Connect provider by id.
+func (r *oidcProviderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Trace(ctx, "resource_oidc_provider import: started")
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrImportOIDCProvider, fmt.Sprintf("invalid OpenID Connect provider id %s: %s", req.ID, err.Error()))
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_oidc_provider ImportState")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	provider, err := helper.GetOIDCProvider(omeClient, id)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrImportOIDCProvider, err.Error())
+		return
+	}
+
+	state := helper.SetStateOIDCProvider(provider, helper.ImportStateOIDCProvider())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "resource_oidc_provider import: finished")
+}
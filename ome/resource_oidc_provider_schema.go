@@ -0,0 +1,115 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"terraform-provider-ome/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// OIDCProviderSchema returns the schema for the OpenID Connect provider resource
+func OIDCProviderSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the OpenID Connect provider.",
+			Description:         "ID of the OpenID Connect provider.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the OpenID Connect provider.",
+			Description:         "Name of the OpenID Connect provider.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"discovery_uri": schema.StringAttribute{
+			MarkdownDescription: "Discovery URL of the OpenID Connect provider, for example `https://idp.example.com/.well-known/openid-configuration`.",
+			Description:         "Discovery URL of the OpenID Connect provider, for example 'https://idp.example.com/.well-known/openid-configuration'.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"registration_method": schema.StringAttribute{
+			MarkdownDescription: "Method used to register OME as a client of the OpenID Connect provider." +
+				" Supported values are `client_credentials` and `initial_access_token`. Default value is `client_credentials`.",
+			Description: "Method used to register OME as a client of the OpenID Connect provider." +
+				" Supported values are 'client_credentials' and 'initial_access_token'. Default value is 'client_credentials'.",
+			Optional: true,
+			Computed: true,
+			Default:  stringdefault.StaticString(helper.OIDCRegistrationClientCredentials),
+			Validators: []validator.String{
+				stringvalidator.OneOf(helper.OIDCRegistrationClientCredentials, helper.OIDCRegistrationInitialAccessToken),
+			},
+		},
+		"client_id": schema.StringAttribute{
+			MarkdownDescription: "Client ID of OME on the OpenID Connect provider. Required when `registration_method` is `client_credentials`." +
+				" When registering with an initial access token, the client ID generated by the provider is returned.",
+			Description: "Client ID of OME on the OpenID Connect provider. Required when 'registration_method' is 'client_credentials'." +
+				" When registering with an initial access token, the client ID generated by the provider is returned.",
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"client_secret": schema.StringAttribute{
+			MarkdownDescription: "Client secret of OME on the OpenID Connect provider. Required when `registration_method` is `client_credentials`." +
+				" The secret is never read back from OME, changing it in the configuration updates the provider.",
+			Description: "Client secret of OME on the OpenID Connect provider. Required when 'registration_method' is 'client_credentials'." +
+				" The secret is never read back from OME, changing it in the configuration updates the provider.",
+			Optional:  true,
+			Sensitive: true,
+		},
+		"initial_access_token": schema.StringAttribute{
+			MarkdownDescription: "Initial access token used to register OME on the OpenID Connect provider. Required when `registration_method` is `initial_access_token`." +
+				" The token is never read back from OME.",
+			Description: "Initial access token used to register OME on the OpenID Connect provider. Required when 'registration_method' is 'initial_access_token'." +
+				" The token is never read back from OME.",
+			Optional:  true,
+			Sensitive: true,
+		},
+		"verify_certificate": schema.BoolAttribute{
+			MarkdownDescription: "Validate the certificate of the OpenID Connect provider. Default value is `true`.",
+			Description:         "Validate the certificate of the OpenID Connect provider. Default value is 'true'.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+		},
+		"certificate": schema.StringAttribute{
+			MarkdownDescription: "PEM encoded CA certificate used to validate the OpenID Connect provider. The certificate is never read back from OME.",
+			Description:         "PEM encoded CA certificate used to validate the OpenID Connect provider. The certificate is never read back from OME.",
+			Optional:            true,
+		},
+		"enabled": schema.BoolAttribute{
+			MarkdownDescription: "Enable console logins with the OpenID Connect provider. Default value is `true`.",
+			Description:         "Enable console logins with the OpenID Connect provider. Default value is 'true'.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+		},
+	}
+}
@@ -0,0 +1,208 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/helper"
+	"terraform-provider-ome/models"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestOIDCProviderResource(t *testing.T) {
+	var oidcProviderTfName = "ome_oidc_provider.oidc"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testOIDCProviderCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(oidcProviderTfName, "name", "tfacc_oidc"),
+					resource.TestCheckResourceAttr(oidcProviderTfName, "registration_method", "client_credentials"),
+					resource.TestCheckResourceAttr(oidcProviderTfName, "client_id", "tfacc_client"),
+					resource.TestCheckResourceAttr(oidcProviderTfName, "enabled", "true"),
+				),
+			},
+			{
+				Config: testOIDCProviderUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(oidcProviderTfName, "name", "tfacc_oidc_update"),
+					resource.TestCheckResourceAttr(oidcProviderTfName, "enabled", "false"),
+					resource.TestCheckResourceAttr(oidcProviderTfName, "verify_certificate", "false"),
+				),
+			},
+			// Import testing
+			{
+				ResourceName:            oidcProviderTfName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"client_secret"},
+			},
+		},
+	})
+}
+
+func TestOIDCProviderResourceValidationError(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testOIDCProviderNoSecret,
+				ExpectError: regexp.MustCompile(`.*client_id and client_secret are required.*`),
+			},
+			{
+				Config:      testOIDCProviderNoToken,
+				ExpectError: regexp.MustCompile(`.*initial_access_token is required.*`),
+			},
+			{
+				Config:      testOIDCProviderCertNoVerify,
+				ExpectError: regexp.MustCompile(`.*certificate cannot be set when verify_certificate is false.*`),
+			},
+		},
+	})
+}
+
+func TestOIDCProviderResourceSecret(t *testing.T) {
+	var (
+		oidcProviderTfName                     = "ome_oidc_provider.oidc"
+		createOIDCProvider, updateOIDCProvider func(*clients.Client, models.OIDCProvider) (models.OIDCProvider, error)
+		payloads                               []models.OIDCProvider
+	)
+	checkSecrets := func(want ...string) resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			secrets := []string{}
+			for _, payload := range payloads {
+				secrets = append(secrets, payload.ClientSecret)
+			}
+			if !slices.Equal(secrets, want) {
+				return fmt.Errorf("expected the client secrets %v to be sent, got %v", want, secrets)
+			}
+			return nil
+		}
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock(helper.CreateOIDCProvider).To(func(c *clients.Client, p models.OIDCProvider) (models.OIDCProvider, error) {
+						payloads = append(payloads, p)
+						return createOIDCProvider(c, p)
+					}).Origin(&createOIDCProvider).Build()
+					localMocker = Mock(helper.UpdateOIDCProvider).To(func(c *clients.Client, p models.OIDCProvider) (models.OIDCProvider, error) {
+						payloads = append(payloads, p)
+						return updateOIDCProvider(c, p)
+					}).Origin(&updateOIDCProvider).Build()
+				},
+				Config: testOIDCProviderCreate,
+				Check: resource.ComposeTestCheckFunc(
+					checkSecrets("tfacc_secret"),
+					resource.TestCheckResourceAttr(oidcProviderTfName, "client_secret", "tfacc_secret"),
+				),
+			},
+			// the secret is not returned by the appliance, the refresh keeps it from the state
+			{
+				Config:   testOIDCProviderCreate,
+				PlanOnly: true,
+			},
+			// changing only the secret updates the provider with the new secret
+			{
+				Config: testOIDCProviderSecret("tfacc_secret_rotated"),
+				Check: resource.ComposeTestCheckFunc(
+					checkSecrets("tfacc_secret", "tfacc_secret_rotated"),
+					resource.TestCheckResourceAttr(oidcProviderTfName, "client_secret", "tfacc_secret_rotated"),
+				),
+			},
+			// the imported provider has no secret
+			{
+				ResourceName: oidcProviderTfName,
+				ImportState:  true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if _, ok := states[0].Attributes["client_secret"]; ok {
+						return fmt.Errorf("expected no client secret to be imported")
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func testOIDCProviderSecret(secret string) string {
+	return testProvider + `
+	resource "ome_oidc_provider" "oidc" {
+		name          = "tfacc_oidc"
+		discovery_uri = "` + OIDCDiscoveryURI + `"
+		client_id     = "tfacc_client"
+		client_secret = "` + secret + `"
+	}
+	`
+}
+
+var testOIDCProviderCreate = testProvider + `
+resource "ome_oidc_provider" "oidc" {
+	name          = "tfacc_oidc"
+	discovery_uri = "` + OIDCDiscoveryURI + `"
+	client_id     = "tfacc_client"
+	client_secret = "tfacc_secret"
+}
+`
+
+var testOIDCProviderUpdate = testProvider + `
+resource "ome_oidc_provider" "oidc" {
+	name               = "tfacc_oidc_update"
+	discovery_uri      = "` + OIDCDiscoveryURI + `"
+	client_id          = "tfacc_client"
+	client_secret      = "tfacc_secret_update"
+	verify_certificate = false
+	enabled            = false
+}
+`
+
+var testOIDCProviderNoSecret = testProvider + `
+resource "ome_oidc_provider" "oidc" {
+	name          = "tfacc_oidc"
+	discovery_uri = "https://idp.example.com/.well-known/openid-configuration"
+	client_id     = "tfacc_client"
+}
+`
+
+var testOIDCProviderNoToken = testProvider + `
+resource "ome_oidc_provider" "oidc" {
+	name                = "tfacc_oidc"
+	discovery_uri       = "https://idp.example.com/.well-known/openid-configuration"
+	registration_method = "initial_access_token"
+}
+`
+
+var testOIDCProviderCertNoVerify = testProvider + `
+resource "ome_oidc_provider" "oidc" {
+	name               = "tfacc_oidc"
+	discovery_uri      = "https://idp.example.com/.well-known/openid-configuration"
+	client_id          = "tfacc_client"
+	client_secret      = "tfacc_secret"
+	verify_certificate = false
+	certificate        = "-----BEGIN CERTIFICATE-----"
+}
+`
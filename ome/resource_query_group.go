@@ -0,0 +1,233 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/helper"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &queryGroupResource{}
+	_ resource.ResourceWithConfigure      = &queryGroupResource{}
+	_ resource.ResourceWithValidateConfig = &queryGroupResource{}
+)
+
+// NewQueryGroupResource is a helper function to simplify the provider implementation.
+func NewQueryGroupResource() resource.Resource {
+	return &queryGroupResource{}
+}
+
+// queryGroupResource is the resource implementation.
+type queryGroupResource struct {
+	p *omeProvider
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *queryGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.p = req.ProviderData.(*omeProvider)
+}
+
+// Metadata returns the resource type name.
+func (r *queryGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "query_group"
+}
+
+// Schema defines the schema for the resource.
+func (r *queryGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This terraform resource is used to manage Query Device Group entity on OME." +
+			" The members of a query group are the devices which match its criteria." +
+			" We can Create, Update and Delete OME Query Device Group using this resource." +
+			" Import is not supported as the criteria of a query group is not read back from OME.",
+		Version:    1,
+		Attributes: QueryGroupSchema(),
+	}
+}
+
+// ValidateConfig validates the query group configuration.
+func (r *queryGroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data models.OmeQueryGroup
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := helper.ValidateQueryGroup(data); err != nil {
+		resp.Diagnostics.AddError(
+			"Attribute Error",
+			err.Error(),
+		)
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *queryGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "resource_query_group create: started")
+	var plan models.OmeQueryGroup
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_query_group Create")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	group, query, err := helper.MakeQueryGroupPayload(omeClient, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrCreateQueryGroup, err.Error())
+		return
+	}
+	id, err := helper.CreateQueryGroup(omeClient, group, query)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrCreateQueryGroup, err.Error())
+		return
+	}
+
+	createdGroup, devices, err := helper.GetQueryGroup(omeClient, id)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrCreateQueryGroup, err.Error())
+		return
+	}
+	state, d := helper.NewQueryGroupState(createdGroup, devices, plan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "resource_query_group create: finished")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *queryGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "resource_query_group read: started")
+	var state models.OmeQueryGroup
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_query_group Read")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	group, devices, err := helper.GetQueryGroup(omeClient, state.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrReadQueryGroup, err.Error())
+		return
+	}
+	state, d = helper.NewQueryGroupState(group, devices, state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "resource_query_group read: finished")
+}
+
+// Update updates the name and description of the query group, any other change recreates the group.
+func (r *queryGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "resource_query_group update: started")
+	var state, plan models.OmeQueryGroup
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_query_group Update")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	err := omeClient.UpdateGroup(models.Group{
+		ID:               state.ID.ValueInt64(),
+		Name:             plan.Name.ValueString(),
+		Description:      plan.Description.ValueString(),
+		MembershipTypeID: helper.QueryGroupMembershipTypeID,
+		ParentID:         state.ParentID.ValueInt64(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrUpdateQueryGroup, err.Error())
+		return
+	}
+
+	group, devices, err := helper.GetQueryGroup(omeClient, state.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrUpdateQueryGroup, err.Error())
+		return
+	}
+	state, d = helper.NewQueryGroupState(group, devices, plan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "resource_query_group update: finished")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *queryGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "resource_query_group delete: started")
+	var state models.OmeQueryGroup
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_query_group Delete")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	if err := omeClient.DeleteGroup(state.ID.ValueInt64()); err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrDeleteQueryGroup, err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Trace(ctx, "resource_query_group delete: finished")
+}
@@ -0,0 +1,162 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// QueryGroupSchema returns the schema for the query group resource
+func QueryGroupSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the query group.",
+			Description:         "ID of the query group.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the query group.",
+			Description:         "Name of the query group.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Description of the query group.",
+			Description:         "Description of the query group.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(""),
+		},
+		"parent_id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the parent group of the query group, usually the `Query Groups` group." +
+				" If the value of `parent_id` changes, Terraform will destroy and recreate the resource.",
+			Description: "ID of the parent group of the query group, usually the 'Query Groups' group." +
+				" If the value of 'parent_id' changes, Terraform will destroy and recreate the resource.",
+			Required: true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"membership_type_id": schema.Int64Attribute{
+			MarkdownDescription: "Membership type of the query group.",
+			Description:         "Membership type of the query group.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"criteria": schema.SingleNestedAttribute{
+			MarkdownDescription: "Criteria of the devices which are members of the query group." +
+				" The `conditions` and the nested `groups` of conditions are joined with `operator`." +
+				" The fields and operators are validated against the query metadata of OME." +
+				" If the value of `criteria` changes, Terraform will destroy and recreate the resource.",
+			Description: "Criteria of the devices which are members of the query group." +
+				" The 'conditions' and the nested 'groups' of conditions are joined with 'operator'." +
+				" The fields and operators are validated against the query metadata of OME." +
+				" If the value of 'criteria' changes, Terraform will destroy and recreate the resource.",
+			Required: true,
+			PlanModifiers: []planmodifier.Object{
+				objectplanmodifier.RequiresReplace(),
+			},
+			Attributes: map[string]schema.Attribute{
+				"operator":   queryLogicalOperatorSchema(),
+				"conditions": queryConditionsSchema(),
+				"groups": schema.ListNestedAttribute{
+					MarkdownDescription: "Groups of conditions, each group is put in parentheses.",
+					Description:         "Groups of conditions, each group is put in parentheses.",
+					Optional:            true,
+					Validators: []validator.List{
+						listvalidator.SizeAtLeast(1),
+					},
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"operator":   queryLogicalOperatorSchema(),
+							"conditions": queryConditionsSchema(),
+						},
+					},
+				},
+			},
+		},
+		"device_ids": schema.SetAttribute{
+			MarkdownDescription: "List of IDs of the devices which match the criteria of the query group.",
+			Description:         "List of IDs of the devices which match the criteria of the query group.",
+			Computed:            true,
+			ElementType:         types.Int64Type,
+		},
+	}
+}
+
+func queryLogicalOperatorSchema() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "Logical operator joining the conditions. Supported values are `and` and `or`. Default value is `and`.",
+		Description:         "Logical operator joining the conditions. Supported values are 'and' and 'or'. Default value is 'and'.",
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString("and"),
+		Validators: []validator.String{
+			stringvalidator.OneOf("and", "or"),
+		},
+	}
+}
+
+func queryConditionsSchema() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: "Conditions on the fields of the devices.",
+		Description:         "Conditions on the fields of the devices.",
+		Optional:            true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"field": schema.StringAttribute{
+					MarkdownDescription: "Name of the field, for example `Device Model`." +
+						" Use the format `<table>.<field>` when the field name exists in several tables.",
+					Description: "Name of the field, for example 'Device Model'." +
+						" Use the format '<table>.<field>' when the field name exists in several tables.",
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+				"operator": schema.StringAttribute{
+					MarkdownDescription: "Operator of the condition, for example `=`, `!=`, `contains` or `startswith`.",
+					Description:         "Operator of the condition, for example '=', '!=', 'contains' or 'startswith'.",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+				"value": schema.StringAttribute{
+					MarkdownDescription: "Value compared with the field.",
+					Description:         "Value compared with the field.",
+					Required:            true,
+				},
+			},
+		},
+	}
+}
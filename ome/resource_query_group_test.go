@@ -0,0 +1,291 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/helper"
+	"terraform-provider-ome/models"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestQueryGroupResource(t *testing.T) {
+	var queryGroupTfName = "ome_query_group.query"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testQueryGroupCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(queryGroupTfName, "name", "tfacc_query_group"),
+					resource.TestCheckResourceAttr(queryGroupTfName, "membership_type_id", "24"),
+					resource.TestCheckResourceAttr(queryGroupTfName, "criteria.operator", "or"),
+					resource.TestCheckResourceAttr(queryGroupTfName, "criteria.groups.0.operator", "and"),
+					resource.TestCheckResourceAttrSet(queryGroupTfName, "device_ids.#"),
+				),
+			},
+			{
+				Config: testQueryGroupUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(queryGroupTfName, "name", "tfacc_query_group_update"),
+					resource.TestCheckResourceAttr(queryGroupTfName, "description", "query group updated by acceptance test"),
+				),
+			},
+		},
+	})
+}
+
+func TestQueryGroupResourceValidationError(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testQueryGroupNoConditions,
+				ExpectError: regexp.MustCompile(`.*at least one of criteria.conditions or criteria.groups is required.*`),
+			},
+			{
+				Config:      testQueryGroupEmptyGroup,
+				ExpectError: regexp.MustCompile(`.*criteria.groups\[0\] requires at least one condition.*`),
+			},
+			{
+				Config:      testQueryGroupInvalidField,
+				ExpectError: regexp.MustCompile(`.*invalid query field.*`),
+			},
+			{
+				Config:      testQueryGroupInvalidOperator,
+				ExpectError: regexp.MustCompile(`.*invalid query operator.*`),
+			},
+		},
+	})
+}
+
+func TestQueryGroupResourceConditions(t *testing.T) {
+	var (
+		createQueryGroup func(*clients.Client, models.Group, models.QueryGroupExtension) (int64, error)
+		query            models.QueryGroupExtension
+	)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// the nested groups are flattened between parentheses, their first condition is joined with the criteria operator
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock(helper.CreateQueryGroup).To(func(c *clients.Client, group models.Group, q models.QueryGroupExtension) (int64, error) {
+						query = q
+						return createQueryGroup(c, group, q)
+					}).Origin(&createQueryGroup).Build()
+				},
+				Config: testQueryGroupCreate,
+				Check: resource.ComposeTestCheckFunc(
+					func(*terraform.State) error {
+						FunctionMocker.UnPatch()
+						type shape struct {
+							operator              int64
+							leftParen, rightParen bool
+							value                 string
+						}
+						want := []shape{
+							{2, false, false, DeviceModel},
+							{2, true, false, "PowerEdge"},
+							{1, false, true, DeviceModel},
+						}
+						got := []shape{}
+						for _, c := range query.Conditions {
+							got = append(got, shape{c.LogicalOperatorID, c.LeftParen, c.RightParen, c.Value})
+						}
+						if !reflect.DeepEqual(got, want) {
+							return fmt.Errorf("expected the conditions %+v, got %+v", want, got)
+						}
+						if query.Conditions[0].FieldID != query.Conditions[1].FieldID || query.Conditions[0].OperatorID == query.Conditions[1].OperatorID {
+							return fmt.Errorf("expected the same field with different operators, got %+v", query.Conditions)
+						}
+						return nil
+					},
+				),
+			},
+			// a field present in several tables must be qualified by its table, changing the criteria recreates the group
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock((*clients.Client).GetQueryContext).Return(models.QueryContext{
+						Tables: []models.QueryContextTable{
+							{Name: "Device", Fields: []models.QueryContextField{{ID: 1, Name: "Device Model"}}},
+							{Name: "Inventory", Fields: []models.QueryContextField{{ID: 2, Name: "Device Model"}}},
+						},
+					}, nil).Build()
+				},
+				Config:      testQueryGroupSingleCondition,
+				ExpectError: regexp.MustCompile(`.*query field Device Model exists in several tables.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.UnPatch()
+				},
+				Config: testQueryGroupCreate,
+			},
+		},
+	})
+}
+
+var testQueryGroupParent = testProvider + `
+data "ome_groupdevices_info" "query_groups" {
+	device_group_names = ["Query Groups"]
+}
+`
+
+var testQueryGroupCreate = testQueryGroupParent + `
+resource "ome_query_group" "query" {
+	name      = "tfacc_query_group"
+	parent_id = data.ome_groupdevices_info.query_groups.device_groups["Query Groups"].id
+	criteria = {
+		operator = "or"
+		conditions = [
+			{
+				field    = "Device Model"
+				operator = "="
+				value    = "` + DeviceModel + `"
+			}
+		]
+		groups = [
+			{
+				conditions = [
+					{
+						field    = "Device Model"
+						operator = "contains"
+						value    = "PowerEdge"
+					},
+					{
+						field    = "Device Model"
+						operator = "!="
+						value    = "` + DeviceModel + `"
+					}
+				]
+			}
+		]
+	}
+}
+`
+
+var testQueryGroupUpdate = testQueryGroupParent + `
+resource "ome_query_group" "query" {
+	name        = "tfacc_query_group_update"
+	description = "query group updated by acceptance test"
+	parent_id   = data.ome_groupdevices_info.query_groups.device_groups["Query Groups"].id
+	criteria = {
+		operator = "or"
+		conditions = [
+			{
+				field    = "Device Model"
+				operator = "="
+				value    = "` + DeviceModel + `"
+			}
+		]
+		groups = [
+			{
+				conditions = [
+					{
+						field    = "Device Model"
+						operator = "contains"
+						value    = "PowerEdge"
+					},
+					{
+						field    = "Device Model"
+						operator = "!="
+						value    = "` + DeviceModel + `"
+					}
+				]
+			}
+		]
+	}
+}
+`
+
+var testQueryGroupSingleCondition = testQueryGroupParent + `
+resource "ome_query_group" "query" {
+	name      = "tfacc_query_group"
+	parent_id = data.ome_groupdevices_info.query_groups.device_groups["Query Groups"].id
+	criteria = {
+		conditions = [
+			{
+				field    = "Device Model"
+				operator = "="
+				value    = "` + DeviceModel + `"
+			}
+		]
+	}
+}
+`
+
+var testQueryGroupNoConditions = testProvider + `
+resource "ome_query_group" "query" {
+	name      = "tfacc_query_group"
+	parent_id = 1
+	criteria  = {}
+}
+`
+
+var testQueryGroupEmptyGroup = testProvider + `
+resource "ome_query_group" "query" {
+	name      = "tfacc_query_group"
+	parent_id = 1
+	criteria = {
+		groups = [
+			{
+				operator = "or"
+			}
+		]
+	}
+}
+`
+
+var testQueryGroupInvalidField = testQueryGroupParent + `
+resource "ome_query_group" "query" {
+	name      = "tfacc_query_group"
+	parent_id = data.ome_groupdevices_info.query_groups.device_groups["Query Groups"].id
+	criteria = {
+		conditions = [
+			{
+				field    = "invalid field"
+				operator = "="
+				value    = "invalid"
+			}
+		]
+	}
+}
+`
+
+var testQueryGroupInvalidOperator = testQueryGroupParent + `
+resource "ome_query_group" "query" {
+	name      = "tfacc_query_group"
+	parent_id = data.ome_groupdevices_info.query_groups.device_groups["Query Groups"].id
+	criteria = {
+		conditions = [
+			{
+				field    = "Device Model"
+				operator = "invalid"
+				value    = "invalid"
+			}
+		]
+	}
+}
+`
@@ -0,0 +1,321 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/helper"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &serverProfileResource{}
+	_ resource.ResourceWithConfigure   = &serverProfileResource{}
+	_ resource.ResourceWithImportState = &serverProfileResource{}
+)
+
+// NewServerProfileResource is a helper function to simplify the provider implementation.
+func NewServerProfileResource() resource.Resource {
+	return &serverProfileResource{}
+}
+
+// serverProfileResource is the resource implementation.
+type serverProfileResource struct {
+	p *omeProvider
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *serverProfileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.p = req.ProviderData.(*omeProvider)
+}
+
+// Metadata returns the resource type name.
+func (r *serverProfileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "server_profile"
+}
+
+// Schema defines the schema for the resource.
+func (r *serverProfileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This terraform resource is used to manage the server profiles of OME." +
+			" A server profile is created from a deployment template, with its own attribute values and virtual identities," +
+			" and can be assigned to a device or to the sled in a slot of an MX chassis." +
+			" When the device changes, like after a hardware replacement, the server profile is migrated to the new device with its virtual identities." +
+			" We can Create, Update and Delete OME server profiles using this resource. We can also 'Import' an existing 'server profile' from OME.",
+		Version:    1,
+		Attributes: ServerProfileSchema(),
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *serverProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "resource_server_profile create: started")
+	var plan models.OmeServerProfile
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_server_profile Create")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	template, err := helper.GetTemplate(omeClient, plan.TemplateID.ValueInt64(), plan.TemplateName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrCreateServerProfile, err.Error())
+		return
+	}
+	targetID, err := helper.GetServerProfileTarget(omeClient, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrCreateServerProfile, fmt.Sprintf("unable to find the device to assign the server profile to: %s", err.Error()))
+		return
+	}
+
+	id, err := helper.CreateServerProfile(omeClient, models.OMECreateProfile{
+		TemplateID:               template.ID,
+		NamePrefix:               plan.Name.ValueString(),
+		Description:              plan.Description.ValueString(),
+		NumberOfProfilesToCreate: 1,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrCreateServerProfile, err.Error())
+		return
+	}
+
+	// OME suffixes the names of the created profiles with a number, the profile is renamed with its overrides
+	err = r.apply(ctx, omeClient, id, targetID, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrCreateServerProfile, err.Error())
+		profile, getErr := helper.GetServerProfile(omeClient, id)
+		if getErr == nil {
+			getErr = helper.DeleteServerProfile(omeClient, profile, plan)
+		}
+		if getErr != nil {
+			resp.Diagnostics.AddError(clients.ErrGnrCreateServerProfile, fmt.Sprintf("unable to delete the server profile %d: %s", id, getErr.Error()))
+		}
+		return
+	}
+
+	state, d := r.newState(ctx, omeClient, id, plan, clients.ErrGnrCreateServerProfile)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "resource_server_profile create: finished")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *serverProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "resource_server_profile read: started")
+	var state models.OmeServerProfile
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_server_profile Read")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	state, d = r.newState(ctx, omeClient, state.ID.ValueInt64(), state, clients.ErrGnrReadServerProfile)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "resource_server_profile read: finished")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *serverProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "resource_server_profile update: started")
+	var state, plan models.OmeServerProfile
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_server_profile Update")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	targetID, err := helper.GetServerProfileTarget(omeClient, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrUpdateServerProfile, fmt.Sprintf("unable to find the device to assign the server profile to: %s", err.Error()))
+		return
+	}
+	if err := r.apply(ctx, omeClient, state.ID.ValueInt64(), targetID, plan); err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrUpdateServerProfile, err.Error())
+		return
+	}
+
+	state, d = r.newState(ctx, omeClient, state.ID.ValueInt64(), plan, clients.ErrGnrUpdateServerProfile)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "resource_server_profile update: finished")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *serverProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "resource_server_profile delete: started")
+	var state models.OmeServerProfile
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_server_profile Delete")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	profile, err := helper.GetServerProfile(omeClient, state.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrDeleteServerProfile, err.Error())
+		return
+	}
+	if err := helper.DeleteServerProfile(omeClient, profile, state); err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrDeleteServerProfile, err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Trace(ctx, "resource_server_profile delete: finished")
+}
+
+// ImportState imports an existing server profile by id or by name.
+func (r *serverProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Trace(ctx, "resource_server_profile import: started")
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_server_profile ImportState")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	var profile models.OMEProfile
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err == nil {
+		profile, err = helper.GetServerProfile(omeClient, id)
+	} else {
+		profile, err = helper.GetServerProfileByName(omeClient, req.ID)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrImportServerProfile, err.Error())
+		return
+	}
+
+	prior := models.OmeServerProfile{
+		ForceMigrate:   types.BoolValue(false),
+		ForcedShutdown: types.BoolValue(false),
+		JobRetryCount:  types.Int64Value(RetryCount),
+		SleepInterval:  types.Int64Value(SleepInterval),
+	}
+	state, d := r.newState(ctx, omeClient, profile.ID, prior, clients.ErrGnrImportServerProfile)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "resource_server_profile import: finished")
+}
+
+// apply updates the server profile and assigns, migrates, redeploys or unassigns it to match the target device
+func (r *serverProfileResource) apply(ctx context.Context, client *clients.Client, id, targetID int64, plan models.OmeServerProfile) error {
+	profile, err := helper.GetServerProfile(client, id)
+	if err != nil {
+		return err
+	}
+	attributesChanged, err := helper.UpdateServerProfile(ctx, client, profile, plan)
+	if err != nil {
+		return err
+	}
+	switch {
+	case profile.TargetID == 0 && targetID != 0:
+		tflog.Info(ctx, "resource_server_profile: assigning the server profile", map[string]interface{}{"target": targetID})
+		err = helper.AssignServerProfile(client, id, targetID, plan)
+	case profile.TargetID != 0 && targetID == 0:
+		tflog.Info(ctx, "resource_server_profile: unassigning the server profile", map[string]interface{}{"target": profile.TargetID})
+		err = helper.UnassignServerProfile(client, id, plan)
+	case profile.TargetID != targetID:
+		tflog.Info(ctx, "resource_server_profile: migrating the server profile", map[string]interface{}{"source": profile.TargetID, "target": targetID})
+		err = helper.MigrateServerProfile(client, id, targetID, plan)
+	case targetID != 0 && attributesChanged:
+		tflog.Info(ctx, "resource_server_profile: redeploying the server profile", map[string]interface{}{"target": targetID})
+		err = helper.RedeployServerProfile(client, id, plan)
+	}
+	return err
+}
+
+// newState reads the server profile with its attributes and maps it into the terraform state
+func (r *serverProfileResource) newState(ctx context.Context, client *clients.Client, id int64, prior models.OmeServerProfile, summary string) (models.OmeServerProfile, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	profile, err := helper.GetServerProfile(client, id)
+	if err != nil {
+		diags.AddError(summary, err.Error())
+		return prior, diags
+	}
+	attributes := []models.OmeAttribute{}
+	if !prior.AttributeOverrides.IsNull() {
+		attributes, err = helper.GetServerProfileAttributes(client, id)
+		if err != nil {
+			diags.AddError(summary, err.Error())
+			return prior, diags
+		}
+	}
+	return helper.SetStateServerProfile(ctx, profile, attributes, prior)
+}
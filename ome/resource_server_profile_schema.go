@@ -0,0 +1,201 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ServerProfileSchema returns the schema for the server profile resource
+func ServerProfileSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the server profile.",
+			Description:         "ID of the server profile.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the server profile.",
+			Description:         "Name of the server profile.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Description of the server profile.",
+			Description:         "Description of the server profile.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(""),
+		},
+		"template_id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the deployment template of the server profile. Conflicts with `template_name`." +
+				" Changing the template replaces the server profile.",
+			Description: "ID of the deployment template of the server profile. Conflicts with 'template_name'." +
+				" Changing the template replaces the server profile.",
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+				int64planmodifier.RequiresReplace(),
+			},
+			Validators: []validator.Int64{
+				int64validator.ExactlyOneOf(path.MatchRoot("template_name")),
+			},
+		},
+		"template_name": schema.StringAttribute{
+			MarkdownDescription: "Name of the deployment template of the server profile. Conflicts with `template_id`." +
+				" Changing the template replaces the server profile.",
+			Description: "Name of the deployment template of the server profile. Conflicts with 'template_id'." +
+				" Changing the template replaces the server profile.",
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"attribute_overrides": schema.MapAttribute{
+			MarkdownDescription: "Values of the attributes of the server profile, overriding the values of the template," +
+				" keyed by the path of their display names, for example `iDRAC,NIC Information,DNS Domain Name`." +
+				" The leading group names can be omitted as long as the path matches a single attribute." +
+				" The assigned server profile is redeployed when the values change.",
+			Description: "Values of the attributes of the server profile, overriding the values of the template," +
+				" keyed by the path of their display names, for example 'iDRAC,NIC Information,DNS Domain Name'." +
+				" The leading group names can be omitted as long as the path matches a single attribute." +
+				" The assigned server profile is redeployed when the values change.",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+		"device_id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the device to assign the server profile to." +
+				" When the device changes, the server profile is migrated to the new device with its virtual identities." +
+				" The server profile is unassigned when no device is configured." +
+				" Conflicts with `device_servicetag` and `chassis_servicetag`.",
+			Description: "ID of the device to assign the server profile to." +
+				" When the device changes, the server profile is migrated to the new device with its virtual identities." +
+				" The server profile is unassigned when no device is configured." +
+				" Conflicts with 'device_servicetag' and 'chassis_servicetag'.",
+			Optional: true,
+			Validators: []validator.Int64{
+				int64validator.ConflictsWith(path.MatchRoot("device_servicetag"), path.MatchRoot("chassis_servicetag")),
+				int64validator.AtLeast(1),
+			},
+		},
+		"device_servicetag": schema.StringAttribute{
+			MarkdownDescription: "Service tag of the device to assign the server profile to. Conflicts with `device_id` and `chassis_servicetag`.",
+			Description:         "Service tag of the device to assign the server profile to. Conflicts with 'device_id' and 'chassis_servicetag'.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(path.MatchRoot("chassis_servicetag")),
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"chassis_servicetag": schema.StringAttribute{
+			MarkdownDescription: "Service tag of the MX chassis of the slot to assign the server profile to." +
+				" The server profile is assigned to the sled in the slot. Requires `slot_number`.",
+			Description: "Service tag of the MX chassis of the slot to assign the server profile to." +
+				" The server profile is assigned to the sled in the slot. Requires 'slot_number'.",
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.AlsoRequires(path.MatchRoot("slot_number")),
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"slot_number": schema.Int64Attribute{
+			MarkdownDescription: "Number of the slot of the MX chassis to assign the server profile to. Requires `chassis_servicetag`.",
+			Description:         "Number of the slot of the MX chassis to assign the server profile to. Requires 'chassis_servicetag'.",
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.AlsoRequires(path.MatchRoot("chassis_servicetag")),
+				int64validator.AtLeast(1),
+			},
+		},
+		"force_migrate": schema.BoolAttribute{
+			MarkdownDescription: "Migrate the server profile even when it cannot be unassigned from the previous device, like after a hardware failure." +
+				" Default value is `false`.",
+			Description: "Migrate the server profile even when it cannot be unassigned from the previous device, like after a hardware failure." +
+				" Default value is 'false'.",
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(false),
+		},
+		"forced_shutdown": schema.BoolAttribute{
+			MarkdownDescription: "Force the shutdown of the device during the deployment of the server profile. Default value is `false`.",
+			Description:         "Force the shutdown of the device during the deployment of the server profile. Default value is 'false'.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"target_id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the device the server profile is assigned to, null when the server profile is unassigned.",
+			Description:         "ID of the device the server profile is assigned to, null when the server profile is unassigned.",
+			Computed:            true,
+		},
+		"target_name": schema.StringAttribute{
+			MarkdownDescription: "Name of the device the server profile is assigned to, null when the server profile is unassigned.",
+			Description:         "Name of the device the server profile is assigned to, null when the server profile is unassigned.",
+			Computed:            true,
+		},
+		"profile_state": schema.StringAttribute{
+			MarkdownDescription: "State of the server profile, like `unassigned`, `assigned` or `deployed`.",
+			Description:         "State of the server profile, like 'unassigned', 'assigned' or 'deployed'.",
+			Computed:            true,
+		},
+		"job_retry_count": schema.Int64Attribute{
+			MarkdownDescription: "Number of times the jobs of the server profile are polled to get their final status." +
+				fmt.Sprintf(" Default value is `%d`.", RetryCount),
+			Description: "Number of times the jobs of the server profile are polled to get their final status." +
+				fmt.Sprintf(" Default value is '%d'.", RetryCount),
+			Optional: true,
+			Computed: true,
+			Default:  int64default.StaticInt64(RetryCount),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"sleep_interval": schema.Int64Attribute{
+			MarkdownDescription: "Sleep time interval in seconds between the polls of the jobs of the server profile." +
+				fmt.Sprintf(" Default value is `%d`.", SleepInterval),
+			Description: "Sleep time interval in seconds between the polls of the jobs of the server profile." +
+				fmt.Sprintf(" Default value is '%d'.", SleepInterval),
+			Optional: true,
+			Computed: true,
+			Default:  int64default.StaticInt64(SleepInterval),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+	}
+}
@@ -0,0 +1,307 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestServerProfileResource(t *testing.T) {
+	var serverProfileTfName = "ome_server_profile.profile"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testServerProfileCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(serverProfileTfName, "name", "tfacc_server_profile"),
+					resource.TestCheckResourceAttr(serverProfileTfName, "template_name", TemplateName1),
+					resource.TestCheckResourceAttr(serverProfileTfName, "profile_state", "unassigned"),
+					resource.TestCheckNoResourceAttr(serverProfileTfName, "target_id"),
+				),
+			},
+			{
+				Config: testServerProfileAssign,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(serverProfileTfName, "description", "tfacc server profile"),
+					resource.TestCheckResourceAttr(serverProfileTfName, "device_servicetag", DeviceSvcTag1),
+					resource.TestCheckResourceAttr(serverProfileTfName, "attribute_overrides.%", "1"),
+					resource.TestCheckResourceAttrSet(serverProfileTfName, "target_id"),
+					resource.TestCheckResourceAttr(serverProfileTfName, "profile_state", "deployed"),
+				),
+			},
+			// Import testing
+			{
+				ResourceName:            serverProfileTfName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"device_id", "device_servicetag", "attribute_overrides"},
+			},
+			{
+				Config: testServerProfileCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(serverProfileTfName, "profile_state", "unassigned"),
+					resource.TestCheckNoResourceAttr(serverProfileTfName, "target_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestServerProfileResourceValidationError(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testServerProfileNoTemplate,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Combination.*`),
+			},
+			{
+				Config:      testServerProfileSlotWithoutChassis,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Combination.*`),
+			},
+			{
+				Config:      testServerProfileInvalidTemplate,
+				ExpectError: regexp.MustCompile(`.*error creating server profile.*`),
+			},
+			{
+				Config:      testServerProfileInvalidDevice,
+				ExpectError: regexp.MustCompile(`.*unable to find the device to assign the server profile to.*`),
+			},
+		},
+	})
+}
+
+func TestServerProfileResourceActions(t *testing.T) {
+	var serverProfileTfName = "ome_server_profile.profile"
+	// the server profile is kept in memory, the actions run on it are recorded
+	var profile models.OMEProfile
+	var attributes []models.OmeAttribute
+	var actions []string
+	var failAssign bool
+	devices := map[string]int64{"TAG0001": 10001, "TAG0002": 10002}
+	mockers := []*Mocker{
+		Mock((*clients.Client).GetTemplateByIDOrName).Return(models.OMETemplate{ID: 5, Name: "tfacc_profile_template"}, nil).Build(),
+		Mock((*clients.Client).GetDevice).To(func(_ *clients.Client, serviceTag string, _ int64) (models.Device, error) {
+			return models.Device{ID: devices[serviceTag], DeviceServiceTag: serviceTag}, nil
+		}).Build(),
+		Mock((*clients.Client).CreateProfile).To(func(_ *clients.Client, create models.OMECreateProfile) (int64, error) {
+			profile = models.OMEProfile{ID: 77, ProfileName: create.NamePrefix + " (1)", ProfileDescription: create.Description,
+				TemplateID: create.TemplateID, TemplateName: "tfacc_profile_template"}
+			attributes = []models.OmeAttribute{
+				{AttributeID: 1, DisplayName: "iDRAC,Time Zone Configuration Information,Time 1 Time Zone String", Value: "CST6CDT", IsIgnored: true},
+				{AttributeID: 2, DisplayName: "iDRAC,Users,User Name", Value: "root"},
+			}
+			return profile.ID, nil
+		}).Build(),
+		Mock((*clients.Client).GetProfileByID).To(func(_ *clients.Client, _ int64) (models.OMEProfile, error) {
+			return profile, nil
+		}).Build(),
+		Mock((*clients.Client).GetProfileAttributes).To(func(_ *clients.Client, _ int64) ([]models.OmeAttribute, error) {
+			return append([]models.OmeAttribute{}, attributes...), nil
+		}).Build(),
+		Mock((*clients.Client).UpdateProfile).To(func(_ *clients.Client, update models.OMEUpdateProfile) error {
+			actions = append(actions, fmt.Sprintf("update %d", len(update.Attributes)))
+			profile.ProfileName, profile.ProfileDescription = update.ProfileName, update.Description
+			for _, updated := range update.Attributes {
+				for i := range attributes {
+					if attributes[i].AttributeID == updated.ID {
+						attributes[i].Value, attributes[i].IsIgnored = updated.Value, updated.IsIgnored
+					}
+				}
+			}
+			return nil
+		}).Build(),
+		Mock((*clients.Client).AssignProfile).To(func(_ *clients.Client, assign models.OMEAssignProfile) (int64, error) {
+			actions = append(actions, fmt.Sprintf("assign %d", assign.TargetID))
+			if failAssign {
+				return 0, fmt.Errorf("mock assign error")
+			}
+			profile.TargetID, profile.ProfileState = assign.TargetID, 4
+			return 0, nil
+		}).Build(),
+		Mock((*clients.Client).MigrateProfile).To(func(_ *clients.Client, migrate models.OMEMigrateProfile) (int64, error) {
+			actions = append(actions, fmt.Sprintf("migrate %d>%d force=%t", profile.TargetID, migrate.TargetID, migrate.ForceMigrate))
+			profile.TargetID = migrate.TargetID
+			return 0, nil
+		}).Build(),
+		Mock((*clients.Client).RedeployProfiles).To(func(_ *clients.Client, _ models.OMERedeployProfiles) (int64, error) {
+			actions = append(actions, "redeploy")
+			return 0, nil
+		}).Build(),
+		Mock((*clients.Client).UnassignProfiles).To(func(_ *clients.Client, _ []int64) (int64, error) {
+			actions = append(actions, "unassign")
+			profile.TargetID, profile.ProfileState = 0, 0
+			return 0, nil
+		}).Build(),
+		Mock((*clients.Client).DeleteProfiles).To(func(_ *clients.Client, _ []int64) error {
+			actions = append(actions, "delete")
+			return nil
+		}).Build(),
+	}
+	defer func() {
+		for _, mocker := range mockers {
+			mocker.UnPatch()
+		}
+	}()
+	expectActions := func(expected ...string) resource.TestCheckFunc {
+		return func(_ *terraform.State) error {
+			if fmt.Sprint(actions) != fmt.Sprint(expected) {
+				return fmt.Errorf("expected the actions %v, got %v", expected, actions)
+			}
+			return nil
+		}
+	}
+	resetActions := func() { actions = nil }
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// the number suffixed by OME to the name of the created profile is removed
+			{
+				Config: testServerProfileMocked("tfacc_profile_template", `null`, `null`, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(serverProfileTfName, "name", "tfacc_server_profile"),
+					resource.TestCheckResourceAttr(serverProfileTfName, "profile_state", "unassigned"),
+					expectActions("update 0"),
+				),
+			},
+			// the overrides of a new assignment are deployed by the assignment, without redeployment
+			{
+				PreConfig: resetActions,
+				Config:    testServerProfileMocked("tfacc_profile_template", `"TAG0001"`, `{ "Time 1 Time Zone String" = "UTC" }`, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(serverProfileTfName, "target_id", "10001"),
+					resource.TestCheckResourceAttr(serverProfileTfName, "profile_state", "deployed"),
+					resource.TestCheckResourceAttr(serverProfileTfName, "attribute_overrides.Time 1 Time Zone String", "UTC"),
+					expectActions("update 1", "assign 10001"),
+				),
+			},
+			{
+				PreConfig: resetActions,
+				Config:    testServerProfileMocked("tfacc_profile_template", `"TAG0001"`, `{ "Time 1 Time Zone String" = "PST8PDT" }`, false),
+				Check: resource.ComposeTestCheckFunc(
+					expectActions("update 1", "redeploy"),
+				),
+			},
+			{
+				PreConfig: resetActions,
+				Config:    testServerProfileMocked("tfacc_profile_template", `"TAG0002"`, `{ "Time 1 Time Zone String" = "PST8PDT" }`, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(serverProfileTfName, "target_id", "10002"),
+					expectActions("migrate 10001>10002 force=true"),
+				),
+			},
+			{
+				PreConfig: resetActions,
+				Config:    testServerProfileMocked("tfacc_profile_template", `null`, `null`, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(serverProfileTfName, "target_id"),
+					resource.TestCheckResourceAttr(serverProfileTfName, "profile_state", "unassigned"),
+					expectActions("unassign"),
+				),
+			},
+			// the profile created from the new template is deleted when it cannot be assigned
+			{
+				PreConfig: func() {
+					resetActions()
+					failAssign = true
+				},
+				Config:      testServerProfileMocked("tfacc_profile_template_2", `"TAG0001"`, `null`, false),
+				ExpectError: regexp.MustCompile(`.*mock assign error.*`),
+			},
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			return expectActions("delete", "update 0", "assign 10001", "delete")(s)
+		},
+	})
+}
+
+func testServerProfileMocked(templateName, serviceTag, overrides string, forceMigrate bool) string {
+	return testProvider + `
+	resource "ome_server_profile" "profile" {
+		name                = "tfacc_server_profile"
+		template_name       = "` + templateName + `"
+		device_servicetag   = ` + serviceTag + `
+		attribute_overrides = ` + overrides + `
+		force_migrate       = ` + fmt.Sprint(forceMigrate) + `
+	}
+	`
+}
+
+var testServerProfileTemplate = `
+resource "ome_template" "profile_template" {
+	name                 = "` + TemplateName1 + `"
+	refdevice_servicetag = "` + DeviceSvcTag1 + `"
+	fqdds                = "iDRAC"
+}
+`
+
+var testServerProfileCreate = testProvider + testServerProfileTemplate + `
+resource "ome_server_profile" "profile" {
+	name          = "tfacc_server_profile"
+	template_name = ome_template.profile_template.name
+}
+`
+
+var testServerProfileAssign = testProvider + testServerProfileTemplate + `
+resource "ome_server_profile" "profile" {
+	name              = "tfacc_server_profile"
+	description       = "tfacc server profile"
+	template_name     = ome_template.profile_template.name
+	device_servicetag = "` + DeviceSvcTag1 + `"
+	attribute_overrides = {
+		"Time 1 Time Zone String" = "UTC"
+	}
+}
+`
+
+var testServerProfileNoTemplate = testProvider + `
+resource "ome_server_profile" "profile" {
+	name = "tfacc_server_profile"
+}
+`
+
+var testServerProfileSlotWithoutChassis = testProvider + `
+resource "ome_server_profile" "profile" {
+	name          = "tfacc_server_profile"
+	template_name = "tfacc_template"
+	slot_number   = 1
+}
+`
+
+var testServerProfileInvalidTemplate = testProvider + `
+resource "ome_server_profile" "profile" {
+	name          = "tfacc_server_profile"
+	template_name = "tfacc_invalid_template"
+}
+`
+
+var testServerProfileInvalidDevice = testProvider + testServerProfileTemplate + `
+resource "ome_server_profile" "profile" {
+	name              = "tfacc_server_profile"
+	template_name     = ome_template.profile_template.name
+	device_servicetag = "INVALID"
+}
+`
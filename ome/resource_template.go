@@ -20,16 +20,22 @@ import (
 	"strconv"
 	"strings"
 	"terraform-provider-ome/clients"
+	"terraform-provider-ome/helper"
 	"terraform-provider-ome/models"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -42,8 +48,6 @@ const (
 	ComplianceViewTypeID = 1
 	//DeploymentViewTypeID - stores the id for the deployment view type.
 	DeploymentViewTypeID = 2
-	//ChassisDeviceTypeID - stores the id for the Chassis device type type.
-	ChassisDeviceTypeID = 4
 	// RetryCount - stores the default value of retry count
 	RetryCount = 5
 	// SleepInterval - stores the default value of sleep interval
@@ -61,6 +65,7 @@ var (
 	_ resource.Resource                = &resourceTemplate{}
 	_ resource.ResourceWithConfigure   = &resourceTemplate{}
 	_ resource.ResourceWithImportState = &resourceTemplate{}
+	_ resource.ResourceWithModifyPlan  = &resourceTemplate{}
 )
 
 // NewTemplateResource is new resource for template
@@ -106,10 +111,12 @@ func (r *resourceTemplate) Schema(_ context.Context, _ resource.SchemaRequest, r
 			"fqdds": schema.StringAttribute{
 				MarkdownDescription: "Comma seperated values of components from a specified server." +
 					" Valid values are `iDRAC`, `System`, `BIOS`, `NIC`, `LifeCycleController`, `RAID`, `EventFilters` and `All`." +
+					" Several components are captured together, like `iDRAC,BIOS,RAID`, but a component cannot be repeated and `All` cannot be combined with other components." +
 					" Default value is `All`." +
 					" Cannot be updated.",
 				Description: "Comma seperated values of components from a specified server." +
 					" Valid values are 'iDRAC', 'System', 'BIOS', 'NIC', 'LifeCycleController', 'RAID', 'EventFilters' and 'All'." +
+					" Several components are captured together, like 'iDRAC,BIOS,RAID', but a component cannot be repeated and 'All' cannot be combined with other components." +
 					" Default value is 'All'." +
 					" Cannot be updated.",
 				Optional: true,
@@ -148,11 +155,15 @@ func (r *resourceTemplate) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Computed:            true,
 			},
 			"device_type": schema.StringAttribute{
-				MarkdownDescription: "OME template device type, supported types are Server, Chassis. Cannot be updated and is applicable only for importing xml." +
-					" Valid values are `Server` and `Chassis`." +
+				MarkdownDescription: "OME template device type, supported types are Server, Chassis and IO Module. Cannot be updated." +
+					" It is the type of the imported xml. When set for a template captured from a device, it must match the type of the reference device." +
+					" Identity pools and VLANs are only supported by the templates of servers." +
+					" Valid values are `Server`, `Chassis` and `IO Module`." +
 					" Default value is `Server`.",
-				Description: "OME template device type, supported types are Server, Chassis. Cannot be updated and is applicable only for importing xml." +
-					" Valid values are 'Server' and 'Chassis'." +
+				Description: "OME template device type, supported types are Server, Chassis and IO Module. Cannot be updated." +
+					" It is the type of the imported xml. When set for a template captured from a device, it must match the type of the reference device." +
+					" Identity pools and VLANs are only supported by the templates of servers." +
+					" Valid values are 'Server', 'Chassis' and 'IO Module'." +
 					" Default value is 'Server'.",
 				Optional: true,
 				Computed: true,
@@ -163,6 +174,7 @@ func (r *resourceTemplate) Schema(_ context.Context, _ resource.SchemaRequest, r
 					stringvalidator.OneOf(
 						"Server",
 						"Chassis",
+						"IO Module",
 					),
 				},
 			},
@@ -207,6 +219,9 @@ func (r *resourceTemplate) Schema(_ context.Context, _ resource.SchemaRequest, r
 				PlanModifiers: []planmodifier.List{
 					listplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.List{
+					listvalidator.ConflictsWith(path.MatchRoot("attribute_overrides")),
+				},
 				ElementType: types.ObjectType{
 					AttrTypes: map[string]attr.Type{
 						"attribute_id": types.Int64Type,
@@ -216,6 +231,75 @@ func (r *resourceTemplate) Schema(_ context.Context, _ resource.SchemaRequest, r
 					},
 				},
 			},
+			"attribute_overrides": schema.MapAttribute{
+				MarkdownDescription: "Values of the template attributes keyed by the path of their display names, for example `iDRAC,NIC Information,DNS Domain Name`." +
+					" The leading group names can be omitted as long as the path matches a single attribute of the template." +
+					" The attributes can also be keyed by their DMTF attribute name prefixed with the FQDD of their component, for example `iDRAC.Embedded.1#NIC.1#DNSDomainName`," +
+					" which is resolved against the template exported as a server configuration profile." +
+					" The overridden attributes are no longer ignored during the deployment and unknown paths are reported as errors." +
+					" The paths are resolved at plan time against the attributes of the template, or of `reftemplate_name` when the template is created." +
+					" The attributes of a template captured from a device or imported from `content` are only known once it is created," +
+					" its paths are resolved during the apply and the template is deleted when one of them is unknown." +
+					" Conflicts with `attributes`.",
+				Description: "Values of the template attributes keyed by the path of their display names, for example 'iDRAC,NIC Information,DNS Domain Name'." +
+					" The leading group names can be omitted as long as the path matches a single attribute of the template." +
+					" The attributes can also be keyed by their DMTF attribute name prefixed with the FQDD of their component, for example 'iDRAC.Embedded.1#NIC.1#DNSDomainName'," +
+					" which is resolved against the template exported as a server configuration profile." +
+					" The overridden attributes are no longer ignored during the deployment and unknown paths are reported as errors." +
+					" The paths are resolved at plan time against the attributes of the template, or of 'reftemplate_name' when the template is created." +
+					" The attributes of a template captured from a device or imported from 'content' are only known once it is created," +
+					" its paths are resolved during the apply and the template is deleted when one of them is unknown." +
+					" Conflicts with 'attributes'.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"sanitize": schema.BoolAttribute{
+				MarkdownDescription: "Whether the attributes holding values specific to the reference device, like the IP addresses, host names," +
+					" service tags, serial numbers, MAC addresses and WWNs, are ignored once the template is captured." +
+					" It is only supported when the template is created from a reference device." +
+					" Default value is `false`." +
+					" Cannot be updated.",
+				Description: "Whether the attributes holding values specific to the reference device, like the IP addresses, host names," +
+					" service tags, serial numbers, MAC addresses and WWNs, are ignored once the template is captured." +
+					" It is only supported when the template is created from a reference device." +
+					" Default value is 'false'." +
+					" Cannot be updated.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					BoolDefaultValue(types.BoolValue(false)),
+				},
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("reftemplate_name"), path.MatchRoot("content")),
+				},
+			},
+			"sanitize_deny_list": schema.SetAttribute{
+				MarkdownDescription: "Additional patterns of the attributes ignored by `sanitize`, matched against the path of their display names." +
+					" The names of a pattern can hold shell wildcards and its leading group names can be omitted," +
+					" for example `iDRAC,IPv4 Static Information,*` or `*Location*`." +
+					" Cannot be updated.",
+				Description: "Additional patterns of the attributes ignored by 'sanitize', matched against the path of their display names." +
+					" The names of a pattern can hold shell wildcards and its leading group names can be omitted," +
+					" for example 'iDRAC,IPv4 Static Information,*' or '*Location*'." +
+					" Cannot be updated.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"sanitized_attributes": schema.SetAttribute{
+				MarkdownDescription: "Paths of the attributes which were ignored by `sanitize` when the template was captured." +
+					" The attributes set by `attribute_overrides` are no longer ignored.",
+				Description: "Paths of the attributes which were ignored by 'sanitize' when the template was captured." +
+					" The attributes set by 'attribute_overrides' are no longer ignored.",
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"job_retry_count": schema.Int64Attribute{
 				MarkdownDescription: "Number of times the job has to be polled to get the final status of the resource." +
 					fmt.Sprintf(" Default value is `%d`.", RetryCount),
@@ -424,6 +508,24 @@ func (r *resourceTemplate) Create(ctx context.Context, req resource.CreateReques
 			)
 			return
 		}
+		// device_type defaults to Server, the reference device is only checked against the type set in the config
+		var configDeviceType types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("device_type"), &configDeviceType)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !configDeviceType.IsNull() {
+			refDevice, err := omeClient.GetDevice("", deviceID)
+			if err == nil {
+				err = helper.ValidateTemplateReferenceDevice(configDeviceType.ValueString(), refDevice)
+			}
+			if err != nil {
+				resp.Diagnostics.AddError(
+					clients.ErrCreateTemplate, err.Error(),
+				)
+				return
+			}
+		}
 
 		ct := models.CreateTemplate{
 			Fqdds:          strings.ReplaceAll(plan.FQDDS.ValueString(), " ", ""),
@@ -479,8 +581,54 @@ func (r *resourceTemplate) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	sanitizedAttributes := []string{}
+	if plan.Sanitize.ValueBool() {
+		tflog.Trace(ctx, "resource_template create: sanitizing template attributes")
+		omeAttributes, sanitizedAttributes, err = sanitizeTemplateAttributes(ctx, omeClient, plan, omeTemplateData, omeAttributes)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("sanitize_deny_list"),
+				clients.ErrCreateTemplate,
+				err.Error(),
+			)
+			_, err = omeClient.Delete(fmt.Sprintf(clients.TemplateAPI+"(%d)", omeTemplateData.ID), nil, nil)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					clients.ErrCreateTemplate,
+					err.Error(),
+				)
+			}
+			return
+		}
+	}
+	template.Sanitize = plan.Sanitize
+	template.SanitizeDenyList = plan.SanitizeDenyList
+	template.SanitizedAttributes, diags = types.SetValueFrom(ctx, types.StringType, sanitizedAttributes)
+	resp.Diagnostics.Append(diags...)
+
+	if !plan.AttributeOverrides.IsNull() {
+		tflog.Trace(ctx, "resource_template create: applying attribute overrides")
+		omeAttributes, err = applyAttributeOverrides(ctx, omeClient, plan, omeTemplateData, omeAttributes)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("attribute_overrides"),
+				clients.ErrCreateTemplate,
+				err.Error(),
+			)
+			_, err = omeClient.Delete(fmt.Sprintf(clients.TemplateAPI+"(%d)", omeTemplateData.ID), nil, nil)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					clients.ErrCreateTemplate,
+					err.Error(),
+				)
+			}
+			return
+		}
+	}
+	template.AttributeOverrides = plan.AttributeOverrides
+
 	tflog.Trace(ctx, "resource_template create: fetching template valn data")
-	omeVlan, err := omeClient.GetSchemaVlanData(templateID)
+	omeVlan, err := getTemplateVlanData(omeClient, omeTemplateData)
 	if err != nil {
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -622,7 +770,7 @@ func (r *resourceTemplate) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 	tflog.Trace(ctx, "resource_template read: fetching template vlan data")
 
-	omeVlan, err := omeClient.GetSchemaVlanData(templateID)
+	omeVlan, err := getTemplateVlanData(omeClient, omeTemplateData)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			clients.ErrReadTemplate,
@@ -651,6 +799,12 @@ func (r *resourceTemplate) Read(ctx context.Context, req resource.ReadRequest, r
 	stateVlan.VlanAttributes.ElementsAs(ctx, &vlanAttrs, true)
 
 	updateState(&template, vlanAttrs, &omeTemplateData, omeAttributes, omeVlan)
+	template.AttributeOverrides = getRefreshedAttributeOverrides(ctx, omeClient, omeTemplateData.ID, template.AttributeOverrides, omeAttributes)
+	// the templates created by the previous versions of the provider were not sanitized
+	if template.Sanitize.IsNull() {
+		template.Sanitize = types.BoolValue(false)
+		template.SanitizedAttributes = types.SetValueMust(types.StringType, []attr.Value{})
+	}
 
 	tflog.Trace(ctx, "resource_template read: updating state finished")
 
@@ -688,7 +842,7 @@ func (r resourceTemplate) Update(ctx context.Context, req resource.UpdateRequest
 	if isConfigValuesChanged(planTemplate, stateTemplate) {
 		resp.Diagnostics.AddError(
 			clients.ErrUpdateTemplate,
-			"cannot update the following fields : `refdevice_servicetag`,`refdevice_id`,`view_type`, `reftemplate_name`, `content` and `fqdds`",
+			"cannot update the following fields : `refdevice_servicetag`,`refdevice_id`,`view_type`, `reftemplate_name`, `content`, `fqdds`, `sanitize` and `sanitize_deny_list`",
 		)
 		return
 	}
@@ -719,6 +873,14 @@ func (r resourceTemplate) Update(ctx context.Context, req resource.UpdateRequest
 		}
 	}
 	planVlan := getVlanForTemplate(ctx, resp, planTemplate)
+	if !strings.EqualFold(stateTemplate.DeviceType.ValueString(), helper.TemplateTypeName(helper.ServerTemplateTypeID)) &&
+		(planTemplate.IdentityPoolName.ValueString() != "" || (!planTemplate.Vlan.IsUnknown() && len(planVlan.OMEVlanAttributes) > 0)) {
+		resp.Diagnostics.AddError(
+			clients.ErrUpdateTemplate,
+			fmt.Sprintf("identity_pool_name and vlan are only supported by the templates of servers, not by the %s templates", stateTemplate.DeviceType.ValueString()),
+		)
+		return
+	}
 	if !planTemplate.Vlan.IsUnknown() && len(planVlan.OMEVlanAttributes) > 0 {
 		err := validateVlanNetworkData(omeClient, templateID, planVlan)
 		if err != nil {
@@ -744,7 +906,16 @@ func (r resourceTemplate) Update(ctx context.Context, req resource.UpdateRequest
 	stateAttributes := getTfsdkStateAttributes(ctx, stateTemplate)
 	// Terraform compares the list elements based on order, hence it is expected that the practitioner gives all attributes
 	// along with the attribute for which modification is expected.
-	da, deltaError := getDeltaAttributes(ctx, planTemplate, stateAttributes)
+	var (
+		da         []models.UpdateAttribute
+		deltaError error
+	)
+	if planTemplate.AttributeOverrides.IsNull() {
+		da, deltaError = getDeltaAttributes(ctx, planTemplate, stateAttributes)
+	} else {
+		// the overridden attributes are no longer ignored, the attributes resolved at plan time are updated when their ignored flag changes as well
+		da = helper.GetTemplateAttributeUpdates(getOmeAttributes(stateAttributes), getOmeAttributes(getTfsdkStateAttributes(ctx, planTemplate)))
+	}
 	if deltaError != nil {
 		resp.Diagnostics.AddError(
 			clients.ErrUpdateTemplate, deltaError.Error(),
@@ -872,7 +1043,7 @@ func (r resourceTemplate) Update(ctx context.Context, req resource.UpdateRequest
 
 	tflog.Trace(ctx, "resource_template update: fetching vlan data")
 
-	updatedVlan, err := omeClient.GetSchemaVlanData(templateID)
+	updatedVlan, err := getTemplateVlanData(omeClient, omeTemplateData)
 	if err != nil {
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -914,6 +1085,9 @@ func (r resourceTemplate) Update(ctx context.Context, req resource.UpdateRequest
 	tfsdkVlan.VlanAttributes.ElementsAs(ctx, &vlanAttrs, true)
 
 	updateState(&stateTemplate, vlanAttrs, &omeTemplateData, omeAttributes, updatedVlan)
+	stateTemplate.AttributeOverrides = planTemplate.AttributeOverrides
+	stateTemplate.Sanitize = planTemplate.Sanitize
+	stateTemplate.SanitizeDenyList = planTemplate.SanitizeDenyList
 
 	tflog.Trace(ctx, "resource_template update: updating state data finished")
 	//Save into State if template update is successful
@@ -997,7 +1171,7 @@ func (r resourceTemplate) ImportState(ctx context.Context, req resource.ImportSt
 		)
 		return
 	}
-	omeVlan, err := omeClient.GetSchemaVlanData(omeTemplateData.ID)
+	omeVlan, err := getTemplateVlanData(omeClient, omeTemplateData)
 	if err != nil {
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -1017,20 +1191,19 @@ func (r resourceTemplate) ImportState(ctx context.Context, req resource.ImportSt
 		viewType = "Compliance"
 	}
 
-	deviceType := "Server"
-	if omeTemplateData.TypeID == ChassisDeviceTypeID {
-		deviceType = "Chassis"
-	}
-
 	template.RefdeviceID = types.Int64Value(omeTemplateData.SourceDeviceID)
 	template.RefdeviceServicetag = types.StringValue("NA")
 	template.ReftemplateName = types.StringValue("NA")
 	template.Content = types.StringValue("NA")
 	template.ViewType = types.StringValue(viewType)
-	template.DeviceType = types.StringValue(deviceType)
+	template.DeviceType = types.StringValue(helper.TemplateTypeName(omeTemplateData.TypeID))
 	template.JobRetryCount = types.Int64Value(RetryCount)
 	template.SleepInterval = types.Int64Value(SleepInterval)
 	template.FQDDS = types.StringValue("All")
+	template.AttributeOverrides = types.MapNull(types.StringType)
+	template.Sanitize = types.BoolValue(false)
+	template.SanitizeDenyList = types.SetNull(types.StringType)
+	template.SanitizedAttributes = types.SetValueMust(types.StringType, []attr.Value{})
 	diags := resp.State.Set(ctx, &template)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -1039,6 +1212,122 @@ func (r resourceTemplate) ImportState(ctx context.Context, req resource.ImportSt
 	tflog.Trace(ctx, "resource_template import: finished")
 }
 
+// ModifyPlan resolves the attribute overrides against the attributes of the template, so that the plan only shows the attributes that change
+func (r resourceTemplate) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	if req.State.Raw.IsNull() {
+		var plan models.Template
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		r.checkReferenceTemplateAttributeOverrides(ctx, plan, resp)
+		return
+	}
+	var plan, state models.Template
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	overrides, known := getAttributeOverrides(ctx, plan.AttributeOverrides)
+	if !known || len(overrides) == 0 {
+		return
+	}
+
+	tflog.Trace(ctx, "resource_template modify plan: resolving attribute overrides")
+	stateAttributes := getOmeAttributes(getTfsdkStateAttributes(ctx, state))
+	names, err := r.resolveDMTFAttributeNames(ctx, state, stateAttributes, overrides)
+	var attributes []models.OmeAttribute
+	if err == nil {
+		attributes, err = helper.OverrideTemplateAttributes(stateAttributes, overrides, names)
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("attribute_overrides"),
+			clients.ErrTemplateAttributeOverrides,
+			err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("attributes"), getTfsdkAttributes(attributes))...)
+}
+
+// resolveDMTFAttributeNames resolves the DMTF names of the attribute overrides of an existing template,
+// the appliance is only queried when some overrides are keyed by DMTF names
+func (r resourceTemplate) resolveDMTFAttributeNames(ctx context.Context, state models.Template, attributes []models.OmeAttribute,
+	overrides map[string]string) (map[string]string, error) {
+	hasDMTFNames := false
+	for key := range overrides {
+		hasDMTFNames = hasDMTFNames || helper.IsDMTFAttributeName(key)
+	}
+	if !hasDMTFNames {
+		return nil, nil
+	}
+	if r.p == nil || !r.p.configured {
+		return nil, fmt.Errorf("the DMTF attribute names cannot be resolved before the provider is configured")
+	}
+	templateID, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	omeClient, d := r.p.createOMESession(ctx, "resource_template ModifyPlan")
+	if d.HasError() {
+		return nil, fmt.Errorf("unable to create a session to resolve the DMTF attribute names")
+	}
+	defer omeClient.RemoveSession()
+	return helper.ResolveDMTFAttributeNames(omeClient, templateID, attributes, overrides)
+}
+
+// checkReferenceTemplateAttributeOverrides resolves the attribute overrides of a new template against the attributes of its reference template,
+// the attributes of a template captured from a device or imported from xml are only known once it is created
+func (r resourceTemplate) checkReferenceTemplateAttributeOverrides(ctx context.Context, plan models.Template, resp *resource.ModifyPlanResponse) {
+	overrides, known := getAttributeOverrides(ctx, plan.AttributeOverrides)
+	if r.p == nil || !r.p.configured || !known || len(overrides) == 0 ||
+		plan.ReftemplateName.IsUnknown() || plan.ReftemplateName.ValueString() == "" {
+		return
+	}
+
+	omeClient, d := r.p.createOMESession(ctx, "resource_template ModifyPlan")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	refTemplate, err := omeClient.GetTemplateByName(plan.ReftemplateName.ValueString())
+	if err == nil && refTemplate.ID == 0 {
+		err = fmt.Errorf("reference template %s not found", plan.ReftemplateName.ValueString())
+	}
+	var attributes []models.OmeAttribute
+	if err == nil {
+		attributes, err = omeClient.GetTemplateAttributes(refTemplate.ID, []models.Attribute{}, true)
+	}
+	if err != nil {
+		// the reference template may be created during the apply
+		tflog.Debug(ctx, "resource_template modify plan: the attribute overrides are resolved during the apply", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	tflog.Trace(ctx, "resource_template modify plan: resolving attribute overrides against the reference template")
+	names, err := helper.ResolveDMTFAttributeNames(omeClient, refTemplate.ID, attributes, overrides)
+	if err == nil {
+		_, err = helper.OverrideTemplateAttributes(attributes, overrides, names)
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("attribute_overrides"),
+			clients.ErrTemplateAttributeOverrides,
+			err.Error(),
+		)
+	}
+}
+
 func validateCreate(plan models.Template) error {
 	// all references cannot be empty
 	if plan.ReftemplateName.ValueString() == "" && plan.RefdeviceID.ValueInt64() == 0 && plan.RefdeviceServicetag.ValueString() == "" && plan.Content.ValueString() == "" {
@@ -1098,7 +1387,9 @@ func isConfigValuesChanged(planTemplate, stateTemplate models.Template) bool {
 		(!planTemplate.ViewType.IsUnknown() && stateTemplate.ViewType.ValueString() != planTemplate.ViewType.ValueString()) ||
 		(!planTemplate.FQDDS.IsUnknown() && stateTemplate.FQDDS.ValueString() != planTemplate.FQDDS.ValueString()) ||
 		(!planTemplate.ReftemplateName.IsUnknown() && stateTemplate.ReftemplateName.ValueString() != planTemplate.ReftemplateName.ValueString()) ||
-		(!planTemplate.Content.IsUnknown() && stateTemplate.Content.ValueString() != planTemplate.Content.ValueString())
+		(!planTemplate.Content.IsUnknown() && stateTemplate.Content.ValueString() != planTemplate.Content.ValueString()) ||
+		(!planTemplate.Sanitize.IsUnknown() && stateTemplate.Sanitize.ValueBool() != planTemplate.Sanitize.ValueBool()) ||
+		(!planTemplate.SanitizeDenyList.IsUnknown() && !stateTemplate.SanitizeDenyList.Equal(planTemplate.SanitizeDenyList))
 }
 
 func validateVlan(planVlan, remoteVlan models.OMEVlan, vlanNetworks []models.VLanNetworks) error {
@@ -1289,33 +1580,7 @@ func updateState(stateTemplate *models.Template, planVlanAttributes []models.Vla
 	stateTemplate.ViewTypeID = types.Int64Value(omeTemplateData.ViewTypeID)
 	stateTemplate.IdentityPoolID = types.Int64Value(omeTemplateData.IdentityPoolID)
 
-	attributeObjects := []attr.Value{}
-
-	for _, attribute := range omeTemplateAttributes {
-		attributeDetails := map[string]attr.Value{}
-		attributeDetails["attribute_id"] = types.Int64Value(attribute.AttributeID)
-		attributeDetails["display_name"] = types.StringValue(attribute.DisplayName)
-		attributeDetails["value"] = types.StringValue(attribute.Value)
-		attributeDetails["is_ignored"] = types.BoolValue(attribute.IsIgnored)
-		attributeObject, _ := types.ObjectValue(
-			map[string]attr.Type{
-				"attribute_id": types.Int64Type,
-				"display_name": types.StringType,
-				"value":        types.StringType,
-				"is_ignored":   types.BoolType,
-			}, attributeDetails)
-		attributeObjects = append(attributeObjects, attributeObject)
-	}
-	attributesTfsdk, _ := types.ListValue(
-		types.ObjectType{
-			AttrTypes: map[string]attr.Type{
-				"attribute_id": types.Int64Type,
-				"display_name": types.StringType,
-				"value":        types.StringType,
-				"is_ignored":   types.BoolType,
-			},
-		}, attributeObjects)
-	stateTemplate.Attributes = attributesTfsdk
+	stateTemplate.Attributes = getTfsdkAttributes(omeTemplateAttributes)
 
 	omeVlanMap := map[string]models.OMEVlanAttribute{}
 
@@ -1411,3 +1676,139 @@ func getVlanAtrrObject(omeVlanAttr models.OMEVlanAttribute) types.Object {
 		}, vlanAttrMap)
 	return vlanAttrObject
 }
+
+// getAttributeOverrides returns the attribute overrides and whether all of them are known
+func getAttributeOverrides(ctx context.Context, attributeOverrides types.Map) (map[string]string, bool) {
+	if attributeOverrides.IsNull() || attributeOverrides.IsUnknown() {
+		return nil, !attributeOverrides.IsUnknown()
+	}
+	values := map[string]types.String{}
+	attributeOverrides.ElementsAs(ctx, &values, false)
+	overrides := map[string]string{}
+	for key, value := range values {
+		if value.IsUnknown() {
+			return nil, false
+		}
+		overrides[key] = value.ValueString()
+	}
+	return overrides, true
+}
+
+// getTemplateVlanData returns the vlan data of a server template, the chassis and IOM templates have no NIC to attach networks to
+func getTemplateVlanData(omeClient *clients.Client, omeTemplateData models.OMETemplate) (models.OMEVlan, error) {
+	if omeTemplateData.TypeID != 0 && omeTemplateData.TypeID != helper.ServerTemplateTypeID {
+		return models.OMEVlan{}, nil
+	}
+	return omeClient.GetSchemaVlanData(omeTemplateData.ID)
+}
+
+// applyAttributeOverrides updates the attributes of a newly created template with the attribute overrides and returns the refreshed attributes
+func applyAttributeOverrides(ctx context.Context, omeClient *clients.Client, plan models.Template, omeTemplateData models.OMETemplate, omeAttributes []models.OmeAttribute) ([]models.OmeAttribute, error) {
+	overrides, _ := getAttributeOverrides(ctx, plan.AttributeOverrides)
+	names, err := helper.ResolveDMTFAttributeNames(omeClient, omeTemplateData.ID, omeAttributes, overrides)
+	if err != nil {
+		return nil, err
+	}
+	attributes, err := helper.OverrideTemplateAttributes(omeAttributes, overrides, names)
+	if err != nil {
+		return nil, err
+	}
+	return saveTemplateAttributes(omeClient, omeTemplateData, omeAttributes, attributes)
+}
+
+// sanitizeTemplateAttributes ignores the attributes of a newly created template which match the deny lists,
+// and returns the refreshed attributes and the paths of the ignored attributes
+func sanitizeTemplateAttributes(ctx context.Context, omeClient *clients.Client, plan models.Template, omeTemplateData models.OMETemplate,
+	omeAttributes []models.OmeAttribute) ([]models.OmeAttribute, []string, error) {
+	denyList := []string{}
+	plan.SanitizeDenyList.ElementsAs(ctx, &denyList, true)
+	attributes, sanitized, err := helper.SanitizeTemplateAttributes(omeAttributes, denyList)
+	if err != nil {
+		return nil, nil, err
+	}
+	tflog.Debug(ctx, "resource_template create: sanitized attributes", map[string]interface{}{"attributes": sanitized})
+	omeAttributes, err = saveTemplateAttributes(omeClient, omeTemplateData, omeAttributes, attributes)
+	if err != nil {
+		return nil, nil, err
+	}
+	return omeAttributes, sanitized, nil
+}
+
+// saveTemplateAttributes updates the attributes of the template which differ from its current attributes and returns the refreshed attributes
+func saveTemplateAttributes(omeClient *clients.Client, omeTemplateData models.OMETemplate, omeAttributes, attributes []models.OmeAttribute) ([]models.OmeAttribute, error) {
+	updatedAttributes := helper.GetTemplateAttributeUpdates(omeAttributes, attributes)
+	if len(updatedAttributes) == 0 {
+		return omeAttributes, nil
+	}
+	err := omeClient.UpdateTemplate(models.UpdateTemplate{
+		ID:          omeTemplateData.ID,
+		Name:        omeTemplateData.Name,
+		Description: omeTemplateData.Description,
+		Attributes:  updatedAttributes,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return omeClient.GetTemplateAttributes(omeTemplateData.ID, []models.Attribute{}, true)
+}
+
+// getRefreshedAttributeOverrides returns the attribute overrides with the current values of the template attributes
+// The overrides keyed by DMTF names which cannot be resolved keep their value
+func getRefreshedAttributeOverrides(ctx context.Context, omeClient *clients.Client, templateID int64, attributeOverrides types.Map,
+	omeAttributes []models.OmeAttribute) types.Map {
+	overrides, known := getAttributeOverrides(ctx, attributeOverrides)
+	if !known || attributeOverrides.IsNull() {
+		return attributeOverrides
+	}
+	names, err := helper.ResolveDMTFAttributeNames(omeClient, templateID, omeAttributes, overrides)
+	if err != nil {
+		tflog.Debug(ctx, "resource_template read: the DMTF attribute names are not resolved", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+	refreshed, _ := types.MapValueFrom(ctx, types.StringType, helper.RefreshTemplateAttributeOverrides(omeAttributes, overrides, names))
+	return refreshed
+}
+
+func getOmeAttributes(attributes []models.Attribute) []models.OmeAttribute {
+	omeAttributes := []models.OmeAttribute{}
+	for _, attribute := range attributes {
+		omeAttributes = append(omeAttributes, models.OmeAttribute{
+			AttributeID: attribute.AttributeID.ValueInt64(),
+			DisplayName: attribute.DisplayName.ValueString(),
+			Value:       attribute.Value.ValueString(),
+			IsIgnored:   attribute.IsIgnored.ValueBool(),
+		})
+	}
+	return omeAttributes
+}
+
+func getTfsdkAttributes(omeTemplateAttributes []models.OmeAttribute) types.List {
+	attributeObjects := []attr.Value{}
+
+	for _, attribute := range omeTemplateAttributes {
+		attributeDetails := map[string]attr.Value{}
+		attributeDetails["attribute_id"] = types.Int64Value(attribute.AttributeID)
+		attributeDetails["display_name"] = types.StringValue(attribute.DisplayName)
+		attributeDetails["value"] = types.StringValue(attribute.Value)
+		attributeDetails["is_ignored"] = types.BoolValue(attribute.IsIgnored)
+		attributeObject, _ := types.ObjectValue(
+			map[string]attr.Type{
+				"attribute_id": types.Int64Type,
+				"display_name": types.StringType,
+				"value":        types.StringType,
+				"is_ignored":   types.BoolType,
+			}, attributeDetails)
+		attributeObjects = append(attributeObjects, attributeObject)
+	}
+	attributesTfsdk, _ := types.ListValue(
+		types.ObjectType{
+			AttrTypes: map[string]attr.Type{
+				"attribute_id": types.Int64Type,
+				"display_name": types.StringType,
+				"value":        types.StringType,
+				"is_ignored":   types.BoolType,
+			},
+		}, attributeObjects)
+	return attributesTfsdk
+}
@@ -14,6 +14,7 @@ limitations under the License.
 package ome
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"os"
@@ -23,6 +24,7 @@ import (
 	"terraform-provider-ome/models"
 	"testing"
 
+	. "github.com/bytedance/mockey"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -338,6 +340,324 @@ func TestTemplateCreation_CreateAndUpdateTemplateSuccess_UT(t *testing.T) {
 	})
 }
 
+func TestTemplateCreation_AttributeOverrides(t *testing.T) {
+	if os.Getenv("TF_ACC") == "0" {
+		t.Skip("Dont run with units tests, only for Acceptance Test case")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateAttributeOverrides("IST", "Enabled"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ome_template.terraform-acceptance-test-overrides", "name", TemplateName1),
+					resource.TestCheckResourceAttr("ome_template.terraform-acceptance-test-overrides", "attribute_overrides.%", "2"),
+					resource.TestCheckResourceAttr("ome_template.terraform-acceptance-test-overrides", "attribute_overrides.Time 1 Time Zone String", "IST"),
+					resource.TestCheckTypeSetElemNestedAttrs("ome_template.terraform-acceptance-test-overrides", "attributes.*", map[string]string{
+						"display_name": "iDRAC,Time Zone Configuration Information,Time 1 Time Zone String",
+						"value":        "IST",
+						"is_ignored":   "false",
+					}),
+				),
+			},
+			{
+				Config: testAccTemplateAttributeOverrides("CST6CDT", "Enabled"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ome_template.terraform-acceptance-test-overrides", "attribute_overrides.Time 1 Time Zone String", "CST6CDT"),
+					resource.TestCheckTypeSetElemNestedAttrs("ome_template.terraform-acceptance-test-overrides", "attributes.*", map[string]string{
+						"display_name": "iDRAC,Time Zone Configuration Information,Time 1 Time Zone String",
+						"value":        "CST6CDT",
+					}),
+				),
+			},
+			// the DMTF names are resolved against the template exported as a server configuration profile
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock((*clients.Client).ExportTemplate).To(mockExportTemplateProfile(-1)).Build()
+				},
+				Config:      testAccTemplateDMTFAttributeOverrides("iDRAC.Embedded.1#Time.1#Unknown", "UTC"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`.*attribute iDRAC.Embedded.1#Time.1#Unknown does not exist in the template.*`),
+			},
+			// the names are not resolved when the attributes of the profile do not match the attributes of the template
+			{
+				PreConfig: func() {
+					FunctionMocker.UnPatch()
+					FunctionMocker = Mock((*clients.Client).ExportTemplate).To(mockExportTemplateProfile(0)).Build()
+				},
+				Config:      testAccTemplateDMTFAttributeOverrides("iDRAC.Embedded.1#Time.1#Timezone", "UTC"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`.*the DMTF attribute names cannot be resolved.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.UnPatch()
+					FunctionMocker = Mock((*clients.Client).ExportTemplate).To(mockExportTemplateProfile(-1)).Build()
+				},
+				Config: testAccTemplateDMTFAttributeOverrides("iDRAC.Embedded.1#Time.1#Timezone", "UTC"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ome_template.terraform-acceptance-test-overrides", "attribute_overrides.iDRAC.Embedded.1#Time.1#Timezone", "UTC"),
+					resource.TestCheckTypeSetElemNestedAttrs("ome_template.terraform-acceptance-test-overrides", "attributes.*", map[string]string{
+						"display_name": "iDRAC,Time Zone Configuration Information,Time 1 Time Zone String",
+						"value":        "UTC",
+						"is_ignored":   "false",
+					}),
+				),
+			},
+			// the overrides of a cloned template are resolved at plan time against the reference template
+			{
+				PreConfig: func() {
+					FunctionMocker.UnPatch()
+				},
+				Config:      testAccTemplateAttributeOverrides("CST6CDT", "Enabled") + testAccTemplateAttributeOverridesCloneUnknownPath,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`.*attribute iDRAC,Invalid Group,Invalid Attribute does not exist in the template.*`),
+			},
+			{
+				Config:      testAccTemplateAttributeOverridesUnknownPath,
+				ExpectError: regexp.MustCompile(clients.ErrTemplateAttributeOverrides),
+			},
+			{
+				Config:      testAccTemplateAttributeOverridesWithAttributes,
+				ExpectError: regexp.MustCompile(".*Invalid Attribute Combination.*"),
+			},
+		},
+	})
+}
+
+func TestTemplateCreation_Sanitize(t *testing.T) {
+	if os.Getenv("TF_ACC") == "0" {
+		t.Skip("Dont run with units tests, only for Acceptance Test case")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTemplateSanitize(`"iDRAC,All"`, `"*Location*"`),
+				ExpectError: regexp.MustCompile(`.*All already captures every component.*`),
+			},
+			{
+				Config:      testAccTemplateSanitize(`"iDRAC,BIOS,idrac"`, `"*Location*"`),
+				ExpectError: regexp.MustCompile(`.*component idrac is repeated.*`),
+			},
+			{
+				Config:      testAccTemplateSanitizeWithReferenceTemplate,
+				ExpectError: regexp.MustCompile(".*Invalid Attribute Combination.*"),
+			},
+			{
+				Config:      testAccTemplateSanitize(`"iDRAC,System"`, `"[invalid"`),
+				ExpectError: regexp.MustCompile(`.*invalid sanitize pattern.*`),
+			},
+			{
+				Config: testAccTemplateSanitize(`"iDRAC,System"`, `"*Location*"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ome_template.terraform-acceptance-test-sanitize", "sanitize", "true"),
+					resource.TestCheckResourceAttrSet("ome_template.terraform-acceptance-test-sanitize", "sanitized_attributes.#"),
+					resource.TestCheckTypeSetElemNestedAttrs("ome_template.terraform-acceptance-test-sanitize", "attributes.*", map[string]string{
+						"display_name": "iDRAC,NIC Information,DNS RAC Name",
+						"is_ignored":   "true",
+					}),
+				),
+			},
+			{
+				Config:      testAccTemplateSanitize(`"iDRAC,System"`, `"*Rack*"`),
+				ExpectError: regexp.MustCompile(clients.ErrUpdateTemplate),
+			},
+		},
+	})
+}
+
+func TestTemplateCreation_ChassisTemplate(t *testing.T) {
+	if os.Getenv("TF_ACC") == "0" {
+		t.Skip("Dont run with units tests, only for Acceptance Test case")
+	}
+	skipWithoutChassis := func() (bool, error) {
+		if ChassisSvcTag1 == "" {
+			t.Log("Skipping as CHASSISSVCTAG1 is not set")
+			return true, nil
+		}
+		return false, nil
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccChassisTemplate("Chassis", DeviceSvcTag1, ""),
+				ExpectError: regexp.MustCompile(`.*is not a Chassis, set device_type to Server.*`),
+			},
+			{
+				Config:      testAccChassisTemplate("Storage", DeviceSvcTag1, ""),
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Value Match.*`),
+			},
+			{
+				SkipFunc: skipWithoutChassis,
+				Config:   testAccChassisTemplate("Chassis", ChassisSvcTag1, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ome_template.terraform-acceptance-test-chassis", "device_type", "Chassis"),
+					resource.TestCheckResourceAttrSet("ome_template.terraform-acceptance-test-chassis", "attributes.#"),
+				),
+			},
+			{
+				SkipFunc:    skipWithoutChassis,
+				Config:      testAccChassisTemplate("Chassis", ChassisSvcTag1, `identity_pool_name = "IO1"`),
+				ExpectError: regexp.MustCompile(`.*only supported by the templates of servers.*`),
+			},
+		},
+	})
+}
+
+func TestTemplateCreation_ChassisReferenceDeviceWithoutDeviceType(t *testing.T) {
+	if os.Getenv("TF_ACC") == "0" {
+		t.Skip("Dont run with units tests, only for Acceptance Test case")
+	}
+	if ChassisSvcTag1 == "" {
+		t.Skip("Skipping as CHASSISSVCTAG1 is not set")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// the reference device is not checked against the default device_type
+			{
+				Config: testAccChassisTemplate("", ChassisSvcTag1, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ome_template.terraform-acceptance-test-chassis", "device_type", "Server"),
+					resource.TestCheckResourceAttrSet("ome_template.terraform-acceptance-test-chassis", "attributes.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccChassisTemplate(deviceType, serviceTag, extra string) string {
+	if deviceType != "" {
+		extra = `device_type = "` + deviceType + `"
+		` + extra
+	}
+	return testProvider + `
+	resource "ome_template" "terraform-acceptance-test-chassis" {
+		name = "` + TemplateName1 + `"
+		refdevice_servicetag = "` + serviceTag + `"
+		` + extra + `
+	}
+`
+}
+
+func testAccTemplateSanitize(fqdds, denyList string) string {
+	return testProvider + `
+	resource "ome_template" "terraform-acceptance-test-sanitize" {
+		name = "` + TemplateName1 + `"
+		refdevice_servicetag = "` + DeviceSvcTag1 + `"
+		fqdds = ` + fqdds + `
+		sanitize = true
+		sanitize_deny_list = [` + denyList + `]
+	}
+`
+}
+
+var testAccTemplateSanitizeWithReferenceTemplate = testProvider + `
+	resource "ome_template" "terraform-acceptance-test-sanitize" {
+		name = "` + TemplateName1 + `"
+		reftemplate_name = "` + ReferenceDeploymentTemplateNameForClone + `"
+		sanitize = true
+	}
+`
+
+func testAccTemplateAttributeOverrides(timeZone, ioidOpt string) string {
+	return testProvider + `
+	resource "ome_template" "terraform-acceptance-test-overrides" {
+		name = "` + TemplateName1 + `"
+		refdevice_servicetag = "` + DeviceSvcTag1 + `"
+		fqdds = "iDRAC"
+		attribute_overrides = {
+			"Time 1 Time Zone String" = "` + timeZone + `"
+			"iDRAC,IO Identity Optimization,IOIDOpt 1 IOIDOpt Enable" = "` + ioidOpt + `"
+		}
+	}
+`
+}
+
+func testAccTemplateDMTFAttributeOverrides(name, timeZone string) string {
+	return testProvider + `
+	resource "ome_template" "terraform-acceptance-test-overrides" {
+		name = "` + TemplateName1 + `"
+		refdevice_servicetag = "` + DeviceSvcTag1 + `"
+		fqdds = "iDRAC"
+		attribute_overrides = {
+			"` + name + `" = "` + timeZone + `"
+			"iDRAC,IO Identity Optimization,IOIDOpt 1 IOIDOpt Enable" = "Enabled"
+		}
+	}
+`
+}
+
+// mockExportTemplateProfile exports the attributes of the template as a server configuration profile in the json format,
+// the time zone attribute is named after its DMTF name and the value of the attribute at the mismatch position, if any, is changed
+func mockExportTemplateProfile(mismatch int) func(*clients.Client, int64, string) (string, error) {
+	return func(c *clients.Client, templateID int64, _ string) (string, error) {
+		attributes, err := c.GetTemplateAttributes(templateID, []models.Attribute{}, true)
+		if err != nil {
+			return "", err
+		}
+		component := models.OMESystemConfigurationComponent{FQDD: "iDRAC.Embedded.1"}
+		for i, attribute := range attributes {
+			name := fmt.Sprintf("Attribute.%d#Value", i)
+			if attribute.DisplayName == "iDRAC,Time Zone Configuration Information,Time 1 Time Zone String" {
+				name = "Time.1#Timezone"
+			}
+			value := attribute.Value
+			if i == mismatch {
+				value += "-changed"
+			}
+			component.Attributes = append(component.Attributes, models.OMESystemConfigurationAttribute{Name: name, Value: value})
+		}
+		content, err := json.Marshal(models.OMESystemConfiguration{
+			SystemConfiguration: models.OMESystemConfigurationComponent{Components: []models.OMESystemConfigurationComponent{component}},
+		})
+		return string(content), err
+	}
+}
+
+var testAccTemplateAttributeOverridesUnknownPath = testProvider + `
+	resource "ome_template" "terraform-acceptance-test-overrides" {
+		name = "` + TemplateName1 + `"
+		refdevice_servicetag = "` + DeviceSvcTag1 + `"
+		fqdds = "iDRAC"
+		attribute_overrides = {
+			"iDRAC,Invalid Group,Invalid Attribute" = "invalid"
+		}
+	}
+`
+
+var testAccTemplateAttributeOverridesCloneUnknownPath = `
+	resource "ome_template" "terraform-acceptance-test-overrides-clone" {
+		name = "` + TemplateName2 + `"
+		reftemplate_name = "` + TemplateName1 + `"
+		attribute_overrides = {
+			"iDRAC,Invalid Group,Invalid Attribute" = "invalid"
+		}
+	}
+`
+
+var testAccTemplateAttributeOverridesWithAttributes = testProvider + `
+	resource "ome_template" "terraform-acceptance-test-overrides" {
+		name = "` + TemplateName1 + `"
+		refdevice_servicetag = "` + DeviceSvcTag1 + `"
+		fqdds = "iDRAC"
+		attributes = []
+		attribute_overrides = {
+			"Time 1 Time Zone String" = "IST"
+		}
+	}
+`
+
 var testAccCreateTemplateForClone = testProvider + `
 	resource "ome_template" "terraform-acceptance-test-1" {
 		name = "` + ReferenceDeploymentTemplateNameForClone + `"
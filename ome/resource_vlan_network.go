@@ -0,0 +1,271 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"strconv"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/helper"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &vlanNetworkResource{}
+	_ resource.ResourceWithConfigure      = &vlanNetworkResource{}
+	_ resource.ResourceWithImportState    = &vlanNetworkResource{}
+	_ resource.ResourceWithValidateConfig = &vlanNetworkResource{}
+)
+
+// NewVlanNetworkResource is a helper function to simplify the provider implementation.
+func NewVlanNetworkResource() resource.Resource {
+	return &vlanNetworkResource{}
+}
+
+// vlanNetworkResource is the resource implementation.
+type vlanNetworkResource struct {
+	p *omeProvider
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *vlanNetworkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.p = req.ProviderData.(*omeProvider)
+}
+
+// Metadata returns the resource type name.
+func (r *vlanNetworkResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "vlan_network"
+}
+
+// Schema defines the schema for the resource.
+func (r *vlanNetworkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This terraform resource is used to manage the VLAN networks defined on OME." +
+			" The VLAN networks can be referenced in the `vlan` block of `ome_template`." +
+			" We can Create, Update and Delete OME VLAN networks using this resource. We can also 'Import' existing 'VLAN networks' from OME by id or by name.",
+		Version:    1,
+		Attributes: VlanNetworkSchema(),
+	}
+}
+
+// ValidateConfig validates the vlan network configuration.
+func (r *vlanNetworkResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data models.OmeVlanNetwork
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := helper.ValidateVlanNetwork(data); err != nil {
+		resp.Diagnostics.AddError(
+			"Attribute Error",
+			err.Error(),
+		)
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *vlanNetworkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "resource_vlan_network create: started")
+	var plan models.OmeVlanNetwork
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_vlan_network Create")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	if err := helper.CheckVlanNetworkOverlap(omeClient, 0, plan.VLANMinimum.ValueInt64(), plan.VLANMaximum.ValueInt64()); err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrCreateVlanNetwork, err.Error())
+		return
+	}
+	networkTypes, err := helper.GetVlanNetworkTypes(omeClient)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrCreateVlanNetwork, err.Error())
+		return
+	}
+	payload, err := helper.MakeVlanNetworkPayload(plan, 0, networkTypes)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrCreateVlanNetwork, err.Error())
+		return
+	}
+	network, err := helper.CreateVlanNetwork(omeClient, payload)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrCreateVlanNetwork, err.Error())
+		return
+	}
+
+	state := helper.SetStateVlanNetwork(network, networkTypes, plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "resource_vlan_network create: finished")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *vlanNetworkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "resource_vlan_network read: started")
+	var state models.OmeVlanNetwork
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_vlan_network Read")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	network, err := helper.GetVlanNetwork(omeClient, state.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrReadVlanNetwork, err.Error())
+		return
+	}
+	networkTypes, err := helper.GetVlanNetworkTypes(omeClient)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrReadVlanNetwork, err.Error())
+		return
+	}
+
+	state = helper.SetStateVlanNetwork(network, networkTypes, state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "resource_vlan_network read: finished")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *vlanNetworkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "resource_vlan_network update: started")
+	var state, plan models.OmeVlanNetwork
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_vlan_network Update")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	if err := helper.CheckVlanNetworkOverlap(omeClient, state.ID.ValueInt64(), plan.VLANMinimum.ValueInt64(), plan.VLANMaximum.ValueInt64()); err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrUpdateVlanNetwork, err.Error())
+		return
+	}
+	networkTypes, err := helper.GetVlanNetworkTypes(omeClient)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrUpdateVlanNetwork, err.Error())
+		return
+	}
+	payload, err := helper.MakeVlanNetworkPayload(plan, state.ID.ValueInt64(), networkTypes)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrUpdateVlanNetwork, err.Error())
+		return
+	}
+	// the appliance requires the reference of the network to update it
+	payload.InternalRefNWUUID = state.InternalRefNWUUID.ValueString()
+	network, err := helper.UpdateVlanNetwork(omeClient, payload)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrUpdateVlanNetwork, err.Error())
+		return
+	}
+
+	state = helper.SetStateVlanNetwork(network, networkTypes, plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "resource_vlan_network update: finished")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *vlanNetworkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "resource_vlan_network delete: started")
+	var state models.OmeVlanNetwork
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_vlan_network Delete")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	if err := helper.DeleteVlanNetwork(omeClient, state.ID.ValueInt64()); err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrDeleteVlanNetwork, err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Trace(ctx, "resource_vlan_network delete: finished")
+}
+
+// ImportState imports an existing vlan network by id or by name.
+func (r *vlanNetworkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Trace(ctx, "resource_vlan_network import: started")
+
+	// Create Session and defer the remove session
+	omeClient, d := r.p.createOMESession(ctx, "resource_vlan_network ImportState")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	var network models.VLanNetworks
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err == nil {
+		network, err = helper.GetVlanNetwork(omeClient, id)
+	} else {
+		network, err = helper.GetVlanNetworkByName(omeClient, req.ID)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrImportVlanNetwork, err.Error())
+		return
+	}
+	networkTypes, err := helper.GetVlanNetworkTypes(omeClient)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrImportVlanNetwork, err.Error())
+		return
+	}
+
+	state := helper.SetStateVlanNetwork(network, networkTypes, models.OmeVlanNetwork{})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "resource_vlan_network import: finished")
+}
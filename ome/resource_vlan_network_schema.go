@@ -0,0 +1,96 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"terraform-provider-ome/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// VlanNetworkSchema returns the schema for the vlan network resource
+func VlanNetworkSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the VLAN network.",
+			Description:         "ID of the VLAN network.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the VLAN network.",
+			Description:         "Name of the VLAN network.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Description of the VLAN network.",
+			Description:         "Description of the VLAN network.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(""),
+		},
+		"vlan_minimum": schema.Int64Attribute{
+			MarkdownDescription: "First VLAN ID of the range of the network, from `1` to `4094`." +
+				" The range cannot overlap the range of another network of OME.",
+			Description: "First VLAN ID of the range of the network, from '1' to '4094'." +
+				" The range cannot overlap the range of another network of OME.",
+			Required: true,
+			Validators: []validator.Int64{
+				int64validator.Between(1, 4094),
+			},
+		},
+		"vlan_maximum": schema.Int64Attribute{
+			MarkdownDescription: "Last VLAN ID of the range of the network, from `1` to `4094`." +
+				" Set it to `vlan_minimum` for a single VLAN.",
+			Description: "Last VLAN ID of the range of the network, from '1' to '4094'." +
+				" Set it to 'vlan_minimum' for a single VLAN.",
+			Required: true,
+			Validators: []validator.Int64{
+				int64validator.Between(1, 4094),
+			},
+		},
+		"network_type": schema.StringAttribute{
+			MarkdownDescription: "Type of the VLAN network, for example `General Purpose (Silver)`, `Storage - iSCSI` or `VM Migration`." +
+				" The types are validated against the network types of OME. Default value is `General Purpose (Bronze)`.",
+			Description: "Type of the VLAN network, for example 'General Purpose (Silver)', 'Storage - iSCSI' or 'VM Migration'." +
+				" The types are validated against the network types of OME. Default value is 'General Purpose (Bronze)'.",
+			Optional: true,
+			Computed: true,
+			Default:  stringdefault.StaticString(helper.DefaultVlanNetworkType),
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"internal_ref_nwuu_id": schema.StringAttribute{
+			MarkdownDescription: "Reference ID of the VLAN network.",
+			Description:         "Reference ID of the VLAN network.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
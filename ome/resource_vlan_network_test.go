@@ -0,0 +1,256 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestVlanNetworkResource(t *testing.T) {
+	var vlanNetworkTfName = "ome_vlan_network.vlan"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testVlanNetworkCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(vlanNetworkTfName, "name", "tfacc_vlan"),
+					resource.TestCheckResourceAttr(vlanNetworkTfName, "vlan_minimum", "3901"),
+					resource.TestCheckResourceAttr(vlanNetworkTfName, "vlan_maximum", "3901"),
+					resource.TestCheckResourceAttr(vlanNetworkTfName, "network_type", "General Purpose (Bronze)"),
+				),
+			},
+			{
+				Config: testVlanNetworkUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(vlanNetworkTfName, "name", "tfacc_vlan_update"),
+					resource.TestCheckResourceAttr(vlanNetworkTfName, "description", "tfacc vlan"),
+					resource.TestCheckResourceAttr(vlanNetworkTfName, "vlan_maximum", "3905"),
+					resource.TestCheckResourceAttr(vlanNetworkTfName, "network_type", "Storage - iSCSI"),
+				),
+			},
+			// Import testing by id
+			{
+				ResourceName:      vlanNetworkTfName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Import testing by name
+			{
+				ResourceName:      vlanNetworkTfName,
+				ImportState:       true,
+				ImportStateId:     "tfacc_vlan_update",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestVlanNetworkResourceValidationError(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testVlanNetworkInvalidRange,
+				ExpectError: regexp.MustCompile(`.*vlan_minimum 3905 cannot be greater than vlan_maximum 3901.*`),
+			},
+			{
+				Config:      testVlanNetworkOutOfRange,
+				ExpectError: regexp.MustCompile(`.*value must be between 1 and 4094.*`),
+			},
+			{
+				Config:      testVlanNetworkInvalidType,
+				ExpectError: regexp.MustCompile(`.*invalid network type.*`),
+			},
+			{
+				Config:      testVlanNetworkOverlap,
+				ExpectError: regexp.MustCompile(`.*vlan range 3903-3910 overlaps the range 3901-3905 of the network tfacc_vlan.*`),
+			},
+		},
+	})
+}
+
+func TestVlanNetworkResourceOverlap(t *testing.T) {
+	var vlanNetworkTfName = "ome_vlan_network.vlan"
+	var payloads []models.VLanNetworks
+	var getAllVlanNetworks func(*clients.Client) ([]models.VLanNetworks, error)
+	var createVlanNetwork, updateVlanNetwork func(*clients.Client, models.VLanNetworks) (models.VLanNetworks, error)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// the ranges ending just before and starting just after the range of the network do not overlap,
+			// the network type is matched whatever its case
+			{
+				PreConfig: func() {
+					// two networks around the range of the test network are added to the networks of the appliance
+					FunctionMocker = Mock((*clients.Client).GetAllVlanNetworks).To(func(c *clients.Client) ([]models.VLanNetworks, error) {
+						networks, err := getAllVlanNetworks(c)
+						return append(networks,
+							models.VLanNetworks{ID: 1 << 40, Name: "tfacc_vlan_below", VLANMinimum: 3890, VLANMaximum: 3900},
+							models.VLanNetworks{ID: 1<<40 + 1, Name: "tfacc_vlan_above", VLANMinimum: 3906, VLANMaximum: 3910},
+						), err
+					}).Origin(&getAllVlanNetworks).Build()
+					localMocker = Mock((*clients.Client).CreateVlanNetwork).To(func(c *clients.Client, network models.VLanNetworks) (models.VLanNetworks, error) {
+						payloads = append(payloads, network)
+						return createVlanNetwork(c, network)
+					}).Origin(&createVlanNetwork).Build()
+					localMocker2 = Mock((*clients.Client).UpdateVlanNetwork).To(func(c *clients.Client, network models.VLanNetworks) (models.VLanNetworks, error) {
+						payloads = append(payloads, network)
+						return updateVlanNetwork(c, network)
+					}).Origin(&updateVlanNetwork).Build()
+				},
+				Config: testVlanNetworkBetween,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(vlanNetworkTfName, "network_type", "general purpose (bronze)"),
+					func(_ *terraform.State) error {
+						if len(payloads) != 1 || payloads[0].ID != 0 || payloads[0].VLANMinimum != 3901 || payloads[0].VLANMaximum != 3905 || payloads[0].Type == 0 {
+							return fmt.Errorf("unexpected create payloads %+v", payloads)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config:      testVlanNetworkOverlapAbove,
+				ExpectError: regexp.MustCompile(`.*overlaps the range 3906-3910 of the network tfacc_vlan_above.*`),
+			},
+			{
+				Config:      testVlanNetworkOverlapBelow,
+				ExpectError: regexp.MustCompile(`.*overlaps the range 3890-3900 of the network tfacc_vlan_below.*`),
+			},
+			// the range of the network itself is not an overlap
+			{
+				Config: testVlanNetworkBetweenRenamed,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(vlanNetworkTfName, "name", "tfacc_vlan_renamed"),
+					func(s *terraform.State) error {
+						FunctionMocker.UnPatch()
+						localMocker.UnPatch()
+						localMocker2.UnPatch()
+						id := s.RootModule().Resources[vlanNetworkTfName].Primary.ID
+						if len(payloads) != 2 || fmt.Sprint(payloads[1].ID) != id || payloads[1].Name != "tfacc_vlan_renamed" || payloads[1].Type != payloads[0].Type {
+							return fmt.Errorf("unexpected update payloads %+v", payloads)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+var testVlanNetworkCreate = testProvider + `
+resource "ome_vlan_network" "vlan" {
+	name         = "tfacc_vlan"
+	vlan_minimum = 3901
+	vlan_maximum = 3901
+}
+`
+
+var testVlanNetworkUpdate = testProvider + `
+resource "ome_vlan_network" "vlan" {
+	name         = "tfacc_vlan_update"
+	description  = "tfacc vlan"
+	vlan_minimum = 3901
+	vlan_maximum = 3905
+	network_type = "Storage - iSCSI"
+}
+`
+
+var testVlanNetworkInvalidRange = testProvider + `
+resource "ome_vlan_network" "vlan" {
+	name         = "tfacc_vlan"
+	vlan_minimum = 3905
+	vlan_maximum = 3901
+}
+`
+
+var testVlanNetworkOutOfRange = testProvider + `
+resource "ome_vlan_network" "vlan" {
+	name         = "tfacc_vlan"
+	vlan_minimum = 3901
+	vlan_maximum = 4095
+}
+`
+
+var testVlanNetworkInvalidType = testProvider + `
+resource "ome_vlan_network" "vlan" {
+	name         = "tfacc_vlan"
+	vlan_minimum = 3901
+	vlan_maximum = 3901
+	network_type = "invalid"
+}
+`
+
+var testVlanNetworkOverlap = testProvider + `
+resource "ome_vlan_network" "vlan" {
+	name         = "tfacc_vlan"
+	vlan_minimum = 3901
+	vlan_maximum = 3905
+}
+
+resource "ome_vlan_network" "vlan_overlap" {
+	name         = "tfacc_vlan_overlap"
+	vlan_minimum = 3903
+	vlan_maximum = 3910
+	depends_on   = [ome_vlan_network.vlan]
+}
+`
+
+var testVlanNetworkBetween = testProvider + `
+resource "ome_vlan_network" "vlan" {
+	name         = "tfacc_vlan"
+	vlan_minimum = 3901
+	vlan_maximum = 3905
+	network_type = "general purpose (bronze)"
+}
+`
+
+var testVlanNetworkOverlapAbove = testProvider + `
+resource "ome_vlan_network" "vlan" {
+	name         = "tfacc_vlan"
+	vlan_minimum = 3901
+	vlan_maximum = 3906
+	network_type = "general purpose (bronze)"
+}
+`
+
+var testVlanNetworkOverlapBelow = testProvider + `
+resource "ome_vlan_network" "vlan" {
+	name         = "tfacc_vlan"
+	vlan_minimum = 3900
+	vlan_maximum = 3905
+	network_type = "general purpose (bronze)"
+}
+`
+
+var testVlanNetworkBetweenRenamed = testProvider + `
+resource "ome_vlan_network" "vlan" {
+	name         = "tfacc_vlan_renamed"
+	vlan_minimum = 3901
+	vlan_maximum = 3905
+	network_type = "general purpose (bronze)"
+}
+`
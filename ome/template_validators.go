@@ -38,19 +38,20 @@ func (v validFqddsValidator) MarkdownDescription(ctx context.Context) string {
 }
 
 // Validate runs the main validation logic of the validator, reading configuration data out of `req` and updating `resp` with diagnostics.
+// Several components can be captured together, but `All` cannot be combined with other components and a component cannot be repeated.
 func (v validFqddsValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
 	fqdds := req.ConfigValue
 	if fqdds.IsUnknown() || fqdds.IsNull() {
 		return
 	}
-	inputFqdds := fqdds.ValueString()
-	multipleInputFqdds := strings.Split(inputFqdds, ",")
+	multipleInputFqdds := strings.Split(fqdds.ValueString(), ",")
 	multipleValidFqdds := strings.Split(clients.ValidFQDDS, ",")
-	isValid := false
+	seen := map[string]bool{}
+	errs := []string{}
 
 	for _, inpFqdds := range multipleInputFqdds {
 		inputFqddsVal := strings.TrimSpace(inpFqdds)
-		isValid = false
+		isValid := false
 		for _, validFqdds := range multipleValidFqdds {
 			if strings.EqualFold(validFqdds, inputFqddsVal) {
 				isValid = true
@@ -58,13 +59,23 @@ func (v validFqddsValidator) ValidateString(ctx context.Context, req validator.S
 			}
 		}
 		if !isValid {
-			resp.Diagnostics.AddAttributeError(
-				req.Path,
-				clients.ErrInvalidFqdds,
-				v.Description(ctx),
-			)
-			break
+			errs = append(errs, fmt.Sprintf("%q is not a valid component. %s", inputFqddsVal, v.Description(ctx)))
+			continue
 		}
+		key := strings.ToLower(inputFqddsVal)
+		if seen[key] {
+			errs = append(errs, fmt.Sprintf("component %s is repeated", inputFqddsVal))
+		}
+		seen[key] = true
+	}
+	if seen["all"] && len(multipleInputFqdds) > 1 {
+		errs = append(errs, "All already captures every component and cannot be combined with other components")
+	}
+	if len(errs) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			clients.ErrInvalidFqdds,
+			strings.Join(errs, "\n"),
+		)
 	}
-
 }
@@ -286,3 +286,8 @@ func ConvertStringListValue(inputs []string) types.List {
 	)
 	return ret
 }
+
+// EscapeODataString escapes the single quotes of a value to be put in an OData string literal
+func EscapeODataString(value string) string {
+	return strings.ReplaceAll(value, "'", "''")
+}
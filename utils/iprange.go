@@ -18,9 +18,16 @@ import (
 	"fmt"
 	"net"
 	"net/netip"
+	"regexp"
 	"strings"
 )
 
+// LookupHost resolves a host name to its IP addresses, it can be replaced in tests
+var LookupHost = net.LookupHost
+
+// hostnameRegex matches the RFC 1123 host names
+var hostnameRegex = regexp.MustCompile(`^([a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?\.)*[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+
 // IPRange represents a continuous range of IPs
 type IPRange struct {
 	start netip.Addr
@@ -41,6 +48,9 @@ func (ir IPRange) Contains(ip net.IP) bool {
 func ParseNetwork(network string) (IPRange, error) {
 	var netRange IPRange
 	network = strings.TrimSpace(network)
+	if strings.Contains(network, "*") {
+		return parseWildcard(network)
+	}
 	if strings.Contains(network, "/") {
 		// a candidate for a CIDR
 		_, cidr, err := net.ParseCIDR(network)
@@ -69,12 +79,61 @@ func ParseNetwork(network string) (IPRange, error) {
 	return netRange, nil
 }
 
+// parseWildcard converts an IPv4 with trailing wildcard octets, for example 192.35.*.*, to an IPRange
+func parseWildcard(network string) (IPRange, error) {
+	var netRange IPRange
+	octets := strings.Split(network, ".")
+	if len(octets) != 4 {
+		return netRange, fmt.Errorf("%s is not a valid IPv4 wildcard", network)
+	}
+	start, stop := make([]string, 4), make([]string, 4)
+	wildcard := false
+	for i, octet := range octets {
+		if octet == "*" {
+			wildcard = true
+			start[i], stop[i] = "0", "255"
+			continue
+		}
+		if wildcard {
+			return netRange, fmt.Errorf("%s is not a valid IPv4 wildcard, only the trailing octets can be wildcards", network)
+		}
+		start[i], stop[i] = octet, octet
+	}
+	startIP, startErr := netip.ParseAddr(strings.Join(start, "."))
+	stopIP, stopErr := netip.ParseAddr(strings.Join(stop, "."))
+	if err := errors.Join(startErr, stopErr); err != nil {
+		return netRange, err
+	}
+	netRange.start, netRange.stop = startIP, stopIP
+	return netRange, nil
+}
+
+// IsHostname check if a string is a valid host name
+func IsHostname(network string) bool {
+	network = strings.TrimSuffix(strings.TrimSpace(network), ".")
+	if len(network) > 253 || !hostnameRegex.MatchString(network) {
+		return false
+	}
+	// a numeric last label is an invalid IP rather than a host name
+	labels := strings.Split(network, ".")
+	return strings.ContainsAny(strings.ToLower(labels[len(labels)-1]), "abcdefghijklmnopqrstuvwxyz")
+}
+
+// CheckNetwork check if a string is a valid IP expression or host name, without resolving the host name
+func CheckNetwork(network string) error {
+	_, err := ParseNetwork(network)
+	if err != nil && IsHostname(network) {
+		return nil
+	}
+	return err
+}
+
 // IPSet resents a set of IPs (need not be continuous range)
 type IPSet struct {
 	set []IPRange
 }
 
-// ParseNetworks converts a slice of strings to IPSet if valid
+// ParseNetworks converts a slice of strings to IPSet if valid, the host names are resolved to their IPs
 func ParseNetworks(networks []string) (IPSet, error) {
 	set := IPSet{
 		set: make([]IPRange, 0),
@@ -82,6 +141,15 @@ func ParseNetworks(networks []string) (IPSet, error) {
 	var err error
 	for _, network := range networks {
 		ipr, cerr := ParseNetwork(network)
+		if cerr != nil && IsHostname(network) {
+			iprs, herr := resolveHost(network)
+			if herr != nil {
+				err = errors.Join(herr, err)
+				continue
+			}
+			set.set = append(set.set, iprs...)
+			continue
+		}
 		if cerr != nil {
 			err = errors.Join(cerr, err)
 			continue
@@ -91,6 +159,24 @@ func ParseNetworks(networks []string) (IPSet, error) {
 	return set, err
 }
 
+// resolveHost converts a host name to the IPRanges of its IPs
+func resolveHost(host string) ([]IPRange, error) {
+	host = strings.TrimSpace(host)
+	ips, err := LookupHost(host)
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve host %s: %w", host, err)
+	}
+	ret := make([]IPRange, 0, len(ips))
+	for _, ip := range ips {
+		ipr, err := ParseNetwork(ip)
+		if err != nil {
+			return nil, fmt.Errorf("unable to resolve host %s: %w", host, err)
+		}
+		ret = append(ret, ipr)
+	}
+	return ret, nil
+}
+
 // Contains check if a net.IP is contained in the IP set
 func (is IPSet) Contains(ip net.IP) bool {
 	for _, ipr := range is.set {
@@ -14,6 +14,7 @@ limitations under the License.
 package utils
 
 import (
+	"fmt"
 	"net"
 	"testing"
 
@@ -125,3 +126,68 @@ func TestIPRangeNeg(t *testing.T) {
 		assert.Falsef(t, ipr.Contains(ip), "%s found in %s", ips, v)
 	}
 }
+
+func TestIPRangeWildcardAndIPv6Prefix(t *testing.T) {
+	TCs := map[string]map[string]bool{
+		"192.35.0.*":      {"192.35.0.0": true, "192.35.0.255": true, "192.35.1.0": false},
+		"192.35.*.*":      {"192.35.200.7": true, "192.36.0.1": false},
+		"2001:db8::/32":   {"2001:db8:ffff::1": true, "2001:db9::1": false},
+		"2001:db8::1/128": {"2001:db8::1": true, "2001:db8::2": false},
+	}
+	for v, ips := range TCs {
+		ipr, err := ParseNetwork(v)
+		assert.Nilf(t, err, "No error expected for %s", v)
+		for ips, result := range ips {
+			assert.Equalf(t, result, ipr.Contains(net.ParseIP(ips)), "%s in %s", ips, v)
+		}
+	}
+
+	for _, v := range []string{"192.*.0.1", "192.35.*", "192.35.0.**", "fe80::*"} {
+		_, err := ParseNetwork(v)
+		assert.NotNilf(t, err, "Expected error, but none found for %s", v)
+	}
+}
+
+func TestIsHostname(t *testing.T) {
+	TCs := map[string]bool{
+		"idrac-01":               true,
+		"idrac-01.example.com":   true,
+		"idrac-01.example.com.":  true,
+		" idrac-01.example.com ": true,
+		"x.x.x.x":                true,
+		"192.35.0.344":           false,
+		"192.35.0.1":             false,
+		"fe80::1":                false,
+		"-idrac":                 false,
+		"idrac_01":               false,
+		"idrac..example.com":     false,
+		"":                       false,
+	}
+	for v, ok := range TCs {
+		assert.Equalf(t, ok, IsHostname(v), "IsHostname(%s)", v)
+	}
+	assert.Nil(t, CheckNetwork("idrac-01.example.com"))
+	assert.Nil(t, CheckNetwork("192.37.0.0/24"))
+	assert.NotNil(t, CheckNetwork("192.35.0.344"))
+}
+
+func TestNetworkParserHostname(t *testing.T) {
+	lookupHost := LookupHost
+	defer func() { LookupHost = lookupHost }()
+	LookupHost = func(host string) ([]string, error) {
+		if host == "idrac-01.example.com" {
+			return []string{"192.38.0.1", "fe80::ffff:ffff:ffff:1"}, nil
+		}
+		return nil, fmt.Errorf("no such host")
+	}
+
+	pool, err := ParseNetworks([]string{"idrac-01.example.com", "192.37.0.0/24"})
+	assert.Nil(t, err)
+	assert.True(t, pool.Contains(net.ParseIP("192.38.0.1")))
+	assert.True(t, pool.Contains(net.ParseIP("fe80::ffff:ffff:ffff:1")))
+	assert.True(t, pool.Contains(net.ParseIP("192.37.0.8")))
+	assert.False(t, pool.Contains(net.ParseIP("192.38.0.2")))
+
+	_, err = ParseNetworks([]string{"unknown.example.com"})
+	assert.NotNil(t, err)
+}